@@ -0,0 +1,64 @@
+package tfsdk
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/apparentlymart/terraform-sdk/internal/objchange"
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// checkPlannedObject checks a planned new object produced by a resource
+// type's plan function using the same rules that Terraform Core will apply
+// once it receives the plan, so that we can report any problems in terms
+// of the provider rather than leaving Core to report them confusingly.
+//
+// All of the given values must already conform to the given schema.
+func (p *Provider) checkPlannedObject(typeName string, schema *tfschema.BlockType, prior, config, planned cty.Value) Diagnostics {
+	errs := objchange.AssertPlanValid(schema, prior, config, planned)
+	return p.consistencyDiagnostics(
+		errs,
+		"Provider produced invalid plan",
+		fmt.Sprintf("Provider produced an invalid planned new state for %s", typeName),
+	)
+}
+
+// checkAppliedObject checks a new object produced by a resource type's apply
+// function against the planned object it was created from, using the same
+// rules that Terraform Core will apply once it receives the new object.
+//
+// Both of the given values must already conform to the given schema.
+func (p *Provider) checkAppliedObject(typeName string, schema *tfschema.BlockType, planned, actual cty.Value) Diagnostics {
+	errs := objchange.AssertObjectCompatible(schema, planned, actual)
+	return p.consistencyDiagnostics(
+		errs,
+		"Provider produced inconsistent result after apply",
+		fmt.Sprintf("When applying changes to %s, the provider produced an unexpected new value", typeName),
+	)
+}
+
+// consistencyDiagnostics converts errors from package objchange into error
+// diagnostics, or logs them as warnings if the provider has opted in to
+// lenient consistency checks.
+func (p *Provider) consistencyDiagnostics(errs []error, summary, prefix string) Diagnostics {
+	var diags Diagnostics
+	for _, err := range errs {
+		if p.LenientConsistencyChecks {
+			log.Printf("[WARN] %s: %s: %s", summary, prefix, FormatError(err))
+			continue
+		}
+
+		var path cty.Path
+		if pErr, ok := err.(cty.PathError); ok {
+			path = pErr.Path
+		}
+		diags = diags.Append(Diagnostic{
+			Severity: Error,
+			Summary:  summary,
+			Detail:   fmt.Sprintf("%s: %s.\n\nThis is a bug in the provider; please report it in the provider's issue tracker.", prefix, FormatError(err)),
+			Path:     path,
+		})
+	}
+	return diags
+}
@@ -0,0 +1,86 @@
+package tfsdk
+
+import (
+	"testing"
+
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestProviderCheckPlannedObject(t *testing.T) {
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"id":   {Type: cty.String, Computed: true},
+			"name": {Type: cty.String, Required: true},
+		},
+	}
+	prior := schema.Null()
+	config := cty.ObjectVal(map[string]cty.Value{
+		"id":   cty.NullVal(cty.String),
+		"name": cty.StringVal("a"),
+	})
+	planned := cty.ObjectVal(map[string]cty.Value{
+		"id":   cty.UnknownVal(cty.String),
+		"name": cty.StringVal("A"),
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		p := &Provider{}
+		diags := p.checkPlannedObject("test_thing", schema, prior, config, planned)
+		if len(diags) != 1 {
+			t.Fatalf("wrong number of diagnostics %d; want 1", len(diags))
+		}
+		if got, want := diags[0].Severity, Error; got != want {
+			t.Errorf("wrong severity %#v; want %#v", got, want)
+		}
+		if got, want := diags[0].Summary, "Provider produced invalid plan"; got != want {
+			t.Errorf("wrong summary %q; want %q", got, want)
+		}
+		if got, want := diags[0].Path, cty.GetAttrPath("name"); !want.Equals(got) {
+			t.Errorf("wrong path %#v; want %#v", got, want)
+		}
+	})
+	t.Run("lenient", func(t *testing.T) {
+		p := &Provider{LenientConsistencyChecks: true}
+		diags := p.checkPlannedObject("test_thing", schema, prior, config, planned)
+		if len(diags) != 0 {
+			t.Errorf("unexpected diagnostics: %#v", diags)
+		}
+	})
+}
+
+func TestProviderCheckAppliedObject(t *testing.T) {
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"id":   {Type: cty.String, Computed: true},
+			"name": {Type: cty.String, Required: true},
+		},
+	}
+	planned := cty.ObjectVal(map[string]cty.Value{
+		"id":   cty.UnknownVal(cty.String),
+		"name": cty.StringVal("a"),
+	})
+
+	p := &Provider{}
+	diags := p.checkAppliedObject("test_thing", schema, planned, cty.ObjectVal(map[string]cty.Value{
+		"id":   cty.StringVal("abc"),
+		"name": cty.StringVal("a"),
+	}))
+	if len(diags) != 0 {
+		t.Errorf("unexpected diagnostics: %#v", diags)
+	}
+
+	diags = p.checkAppliedObject("test_thing", schema, planned, cty.ObjectVal(map[string]cty.Value{
+		"id":   cty.StringVal("abc"),
+		"name": cty.StringVal("A"),
+	}))
+	if len(diags) != 1 {
+		t.Fatalf("wrong number of diagnostics %d; want 1", len(diags))
+	}
+	if got, want := diags[0].Summary, "Provider produced inconsistent result after apply"; got != want {
+		t.Errorf("wrong summary %q; want %q", got, want)
+	}
+	if got, want := diags[0].Path, cty.GetAttrPath("name"); !want.Equals(got) {
+		t.Errorf("wrong path %#v; want %#v", got, want)
+	}
+}
@@ -0,0 +1,386 @@
+package tfsdk
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// flatmapUnknownValue is the placeholder that Terraform 0.11 and earlier used
+// to represent unknown values in the flatmap format.
+const flatmapUnknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// decodeFlatmapObject decodes the legacy "flatmap" representation of an
+// object, as saved in the state by providers written for Terraform 0.11 and
+// earlier, into an object value conforming to the given schema.
+//
+// Any keys in the given map that do not correspond with the schema are
+// ignored, because legacy providers often saved additional attributes such as
+// "id" that may not be present in the schema. If an error is returned, it
+// is a cty.PathError describing the location of the problem in the object.
+func decodeFlatmapObject(m map[string]string, schema *tfschema.BlockType) (cty.Value, error) {
+	v, err := flatmapBlockValue(m, schema, "", nil)
+	if err != nil {
+		return schema.Null(), err
+	}
+
+	// Unknown values should never appear in state, but legacy Terraform was
+	// not always careful about this and so we'll just treat them as null.
+	return cty.UnknownAsNull(v), nil
+}
+
+func flatmapBlockValue(m map[string]string, schema *tfschema.BlockType, prefix string, path cty.Path) (cty.Value, error) {
+	vals := make(map[string]cty.Value, len(schema.Attributes)+len(schema.NestedBlockTypes))
+
+	for name, attrS := range schema.Attributes {
+		v, err := flatmapValue(m, attrS.ImpliedCtyType(), prefix+name, path.GetAttr(name))
+		if err != nil {
+			return cty.DynamicVal, err
+		}
+		vals[name] = v
+	}
+
+	for name, blockS := range schema.NestedBlockTypes {
+		v, err := flatmapNestedBlockValue(m, blockS, prefix+name, path.GetAttr(name))
+		if err != nil {
+			return cty.DynamicVal, err
+		}
+		vals[name] = v
+	}
+
+	return cty.ObjectVal(vals), nil
+}
+
+func flatmapNestedBlockValue(m map[string]string, schema *tfschema.NestedBlockType, key string, path cty.Path) (cty.Value, error) {
+	ety := schema.Content.ImpliedCtyType()
+
+	switch schema.Nesting {
+	case tfschema.NestingSingle, tfschema.NestingGroup:
+		// The legacy SDK had no single nested blocks, so blocks of this kind
+		// were previously lists with at most one element.
+		n, known, err := flatmapCount(m, key+".#", path)
+		if err != nil {
+			return cty.DynamicVal, err
+		}
+		if !known {
+			return cty.UnknownVal(ety), nil
+		}
+		if n == 0 {
+			if schema.Nesting == tfschema.NestingGroup {
+				// A group block is never null, so we'll decode an object with
+				// all of its attributes set to null instead.
+				return flatmapBlockValue(m, &schema.Content, key+".0.", path)
+			}
+			return cty.NullVal(ety), nil
+		}
+		return flatmapBlockValue(m, &schema.Content, key+".0.", path)
+
+	case tfschema.NestingList:
+		n, known, err := flatmapCount(m, key+".#", path)
+		if err != nil {
+			return cty.DynamicVal, err
+		}
+		if !known {
+			return cty.UnknownVal(cty.List(ety)), nil
+		}
+		vals := make([]cty.Value, 0, n)
+		for i := 0; i < n; i++ {
+			idxStr := strconv.Itoa(i)
+			v, err := flatmapBlockValue(m, &schema.Content, key+"."+idxStr+".", path.Index(cty.NumberIntVal(int64(i))))
+			if err != nil {
+				return cty.DynamicVal, err
+			}
+			vals = append(vals, v)
+		}
+		if ety.HasDynamicTypes() {
+			return cty.TupleVal(vals), nil
+		}
+		if len(vals) == 0 {
+			return cty.ListValEmpty(ety), nil
+		}
+		return cty.ListVal(vals), nil
+
+	case tfschema.NestingSet:
+		n, known, err := flatmapCount(m, key+".#", path)
+		if err != nil {
+			return cty.DynamicVal, err
+		}
+		if !known {
+			return cty.UnknownVal(cty.Set(ety)), nil
+		}
+		var vals []cty.Value
+		if n > 0 {
+			for _, idx := range flatmapSubKeys(m, key, "#") {
+				v, err := flatmapBlockValue(m, &schema.Content, key+"."+idx+".", path)
+				if err != nil {
+					return cty.DynamicVal, err
+				}
+				vals = append(vals, v)
+			}
+		}
+		if len(vals) == 0 {
+			return cty.SetValEmpty(ety), nil
+		}
+		return cty.SetVal(vals), nil
+
+	case tfschema.NestingMap:
+		n, known, err := flatmapCount(m, key+".%", path)
+		if err != nil {
+			return cty.DynamicVal, err
+		}
+		if !known {
+			return cty.UnknownVal(cty.Map(ety)), nil
+		}
+		vals := make(map[string]cty.Value)
+		if n > 0 {
+			for _, k := range flatmapSubKeys(m, key, "%") {
+				v, err := flatmapBlockValue(m, &schema.Content, key+"."+k+".", path.Index(cty.StringVal(k)))
+				if err != nil {
+					return cty.DynamicVal, err
+				}
+				vals[k] = v
+			}
+		}
+		if ety.HasDynamicTypes() {
+			return cty.ObjectVal(vals), nil
+		}
+		if len(vals) == 0 {
+			return cty.MapValEmpty(ety), nil
+		}
+		return cty.MapVal(vals), nil
+
+	default:
+		return cty.DynamicVal, path.NewErrorf("unsupported nested block mode %s", schema.Nesting)
+	}
+}
+
+func flatmapValue(m map[string]string, ty cty.Type, key string, path cty.Path) (cty.Value, error) {
+	switch {
+	case ty.IsPrimitiveType():
+		return flatmapPrimitiveValue(m, ty, key, path)
+	case ty.IsListType(), ty.IsSetType():
+		return flatmapSequenceValue(m, ty, key, path)
+	case ty.IsMapType():
+		return flatmapMapValue(m, ty, key, path)
+	case ty.IsObjectType():
+		return flatmapObjectValue(m, ty, key, path)
+	case ty.IsTupleType():
+		return flatmapTupleValue(m, ty, key, path)
+	case ty == cty.DynamicPseudoType:
+		return cty.DynamicVal, path.NewErrorf("cannot decode a dynamically-typed value from legacy state")
+	default:
+		return cty.DynamicVal, path.NewErrorf("cannot decode %s from legacy state", ty.FriendlyName())
+	}
+}
+
+func flatmapPrimitiveValue(m map[string]string, ty cty.Type, key string, path cty.Path) (cty.Value, error) {
+	raw, exists := m[key]
+	if !exists {
+		return cty.NullVal(ty), nil
+	}
+	if raw == flatmapUnknownValue {
+		return cty.UnknownVal(ty), nil
+	}
+
+	switch ty {
+	case cty.String:
+		return cty.StringVal(raw), nil
+	case cty.Number:
+		v, err := cty.ParseNumberVal(raw)
+		if err != nil {
+			return cty.DynamicVal, path.NewErrorf("invalid number %q", raw)
+		}
+		return v, nil
+	case cty.Bool:
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			return cty.DynamicVal, path.NewErrorf("invalid bool %q", raw)
+		}
+		return cty.BoolVal(v), nil
+	default:
+		// Should never happen, because the above is exhaustive
+		return cty.DynamicVal, path.NewErrorf("unsupported primitive type %s", ty.FriendlyName())
+	}
+}
+
+func flatmapSequenceValue(m map[string]string, ty cty.Type, key string, path cty.Path) (cty.Value, error) {
+	n, known, err := flatmapCount(m, key+".#", path)
+	if err != nil {
+		return cty.DynamicVal, err
+	}
+	if _, exists := m[key+".#"]; !exists {
+		return cty.NullVal(ty), nil
+	}
+	if !known {
+		return cty.UnknownVal(ty), nil
+	}
+
+	ety := ty.ElementType()
+	var vals []cty.Value
+	if ty.IsListType() {
+		for i := 0; i < n; i++ {
+			v, err := flatmapValue(m, ety, key+"."+strconv.Itoa(i), path.Index(cty.NumberIntVal(int64(i))))
+			if err != nil {
+				return cty.DynamicVal, err
+			}
+			vals = append(vals, v)
+		}
+		if len(vals) == 0 {
+			return cty.ListValEmpty(ety), nil
+		}
+		return cty.ListVal(vals), nil
+	}
+
+	if n > 0 {
+		// Set elements are identified by hash codes rather than indices.
+		for _, idx := range flatmapSubKeys(m, key, "#") {
+			v, err := flatmapValue(m, ety, key+"."+idx, path)
+			if err != nil {
+				return cty.DynamicVal, err
+			}
+			vals = append(vals, v)
+		}
+	}
+	if len(vals) == 0 {
+		return cty.SetValEmpty(ety), nil
+	}
+	return cty.SetVal(vals), nil
+}
+
+func flatmapMapValue(m map[string]string, ty cty.Type, key string, path cty.Path) (cty.Value, error) {
+	n, known, err := flatmapCount(m, key+".%", path)
+	if err != nil {
+		return cty.DynamicVal, err
+	}
+	if _, exists := m[key+".%"]; !exists {
+		return cty.NullVal(ty), nil
+	}
+	if !known {
+		return cty.UnknownVal(ty), nil
+	}
+
+	ety := ty.ElementType()
+	vals := make(map[string]cty.Value)
+	if n > 0 {
+		var keys []string
+		if ety.IsPrimitiveType() {
+			// Keys of a map of primitive values may themselves contain dots,
+			// so we take the whole remainder of each key.
+			prefix := key + "."
+			for k := range m {
+				if !strings.HasPrefix(k, prefix) || k == key+".%" {
+					continue
+				}
+				keys = append(keys, k[len(prefix):])
+			}
+		} else {
+			keys = flatmapSubKeys(m, key, "%")
+		}
+		for _, k := range keys {
+			v, err := flatmapValue(m, ety, key+"."+k, path.Index(cty.StringVal(k)))
+			if err != nil {
+				return cty.DynamicVal, err
+			}
+			vals[k] = v
+		}
+	}
+	if len(vals) == 0 {
+		return cty.MapValEmpty(ety), nil
+	}
+	return cty.MapVal(vals), nil
+}
+
+func flatmapObjectValue(m map[string]string, ty cty.Type, key string, path cty.Path) (cty.Value, error) {
+	if len(flatmapSubKeys(m, key, "")) == 0 {
+		return cty.NullVal(ty), nil
+	}
+
+	atys := ty.AttributeTypes()
+	vals := make(map[string]cty.Value, len(atys))
+	for name, aty := range atys {
+		v, err := flatmapValue(m, aty, key+"."+name, path.GetAttr(name))
+		if err != nil {
+			return cty.DynamicVal, err
+		}
+		vals[name] = v
+	}
+	return cty.ObjectVal(vals), nil
+}
+
+func flatmapTupleValue(m map[string]string, ty cty.Type, key string, path cty.Path) (cty.Value, error) {
+	n, known, err := flatmapCount(m, key+".#", path)
+	if err != nil {
+		return cty.DynamicVal, err
+	}
+	if _, exists := m[key+".#"]; !exists {
+		return cty.NullVal(ty), nil
+	}
+	if !known {
+		return cty.UnknownVal(ty), nil
+	}
+
+	etys := ty.TupleElementTypes()
+	if n != len(etys) {
+		return cty.DynamicVal, path.NewErrorf("tuple has %d elements, but %d are required", n, len(etys))
+	}
+	vals := make([]cty.Value, len(etys))
+	for i, ety := range etys {
+		v, err := flatmapValue(m, ety, key+"."+strconv.Itoa(i), path.Index(cty.NumberIntVal(int64(i))))
+		if err != nil {
+			return cty.DynamicVal, err
+		}
+		vals[i] = v
+	}
+	return cty.TupleVal(vals), nil
+}
+
+// flatmapCount reads one of the special "#" or "%" keys that legacy flatmap
+// uses to record the number of elements in a collection.
+//
+// A missing count key is treated as a count of zero. The "known" result is
+// false if the count was recorded as unknown.
+func flatmapCount(m map[string]string, countKey string, path cty.Path) (n int, known bool, err error) {
+	raw, exists := m[countKey]
+	if !exists {
+		return 0, true, nil
+	}
+	if raw == flatmapUnknownValue {
+		return 0, false, nil
+	}
+	n, err = strconv.Atoi(raw)
+	if err != nil || n < 0 {
+		return 0, true, path.NewErrorf("invalid collection length %q", raw)
+	}
+	return n, true, nil
+}
+
+// flatmapSubKeys returns the distinct next-level key segments that appear
+// under the given key prefix, excluding the given count key segment, in
+// lexical order.
+func flatmapSubKeys(m map[string]string, key string, countKey string) []string {
+	prefix := key + "."
+	seen := make(map[string]struct{})
+	for k := range m {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+		rest := k[len(prefix):]
+		if dot := strings.IndexByte(rest, '.'); dot != -1 {
+			rest = rest[:dot]
+		}
+		if rest == countKey {
+			continue
+		}
+		seen[rest] = struct{}{}
+	}
+
+	ret := make([]string, 0, len(seen))
+	for k := range seen {
+		ret = append(ret, k)
+	}
+	sort.Strings(ret)
+	return ret
+}
@@ -0,0 +1,157 @@
+package tfsdk
+
+import (
+	"testing"
+
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestDecodeFlatmapObject(t *testing.T) {
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"name":    {Type: cty.String, Required: true},
+			"port":    {Type: cty.Number, Optional: true},
+			"enabled": {Type: cty.Bool, Optional: true},
+			"tags":    {Type: cty.Map(cty.String), Optional: true},
+			"zones":   {Type: cty.List(cty.String), Optional: true},
+			"groups":  {Type: cty.Set(cty.String), Optional: true},
+			"unset":   {Type: cty.List(cty.String), Optional: true},
+		},
+		NestedBlockTypes: map[string]*tfschema.NestedBlockType{
+			"single": {
+				Nesting: tfschema.NestingSingle,
+				Content: tfschema.BlockType{
+					Attributes: map[string]*tfschema.Attribute{
+						"value": {Type: cty.String, Optional: true},
+					},
+				},
+			},
+			"rule": {
+				Nesting: tfschema.NestingList,
+				Content: tfschema.BlockType{
+					Attributes: map[string]*tfschema.Attribute{
+						"from": {Type: cty.Number, Optional: true},
+						"to":   {Type: cty.Number, Optional: true},
+					},
+				},
+			},
+			"ingress": {
+				Nesting: tfschema.NestingSet,
+				Content: tfschema.BlockType{
+					Attributes: map[string]*tfschema.Attribute{
+						"cidr": {Type: cty.String, Optional: true},
+					},
+				},
+			},
+			"empty": {
+				Nesting: tfschema.NestingList,
+				Content: tfschema.BlockType{
+					Attributes: map[string]*tfschema.Attribute{
+						"value": {Type: cty.String, Optional: true},
+					},
+				},
+			},
+		},
+	}
+
+	got, err := decodeFlatmapObject(map[string]string{
+		"id":                  "legacy-id",
+		"name":                "foo",
+		"port":                "8080",
+		"enabled":             "true",
+		"tags.%":              "2",
+		"tags.Name":           "foo",
+		"tags.example.com/a":  "b",
+		"zones.#":             "2",
+		"zones.0":             "a",
+		"zones.1":             "b",
+		"groups.#":            "2",
+		"groups.1234":         "x",
+		"groups.5678":         "y",
+		"single.#":            "1",
+		"single.0.value":      "hello",
+		"rule.#":              "2",
+		"rule.0.from":         "1",
+		"rule.0.to":           "2",
+		"rule.1.from":         "3",
+		"ingress.#":           "1",
+		"ingress.98765.cidr":  "10.0.0.0/8",
+		"empty.#":             "0",
+		"unrelated.0.ignored": "yes",
+	}, schema)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", FormatError(err))
+	}
+
+	want := cty.ObjectVal(map[string]cty.Value{
+		"name":    cty.StringVal("foo"),
+		"port":    cty.NumberIntVal(8080),
+		"enabled": cty.True,
+		"tags": cty.MapVal(map[string]cty.Value{
+			"Name":          cty.StringVal("foo"),
+			"example.com/a": cty.StringVal("b"),
+		}),
+		"zones":  cty.ListVal([]cty.Value{cty.StringVal("a"), cty.StringVal("b")}),
+		"groups": cty.SetVal([]cty.Value{cty.StringVal("x"), cty.StringVal("y")}),
+		"unset":  cty.NullVal(cty.List(cty.String)),
+		"single": cty.ObjectVal(map[string]cty.Value{
+			"value": cty.StringVal("hello"),
+		}),
+		"rule": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"from": cty.NumberIntVal(1),
+				"to":   cty.NumberIntVal(2),
+			}),
+			cty.ObjectVal(map[string]cty.Value{
+				"from": cty.NumberIntVal(3),
+				"to":   cty.NullVal(cty.Number),
+			}),
+		}),
+		"ingress": cty.SetVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"cidr": cty.StringVal("10.0.0.0/8"),
+			}),
+		}),
+		"empty": cty.ListValEmpty(cty.Object(map[string]cty.Type{
+			"value": cty.String,
+		})),
+	})
+	if !want.RawEquals(got) {
+		t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, want)
+	}
+}
+
+func TestDecodeFlatmapObjectInvalid(t *testing.T) {
+	schema := &tfschema.BlockType{
+		NestedBlockTypes: map[string]*tfschema.NestedBlockType{
+			"rule": {
+				Nesting: tfschema.NestingList,
+				Content: tfschema.BlockType{
+					Attributes: map[string]*tfschema.Attribute{
+						"from": {Type: cty.Number, Optional: true},
+					},
+				},
+			},
+		},
+	}
+
+	_, err := decodeFlatmapObject(map[string]string{
+		"rule.#":      "1",
+		"rule.0.from": "not a number",
+	}, schema)
+	if err == nil {
+		t.Fatalf("unexpected success")
+	}
+	pErr, ok := err.(cty.PathError)
+	if !ok {
+		t.Fatalf("error is %T, not cty.PathError", err)
+	}
+	wantPath := cty.GetAttrPath("rule").Index(cty.NumberIntVal(0)).GetAttr("from")
+	if !pErr.Path.Equals(wantPath) {
+		t.Errorf("wrong error path\ngot:  %#v\nwant: %#v", pErr.Path, wantPath)
+	}
+	if got, want := pErr.Error(), `invalid number "not a number"`; got != want {
+		t.Errorf("wrong error\ngot:  %s\nwant: %s", got, want)
+	}
+}
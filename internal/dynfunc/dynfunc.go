@@ -7,7 +7,6 @@ import (
 	"github.com/apparentlymart/terraform-sdk/internal/sdkdiags"
 	"github.com/apparentlymart/terraform-sdk/tfobj"
 	"github.com/zclconf/go-cty/cty"
-	"github.com/zclconf/go-cty/cty/gocty"
 )
 
 var diagnosticsType = reflect.TypeOf(sdkdiags.Diagnostics(nil))
@@ -18,6 +17,21 @@ var objectBuilderType = reflect.TypeOf(tfobj.ObjectBuilder(nil))
 var planReaderType = reflect.TypeOf(tfobj.PlanReader(nil))
 var planBuilderType = reflect.TypeOf(tfobj.PlanBuilder(nil))
 
+// ObjectArg is an argument to a dynamic function call that represents an
+// object in two ways. If the function accepts Reader then it is passed
+// verbatim. Otherwise, Value is decoded into the function's argument type.
+//
+// This allows the Reader to present a simplified version of the object, such
+// as one with unknown values replaced by nulls, while a function decoding
+// into its own types can still see the full value. For consistency with the
+// Reader, any unknown value in Value that would be decoded into a Go type
+// that cannot represent unknown values is decoded as null, whereas for an
+// argument given as a plain cty.Value that is an error.
+type ObjectArg struct {
+	Reader tfobj.ObjectReader
+	Value  cty.Value
+}
+
 // WrapSimpleFunction dynamically binds the given arguments to the given
 // function, or returns a developer-oriented error describing why it cannot.
 // The given function must return only a tfsdk.Diagnostics value.
@@ -120,7 +134,7 @@ func WrapFunctionWithReturnValue(f interface{}, resultPtr interface{}, args ...i
 // the return value specified as a cty value type rather than a Go pointer.
 //
 // Returns a function that will call the wrapped function, convert its result
-// to cty.Value using gocty, and return it.
+// to cty.Value using tfobj.EncodeValue, and return it.
 func WrapFunctionWithReturnValueCty(f interface{}, wantTy cty.Type, args ...interface{}) (func() (cty.Value, sdkdiags.Diagnostics), error) {
 	if f == nil {
 		return func() (cty.Value, sdkdiags.Diagnostics) {
@@ -163,10 +177,11 @@ func WrapFunctionWithReturnValueCty(f interface{}, wantTy cty.Type, args ...inte
 			return retValRaw.(cty.Value), diags
 		}
 
-		// If we're not just passing through then we need to run gocty first
-		// to try to derive a suitable value from whatever we've been given.
+		// If we're not just passing through then we need to run gocty first,
+		// via tfobj, to try to derive a suitable value from whatever we've
+		// been given.
 
-		retVal, err := gocty.ToCtyValue(retValRaw, wantTy)
+		retVal, err := tfobj.EncodeValue(retValRaw, wantTy)
 		if err != nil {
 			if !diags.HasErrors() { // If the result was errored anyway then we'll tolerate this conversion failure.
 				diags = diags.Append(sdkdiags.Diagnostic{
@@ -187,7 +202,7 @@ func WrapFunctionWithReturnValueCty(f interface{}, wantTy cty.Type, args ...inte
 // where the pathset represents the attributes that require replacement.
 //
 // Returns a function that will call the wrapped function, convert its result
-// to cty.Value using gocty, and return it.
+// to cty.Value using tfobj.EncodeValue, and return it.
 func WrapFunctionWithReturnValueCtyAndPathSet(f interface{}, wantTy cty.Type, args ...interface{}) (func() (cty.Value, cty.PathSet, sdkdiags.Diagnostics), error) {
 	if f == nil {
 		return func() (cty.Value, cty.PathSet, sdkdiags.Diagnostics) {
@@ -234,10 +249,11 @@ func WrapFunctionWithReturnValueCtyAndPathSet(f interface{}, wantTy cty.Type, ar
 			return retValRaw.(cty.Value), retPathSet, diags
 		}
 
-		// If we're not just passing through then we need to run gocty first
-		// to try to derive a suitable value from whatever we've been given.
+		// If we're not just passing through then we need to run gocty first,
+		// via tfobj, to try to derive a suitable value from whatever we've
+		// been given.
 
-		retVal, err := gocty.ToCtyValue(retValRaw, wantTy)
+		retVal, err := tfobj.EncodeValue(retValRaw, wantTy)
 		if err != nil {
 			if !diags.HasErrors() { // If the result was errored anyway then we'll tolerate this conversion failure.
 				diags = diags.Append(sdkdiags.Diagnostic{
@@ -273,8 +289,17 @@ func prepareDynamicCallArgs(f interface{}, args ...interface{}) ([]reflect.Value
 		switch arg := rawArg.(type) {
 		case cty.Value:
 			var moreDiags sdkdiags.Diagnostics
-			convArgs[i], moreDiags = prepareCtyValueArg(arg, wantType)
+			convArgs[i], moreDiags = prepareCtyValueArg(arg, wantType, false)
 			forceDiags = forceDiags.Append(moreDiags)
+		case ObjectArg:
+			readerVal := reflect.ValueOf(arg.Reader)
+			if readerVal.Type().AssignableTo(wantType) {
+				convArgs[i] = readerVal
+			} else {
+				var moreDiags sdkdiags.Diagnostics
+				convArgs[i], moreDiags = prepareCtyValueArg(arg.Value, wantType, true)
+				forceDiags = forceDiags.Append(moreDiags)
+			}
 		case tfobj.ObjectReader:
 			argVal := reflect.ValueOf(rawArg)
 			if argVal.Type().AssignableTo(wantType) {
@@ -284,9 +309,19 @@ func prepareDynamicCallArgs(f interface{}, args ...interface{}) ([]reflect.Value
 				// Otherwise we'll unpack the cty.Value inside the reader and
 				// use gocty with it, just as we'd do for a plain cty.Value.
 				var moreDiags sdkdiags.Diagnostics
-				convArgs[i], moreDiags = prepareCtyValueArg(arg.ObjectVal(), wantType)
+				convArgs[i], moreDiags = prepareCtyValueArg(arg.ObjectVal(), wantType, false)
 				forceDiags = forceDiags.Append(moreDiags)
 			}
+		case nil:
+			// This arises most commonly for the client argument of a
+			// provider that has no ConfigureFn, in which case we pass
+			// the zero value of whatever type the function expects.
+			switch wantType.Kind() {
+			case reflect.Interface, reflect.Ptr, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+				convArgs[i] = reflect.Zero(wantType)
+			default:
+				return nil, nil, fmt.Errorf("argument %d must accept nil", i)
+			}
 		default:
 			// All other arguments must be directly assignable.
 			argVal := reflect.ValueOf(rawArg)
@@ -300,12 +335,21 @@ func prepareDynamicCallArgs(f interface{}, args ...interface{}) ([]reflect.Value
 	return convArgs, forceDiags, nil
 }
 
-func prepareCtyValueArg(arg cty.Value, wantType reflect.Type) (reflect.Value, sdkdiags.Diagnostics) {
+// prepareCtyValueArg decodes the given value into a new value of the given
+// type. If unknownAsNull is set then unknown values are decoded as null for
+// Go types that cannot represent them, rather than being rejected.
+func prepareCtyValueArg(arg cty.Value, wantType reflect.Type, unknownAsNull bool) (reflect.Value, sdkdiags.Diagnostics) {
 	var diags sdkdiags.Diagnostics
 
-	// As a special case, we handle cty.Value arguments through gocty.
+	// As a special case, we handle cty.Value arguments through gocty, with
+	// tfobj's extensions for representing unknown values.
 	targetVal := reflect.New(wantType)
-	err := gocty.FromCtyValue(arg, targetVal.Interface())
+	var err error
+	if unknownAsNull {
+		err = tfobj.DecodeValueUnknownAsNull(arg, targetVal.Interface())
+	} else {
+		err = tfobj.DecodeValue(arg, targetVal.Interface())
+	}
 	if err != nil {
 		// While most of the errors in here are written as if the
 		// f interface is wrong, for this particular case we invert
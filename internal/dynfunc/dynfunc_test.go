@@ -0,0 +1,119 @@
+package dynfunc
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/apparentlymart/terraform-sdk/internal/sdkdiags"
+	"github.com/apparentlymart/terraform-sdk/tfobj"
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestWrapSimpleFunctionNilArg(t *testing.T) {
+	type client struct{}
+
+	t.Run("interface", func(t *testing.T) {
+		called := false
+		f, err := WrapSimpleFunction(func(c interface{}) sdkdiags.Diagnostics {
+			called = true
+			if c != nil {
+				t.Errorf("wrong argument %#v; want nil", c)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		f()
+		if !called {
+			t.Errorf("function was not called")
+		}
+	})
+	t.Run("pointer", func(t *testing.T) {
+		called := false
+		f, err := WrapSimpleFunction(func(c *client) sdkdiags.Diagnostics {
+			called = true
+			if c != nil {
+				t.Errorf("wrong argument %#v; want nil", c)
+			}
+			return nil
+		}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		f()
+		if !called {
+			t.Errorf("function was not called")
+		}
+	})
+	t.Run("non-nillable", func(t *testing.T) {
+		_, err := WrapSimpleFunction(func(c client) sdkdiags.Diagnostics {
+			return nil
+		}, nil)
+		if err == nil {
+			t.Fatalf("unexpected success")
+		}
+		if got, want := err.Error(), "argument 0 must accept nil"; !strings.Contains(got, want) {
+			t.Errorf("wrong error %q; want %q", got, want)
+		}
+	})
+}
+
+func TestWrapSimpleFunctionUnknownArg(t *testing.T) {
+	type arg struct {
+		A *string `cty:"a"`
+	}
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"a": {Type: cty.String, Optional: true},
+		},
+	}
+	val := cty.ObjectVal(map[string]cty.Value{
+		"a": cty.UnknownVal(cty.String),
+	})
+
+	t.Run("value", func(t *testing.T) {
+		called := false
+		f, err := WrapSimpleFunction(func(a arg) sdkdiags.Diagnostics {
+			called = true
+			return nil
+		}, val)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		diags := f()
+		if called {
+			t.Errorf("function was called")
+		}
+		if len(diags) != 1 {
+			t.Fatalf("wrong number of diagnostics %d; want 1\n%#v", len(diags), diags)
+		}
+		if got, want := diags[0].Summary, "Unsuitable argument value"; got != want {
+			t.Errorf("wrong summary %q; want %q", got, want)
+		}
+	})
+	t.Run("object arg", func(t *testing.T) {
+		called := false
+		objArg := ObjectArg{
+			Reader: tfobj.NewObjectReader(schema, cty.UnknownAsNull(val)),
+			Value:  val,
+		}
+		f, err := WrapSimpleFunction(func(a arg) sdkdiags.Diagnostics {
+			called = true
+			if a.A != nil {
+				t.Errorf("unknown a is not decoded as nil: %#v", a.A)
+			}
+			return nil
+		}, objArg)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if diags := f(); len(diags) != 0 {
+			t.Fatalf("unexpected diagnostics: %#v", diags)
+		}
+		if !called {
+			t.Errorf("function was not called")
+		}
+	})
+}
@@ -0,0 +1,262 @@
+package objchange
+
+import (
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// AssertObjectCompatible checks whether the given actual object, produced by
+// applying a change, is a valid result for the given planned object, in the
+// same way that Terraform Core does after applying a change.
+//
+// Any attribute that was known in the planned object must have the same
+// value in the actual object. Unknown values in the planned object may take
+// any value of the appropriate type.
+//
+// The returned errors are cty.PathError values whose paths indicate the
+// offending attribute or block within the object. If the result is empty
+// then the actual object is compatible with the plan.
+func AssertObjectCompatible(schema *tfschema.BlockType, planned, actual cty.Value) []error {
+	return assertObjectCompatible(schema, planned, actual, nil)
+}
+
+func assertObjectCompatible(schema *tfschema.BlockType, planned, actual cty.Value, path cty.Path) []error {
+	var errs []error
+	if planned.IsNull() && !actual.IsNull() {
+		errs = append(errs, path.NewErrorf("was absent, but now present"))
+		return errs
+	}
+	if actual.IsNull() && !planned.IsNull() {
+		errs = append(errs, path.NewErrorf("was present, but now absent"))
+		return errs
+	}
+	if planned.IsNull() || !planned.IsKnown() {
+		return errs
+	}
+	if !actual.IsKnown() {
+		errs = append(errs, path.NewErrorf("was known, but now unknown"))
+		return errs
+	}
+
+	for name, attrS := range schema.Attributes {
+		path := append(path, cty.GetAttrStep{Name: name})
+		if attrS.NestedType != nil {
+			errs = append(errs, assertNestedTypeCompatible(attrS.NestedType, planned.GetAttr(name), actual.GetAttr(name), path)...)
+			continue
+		}
+		errs = append(errs, assertValueCompatible(planned.GetAttr(name), actual.GetAttr(name), path)...)
+	}
+
+	for name, blockS := range schema.NestedBlockTypes {
+		path := append(path, cty.GetAttrStep{Name: name})
+		plannedV := planned.GetAttr(name)
+		actualV := actual.GetAttr(name)
+
+		switch blockS.Nesting {
+		case tfschema.NestingSingle, tfschema.NestingGroup:
+			errs = append(errs, assertObjectCompatible(&blockS.Content, plannedV, actualV, path)...)
+
+		case tfschema.NestingList:
+			if !plannedV.IsKnown() || plannedV.IsNull() || actualV.IsNull() {
+				errs = append(errs, assertValueCompatible(plannedV, actualV, path)...)
+				continue
+			}
+			if !actualV.IsKnown() {
+				errs = append(errs, path.NewErrorf("was known, but now unknown"))
+				continue
+			}
+			plannedLen, actualLen := plannedV.LengthInt(), actualV.LengthInt()
+			if plannedLen != actualLen {
+				errs = append(errs, path.NewErrorf("block count changed from %d to %d", plannedLen, actualLen))
+				continue
+			}
+			for it := plannedV.ElementIterator(); it.Next(); {
+				idx, plannedEV := it.Element()
+				path := append(path, cty.IndexStep{Key: idx})
+				errs = append(errs, assertObjectCompatible(&blockS.Content, plannedEV, actualV.Index(idx), path)...)
+			}
+
+		case tfschema.NestingMap:
+			if !plannedV.IsKnown() || plannedV.IsNull() || actualV.IsNull() {
+				errs = append(errs, assertValueCompatible(plannedV, actualV, path)...)
+				continue
+			}
+			if !actualV.IsKnown() {
+				errs = append(errs, path.NewErrorf("was known, but now unknown"))
+				continue
+			}
+			for it := plannedV.ElementIterator(); it.Next(); {
+				key, plannedEV := it.Element()
+				path := append(path, cty.IndexStep{Key: key})
+				if has := actualV.HasIndex(key); !has.True() {
+					errs = append(errs, path.NewErrorf("block key %q has vanished", key.AsString()))
+					continue
+				}
+				errs = append(errs, assertObjectCompatible(&blockS.Content, plannedEV, actualV.Index(key), path)...)
+			}
+			for it := actualV.ElementIterator(); it.Next(); {
+				key, _ := it.Element()
+				if has := plannedV.HasIndex(key); !has.True() {
+					errs = append(errs, path.Index(key).NewErrorf("new block key %q has appeared", key.AsString()))
+				}
+			}
+
+		case tfschema.NestingSet:
+			// Set elements can't be correlated, so the best we can do is
+			// to check that a wholly-known planned set was applied exactly
+			// and that the number of blocks is unchanged otherwise.
+			errs = append(errs, assertSetCompatible(plannedV, actualV, path)...)
+		}
+	}
+
+	return errs
+}
+
+// assertNestedTypeCompatible checks the value of an attribute with a nested
+// type, checking each of the nested objects against the nested attributes in
+// the same way as for the attributes of a nested block.
+func assertNestedTypeCompatible(schema *tfschema.NestedAttributeType, planned, actual cty.Value, path cty.Path) []error {
+	if !planned.IsKnown() || !actual.IsKnown() || planned.IsNull() || actual.IsNull() {
+		return assertValueCompatible(planned, actual, path)
+	}
+
+	var errs []error
+	content := &tfschema.BlockType{Attributes: schema.Attributes}
+	switch schema.Nesting {
+	case tfschema.NestingSingle, tfschema.NestingGroup:
+		errs = append(errs, assertObjectCompatible(content, planned, actual, path)...)
+
+	case tfschema.NestingList:
+		plannedLen, actualLen := planned.LengthInt(), actual.LengthInt()
+		if plannedLen != actualLen {
+			errs = append(errs, path.NewErrorf("length changed from %d to %d", plannedLen, actualLen))
+			return errs
+		}
+		for it := planned.ElementIterator(); it.Next(); {
+			idx, plannedEV := it.Element()
+			path := append(path, cty.IndexStep{Key: idx})
+			errs = append(errs, assertObjectCompatible(content, plannedEV, actual.Index(idx), path)...)
+		}
+
+	case tfschema.NestingMap:
+		for it := planned.ElementIterator(); it.Next(); {
+			key, plannedEV := it.Element()
+			path := append(path, cty.IndexStep{Key: key})
+			if has := actual.HasIndex(key); !has.True() {
+				errs = append(errs, path.NewErrorf("element %q has vanished", key.AsString()))
+				continue
+			}
+			errs = append(errs, assertObjectCompatible(content, plannedEV, actual.Index(key), path)...)
+		}
+		for it := actual.ElementIterator(); it.Next(); {
+			key, _ := it.Element()
+			if has := planned.HasIndex(key); !has.True() {
+				errs = append(errs, path.Index(key).NewErrorf("new element %q has appeared", key.AsString()))
+			}
+		}
+
+	case tfschema.NestingSet:
+		errs = append(errs, assertSetCompatible(planned, actual, path)...)
+	}
+
+	return errs
+}
+
+// assertValueCompatible checks a single attribute value, or the value of a
+// whole nested block collection, recursing into collection and structural
+// types so that unknown values nested inside the planned value are allowed
+// to take any value in the actual value.
+func assertValueCompatible(planned, actual cty.Value, path cty.Path) []error {
+	var errs []error
+	if !planned.IsKnown() {
+		// Anything goes, then.
+		return errs
+	}
+	if !actual.IsKnown() {
+		errs = append(errs, path.NewErrorf("was known, but now unknown"))
+		return errs
+	}
+	if planned.IsNull() || actual.IsNull() {
+		if planned.IsNull() != actual.IsNull() {
+			errs = append(errs, path.NewErrorf("was %#v, but now %#v", planned, actual))
+		}
+		return errs
+	}
+
+	ty := planned.Type()
+	switch {
+	case ty.IsListType() || ty.IsTupleType():
+		plannedLen, actualLen := planned.LengthInt(), actual.LengthInt()
+		if plannedLen != actualLen {
+			errs = append(errs, path.NewErrorf("length changed from %d to %d", plannedLen, actualLen))
+			return errs
+		}
+		for it := planned.ElementIterator(); it.Next(); {
+			idx, plannedEV := it.Element()
+			path := append(path, cty.IndexStep{Key: idx})
+			errs = append(errs, assertValueCompatible(plannedEV, actual.Index(idx), path)...)
+		}
+
+	case ty.IsMapType():
+		for it := planned.ElementIterator(); it.Next(); {
+			key, plannedEV := it.Element()
+			path := append(path, cty.IndexStep{Key: key})
+			if has := actual.HasIndex(key); !has.True() {
+				errs = append(errs, path.NewErrorf("element %q has vanished", key.AsString()))
+				continue
+			}
+			errs = append(errs, assertValueCompatible(plannedEV, actual.Index(key), path)...)
+		}
+		for it := actual.ElementIterator(); it.Next(); {
+			key, _ := it.Element()
+			if has := planned.HasIndex(key); !has.True() {
+				errs = append(errs, path.Index(key).NewErrorf("new element %q has appeared", key.AsString()))
+			}
+		}
+
+	case ty.IsObjectType():
+		for name := range ty.AttributeTypes() {
+			path := append(path, cty.GetAttrStep{Name: name})
+			errs = append(errs, assertValueCompatible(planned.GetAttr(name), actual.GetAttr(name), path)...)
+		}
+
+	case ty.IsSetType():
+		errs = append(errs, assertSetCompatible(planned, actual, path)...)
+
+	default:
+		if !valuesSame(planned, actual) {
+			errs = append(errs, path.NewErrorf("was %#v, but now %#v", planned, actual))
+		}
+	}
+
+	return errs
+}
+
+func assertSetCompatible(planned, actual cty.Value, path cty.Path) []error {
+	var errs []error
+	if !planned.IsKnown() {
+		return errs
+	}
+	if !actual.IsKnown() {
+		errs = append(errs, path.NewErrorf("was known, but now unknown"))
+		return errs
+	}
+	if planned.IsWhollyKnown() {
+		if !valuesSame(planned, actual) {
+			errs = append(errs, path.NewErrorf("was %#v, but now %#v", planned, actual))
+		}
+		return errs
+	}
+	if planned.IsNull() || actual.IsNull() {
+		if planned.IsNull() != actual.IsNull() {
+			errs = append(errs, path.NewErrorf("was %#v, but now %#v", planned, actual))
+		}
+		return errs
+	}
+	// Set elements containing unknown values may coalesce once they become
+	// known, so the actual set may have fewer elements but never more.
+	if plannedLen, actualLen := planned.LengthInt(), actual.LengthInt(); actualLen > plannedLen {
+		errs = append(errs, path.NewErrorf("length changed from %d to %d", plannedLen, actualLen))
+	}
+	return errs
+}
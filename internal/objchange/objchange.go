@@ -0,0 +1,270 @@
+// Package objchange contains the rules that Terraform Core uses to derive
+// and check planned and applied values for managed resource instances.
+//
+// These are separated into their own package so that both the main tfsdk
+// package and the in-process test harness can apply them, and thus catch
+// provider bugs before Terraform Core would report them.
+package objchange
+
+import (
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ProposedNewObject constructs a proposed new object value by combining the
+// computed attribute values from the given prior object with the configured
+// attribute values from the given config object, in the same way that
+// Terraform Core does before asking a provider to plan a change.
+//
+// Both values must conform to the type implied by the given schema. If the
+// config is null then the result is also null, representing a planned
+// destroy.
+func ProposedNewObject(schema *tfschema.BlockType, prior, config cty.Value) cty.Value {
+	if config.IsNull() || !config.IsKnown() {
+		return config
+	}
+	if prior.IsNull() {
+		// Synthesizing an object with all attributes null means that the
+		// logic below needs only to deal with a non-null prior object.
+		prior = allAttributesNull(schema)
+	}
+	return proposedNewObject(schema, prior, config)
+}
+
+func proposedNewObject(schema *tfschema.BlockType, prior, config cty.Value) cty.Value {
+	if config.IsNull() || !config.IsKnown() {
+		return config
+	}
+	if prior.IsNull() || !prior.IsKnown() {
+		prior = allAttributesNull(schema)
+	}
+
+	vals := make(map[string]cty.Value)
+	for name, attrS := range schema.Attributes {
+		priorV := prior.GetAttr(name)
+		configV := config.GetAttr(name)
+		switch {
+		case isComputed(attrS) && attrS.Optional && configV.IsNull():
+			vals[name] = priorV
+		case isComputed(attrS) && !attrS.Optional:
+			vals[name] = priorV
+		case attrS.NestedType != nil:
+			// The attributes inside a nested type each have their own
+			// rules, so we must build the value from them individually.
+			vals[name] = proposedNewNestedType(attrS.NestedType, priorV, configV)
+		default:
+			vals[name] = configV
+		}
+	}
+
+	for name, blockS := range schema.NestedBlockTypes {
+		vals[name] = proposedNewNestedBlock(blockS, prior.GetAttr(name), config.GetAttr(name))
+	}
+
+	return cty.ObjectVal(vals)
+}
+
+// proposedNewNestedType is like proposedNewNestedBlock but for the value of
+// an attribute with a nested type, whose value and nested objects may also
+// be null or unknown.
+func proposedNewNestedType(schema *tfschema.NestedAttributeType, prior, config cty.Value) cty.Value {
+	if config.IsNull() || !config.IsKnown() {
+		return config
+	}
+	if !prior.IsKnown() {
+		prior = cty.NullVal(prior.Type())
+	}
+	return proposedNewNestedBlock(&tfschema.NestedBlockType{
+		Nesting: schema.Nesting,
+		Content: tfschema.BlockType{Attributes: schema.Attributes},
+	}, prior, config)
+}
+
+func proposedNewNestedBlock(schema *tfschema.NestedBlockType, prior, config cty.Value) cty.Value {
+	if config.IsNull() || !config.IsKnown() {
+		return config
+	}
+
+	switch schema.Nesting {
+	case tfschema.NestingSingle, tfschema.NestingGroup:
+		return proposedNewObject(&schema.Content, prior, config)
+
+	case tfschema.NestingList:
+		priorLen := 0
+		if !prior.IsNull() && prior.IsKnown() {
+			priorLen = prior.LengthInt()
+		}
+		vals := make([]cty.Value, 0, config.LengthInt())
+		for it := config.ElementIterator(); it.Next(); {
+			idx, configEV := it.Element()
+			priorEV := allAttributesNull(&schema.Content)
+			if i, _ := idx.AsBigFloat().Int64(); int(i) < priorLen {
+				priorEV = prior.Index(idx)
+			}
+			vals = append(vals, proposedNewObject(&schema.Content, priorEV, configEV))
+		}
+		return rebuildCollection(config.Type(), vals, nil)
+
+	case tfschema.NestingMap:
+		vals := make(map[string]cty.Value, config.LengthInt())
+		for it := config.ElementIterator(); it.Next(); {
+			key, configEV := it.Element()
+			priorEV := allAttributesNull(&schema.Content)
+			if !prior.IsNull() && prior.IsKnown() {
+				if has := prior.HasIndex(key); has.IsKnown() && has.True() {
+					priorEV = prior.Index(key)
+				}
+			}
+			vals[key.AsString()] = proposedNewObject(&schema.Content, priorEV, configEV)
+		}
+		return rebuildCollection(config.Type(), nil, vals)
+
+	case tfschema.NestingSet:
+		// Set elements have no key to correlate them, so we match elements
+		// whose non-computed attributes are identical. In practice this
+		// means that any change to the configuration of an element produces
+		// an entirely new element, and prior computed values are retained
+		// only for elements that are unchanged.
+		var priorEVs []cty.Value
+		if !prior.IsNull() && prior.IsKnown() {
+			for it := prior.ElementIterator(); it.Next(); {
+				_, priorEV := it.Element()
+				priorEVs = append(priorEVs, priorEV)
+			}
+		}
+		used := make([]bool, len(priorEVs))
+
+		vals := make([]cty.Value, 0, config.LengthInt())
+		for it := config.ElementIterator(); it.Next(); {
+			_, configEV := it.Element()
+			configCmp := setElementCompareValue(&schema.Content, configEV)
+			priorEV := allAttributesNull(&schema.Content)
+			for i, candidate := range priorEVs {
+				if used[i] {
+					continue
+				}
+				if setElementCompareValue(&schema.Content, candidate).RawEquals(configCmp) {
+					priorEV = candidate
+					used[i] = true
+					break
+				}
+			}
+			vals = append(vals, proposedNewObject(&schema.Content, priorEV, configEV))
+		}
+		return rebuildCollection(config.Type(), vals, nil)
+
+	default:
+		// Invalid nesting modes are caught during validation, so we'll
+		// just pass through the config value here.
+		return config
+	}
+}
+
+// setElementCompareValue returns a version of the given set element value
+// with all of its computed attribute values replaced with nulls, for use when
+// correlating set elements between prior and config.
+func setElementCompareValue(schema *tfschema.BlockType, v cty.Value) cty.Value {
+	if v.IsNull() || !v.IsKnown() {
+		return v
+	}
+
+	vals := make(map[string]cty.Value)
+	for name, attrS := range schema.Attributes {
+		if isComputed(attrS) {
+			vals[name] = cty.NullVal(attrS.ImpliedCtyType())
+		} else {
+			vals[name] = v.GetAttr(name)
+		}
+	}
+	for name, blockS := range schema.NestedBlockTypes {
+		bv := v.GetAttr(name)
+		switch {
+		case bv.IsNull() || !bv.IsKnown():
+			vals[name] = bv
+		case blockS.Nesting == tfschema.NestingSingle || blockS.Nesting == tfschema.NestingGroup:
+			vals[name] = setElementCompareValue(&blockS.Content, bv)
+		case blockS.Nesting == tfschema.NestingMap:
+			evs := make(map[string]cty.Value)
+			for it := bv.ElementIterator(); it.Next(); {
+				k, ev := it.Element()
+				evs[k.AsString()] = setElementCompareValue(&blockS.Content, ev)
+			}
+			vals[name] = rebuildCollection(bv.Type(), nil, evs)
+		default:
+			var evs []cty.Value
+			for it := bv.ElementIterator(); it.Next(); {
+				_, ev := it.Element()
+				evs = append(evs, setElementCompareValue(&blockS.Content, ev))
+			}
+			vals[name] = rebuildCollection(bv.Type(), evs, nil)
+		}
+	}
+	return cty.ObjectVal(vals)
+}
+
+// allAttributesNull returns an object conforming to the given schema where
+// all of the attributes are null and all of the nested blocks are absent,
+// similar to the result of decoding an empty configuration block.
+func allAttributesNull(schema *tfschema.BlockType) cty.Value {
+	vals := make(map[string]cty.Value)
+	for name, attrS := range schema.Attributes {
+		vals[name] = cty.NullVal(attrS.ImpliedCtyType())
+	}
+	for name, blockS := range schema.NestedBlockTypes {
+		ty := schema.ImpliedCtyType().AttributeType(name)
+		switch {
+		case blockS.Nesting == tfschema.NestingSingle || blockS.Nesting == tfschema.NestingGroup:
+			vals[name] = cty.NullVal(ty)
+		case ty.IsListType():
+			vals[name] = cty.ListValEmpty(ty.ElementType())
+		case ty.IsSetType():
+			vals[name] = cty.SetValEmpty(ty.ElementType())
+		case ty.IsMapType():
+			vals[name] = cty.MapValEmpty(ty.ElementType())
+		case blockS.Nesting == tfschema.NestingMap:
+			vals[name] = cty.EmptyObjectVal
+		default:
+			vals[name] = cty.EmptyTupleVal
+		}
+	}
+	return cty.ObjectVal(vals)
+}
+
+// rebuildCollection constructs a new collection value of the same kind as
+// the given type from either the given sequence elements or the given map
+// elements, whichever is relevant to the type.
+//
+// Types that are not collection types are assumed to be the tuple or object
+// types used to represent nested blocks containing dynamically-typed
+// attributes.
+func rebuildCollection(ty cty.Type, seq []cty.Value, m map[string]cty.Value) cty.Value {
+	switch {
+	case ty.IsListType():
+		if len(seq) == 0 {
+			return cty.ListValEmpty(ty.ElementType())
+		}
+		return cty.ListVal(seq)
+	case ty.IsSetType():
+		if len(seq) == 0 {
+			return cty.SetValEmpty(ty.ElementType())
+		}
+		return cty.SetVal(seq)
+	case ty.IsMapType():
+		if len(m) == 0 {
+			return cty.MapValEmpty(ty.ElementType())
+		}
+		return cty.MapVal(m)
+	case ty.IsObjectType():
+		return cty.ObjectVal(m)
+	default:
+		return cty.TupleVal(seq)
+	}
+}
+
+// isComputed returns true if the provider may decide the value of the given
+// attribute. Attributes with either Default or DefaultFn set are presented to
+// Terraform Core as computed, because the provider inserts the default during
+// planning.
+func isComputed(attrS *tfschema.Attribute) bool {
+	return attrS.Computed || attrS.Default != nil || attrS.DefaultFn != nil
+}
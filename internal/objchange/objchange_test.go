@@ -0,0 +1,280 @@
+package objchange
+
+import (
+	"testing"
+
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var testSchema = &tfschema.BlockType{
+	Attributes: map[string]*tfschema.Attribute{
+		"id":   {Type: cty.String, Computed: true},
+		"name": {Type: cty.String, Required: true},
+		"size": {Type: cty.Number, Optional: true, Computed: true},
+	},
+	NestedBlockTypes: map[string]*tfschema.NestedBlockType{
+		"disk": {
+			Nesting: tfschema.NestingList,
+			Content: tfschema.BlockType{
+				Attributes: map[string]*tfschema.Attribute{
+					"label": {Type: cty.String, Required: true},
+					"uuid":  {Type: cty.String, Computed: true},
+				},
+			},
+		},
+	},
+}
+
+func testObject(id, name cty.Value, size cty.Value, disks ...cty.Value) cty.Value {
+	diskTy := testSchema.ImpliedCtyType().AttributeType("disk")
+	disk := cty.ListValEmpty(diskTy.ElementType())
+	if len(disks) > 0 {
+		disk = cty.ListVal(disks)
+	}
+	return cty.ObjectVal(map[string]cty.Value{
+		"id":   id,
+		"name": name,
+		"size": size,
+		"disk": disk,
+	})
+}
+
+func testDisk(label, uuid cty.Value) cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"label": label,
+		"uuid":  uuid,
+	})
+}
+
+func TestProposedNewObject(t *testing.T) {
+	prior := testObject(
+		cty.StringVal("i-abc"), cty.StringVal("a"), cty.NumberIntVal(2),
+		testDisk(cty.StringVal("root"), cty.StringVal("u-1")),
+	)
+	config := testObject(
+		cty.NullVal(cty.String), cty.StringVal("b"), cty.NullVal(cty.Number),
+		testDisk(cty.StringVal("root"), cty.NullVal(cty.String)),
+		testDisk(cty.StringVal("data"), cty.NullVal(cty.String)),
+	)
+	want := testObject(
+		cty.StringVal("i-abc"), cty.StringVal("b"), cty.NumberIntVal(2),
+		testDisk(cty.StringVal("root"), cty.StringVal("u-1")),
+		testDisk(cty.StringVal("data"), cty.NullVal(cty.String)),
+	)
+
+	got := ProposedNewObject(testSchema, prior, config)
+	if !want.RawEquals(got) {
+		t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, want)
+	}
+}
+
+func TestAssertPlanValid(t *testing.T) {
+	prior := cty.NullVal(testSchema.ImpliedCtyType())
+	config := testObject(
+		cty.NullVal(cty.String), cty.StringVal("a"), cty.NullVal(cty.Number),
+		testDisk(cty.StringVal("root"), cty.NullVal(cty.String)),
+	)
+
+	t.Run("valid", func(t *testing.T) {
+		planned := testObject(
+			cty.UnknownVal(cty.String), cty.StringVal("a"), cty.NumberIntVal(1),
+			testDisk(cty.StringVal("root"), cty.UnknownVal(cty.String)),
+		)
+		if errs := AssertPlanValid(testSchema, prior, config, planned); len(errs) != 0 {
+			t.Errorf("unexpected errors: %#v", errs)
+		}
+	})
+	t.Run("changed required attribute", func(t *testing.T) {
+		planned := testObject(
+			cty.UnknownVal(cty.String), cty.StringVal("b"), cty.NumberIntVal(1),
+			testDisk(cty.StringVal("root"), cty.UnknownVal(cty.String)),
+		)
+		errs := AssertPlanValid(testSchema, prior, config, planned)
+		if len(errs) != 1 {
+			t.Fatalf("wrong number of errors %d; want 1", len(errs))
+		}
+		if got, want := errs[0].(cty.PathError).Path, cty.GetAttrPath("name"); !want.Equals(got) {
+			t.Errorf("wrong error path %#v; want %#v", got, want)
+		}
+	})
+	t.Run("kept prior value", func(t *testing.T) {
+		prior := testObject(
+			cty.StringVal("i-abc"), cty.StringVal("A"), cty.NumberIntVal(1),
+			testDisk(cty.StringVal("root"), cty.StringVal("u-1")),
+		)
+		planned := testObject(
+			cty.StringVal("i-abc"), cty.StringVal("A"), cty.NumberIntVal(1),
+			testDisk(cty.StringVal("root"), cty.StringVal("u-1")),
+		)
+		if errs := AssertPlanValid(testSchema, prior, config, planned); len(errs) != 0 {
+			t.Errorf("unexpected errors: %#v", errs)
+		}
+	})
+	t.Run("changed nested block attribute", func(t *testing.T) {
+		planned := testObject(
+			cty.UnknownVal(cty.String), cty.StringVal("a"), cty.NumberIntVal(1),
+			testDisk(cty.StringVal("boot"), cty.UnknownVal(cty.String)),
+		)
+		errs := AssertPlanValid(testSchema, prior, config, planned)
+		if len(errs) != 1 {
+			t.Fatalf("wrong number of errors %d; want 1", len(errs))
+		}
+		want := cty.GetAttrPath("disk").Index(cty.NumberIntVal(0)).GetAttr("label")
+		if got := errs[0].(cty.PathError).Path; !want.Equals(got) {
+			t.Errorf("wrong error path %#v; want %#v", got, want)
+		}
+	})
+}
+
+func TestAssertObjectCompatible(t *testing.T) {
+	planned := testObject(
+		cty.UnknownVal(cty.String), cty.StringVal("a"), cty.NumberIntVal(1),
+		testDisk(cty.StringVal("root"), cty.UnknownVal(cty.String)),
+	)
+
+	t.Run("compatible", func(t *testing.T) {
+		actual := testObject(
+			cty.StringVal("i-abc"), cty.StringVal("a"), cty.NumberIntVal(1),
+			testDisk(cty.StringVal("root"), cty.StringVal("u-1")),
+		)
+		if errs := AssertObjectCompatible(testSchema, planned, actual); len(errs) != 0 {
+			t.Errorf("unexpected errors: %#v", errs)
+		}
+	})
+	t.Run("changed known value", func(t *testing.T) {
+		actual := testObject(
+			cty.StringVal("i-abc"), cty.StringVal("a"), cty.NumberIntVal(2),
+			testDisk(cty.StringVal("root"), cty.StringVal("u-1")),
+		)
+		errs := AssertObjectCompatible(testSchema, planned, actual)
+		if len(errs) != 1 {
+			t.Fatalf("wrong number of errors %d; want 1", len(errs))
+		}
+		if got, want := errs[0].(cty.PathError).Path, cty.GetAttrPath("size"); !want.Equals(got) {
+			t.Errorf("wrong error path %#v; want %#v", got, want)
+		}
+	})
+}
+
+var testNestedTypeSchema = &tfschema.BlockType{
+	Attributes: map[string]*tfschema.Attribute{
+		"rule": {
+			NestedType: &tfschema.NestedAttributeType{
+				Nesting: tfschema.NestingList,
+				Attributes: map[string]*tfschema.Attribute{
+					"port": {Type: cty.Number, Required: true},
+					"id":   {Type: cty.String, Computed: true},
+				},
+			},
+			Optional: true,
+		},
+	},
+}
+
+func testNestedTypeObject(rules ...cty.Value) cty.Value {
+	ruleTy := testNestedTypeSchema.ImpliedCtyType().AttributeType("rule")
+	rule := cty.NullVal(ruleTy)
+	if len(rules) > 0 {
+		rule = cty.ListVal(rules)
+	}
+	return cty.ObjectVal(map[string]cty.Value{
+		"rule": rule,
+	})
+}
+
+func testRule(port, id cty.Value) cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"port": port,
+		"id":   id,
+	})
+}
+
+func TestProposedNewObjectNestedType(t *testing.T) {
+	prior := testNestedTypeObject(
+		testRule(cty.NumberIntVal(80), cty.StringVal("r-1")),
+	)
+	config := testNestedTypeObject(
+		testRule(cty.NumberIntVal(80), cty.NullVal(cty.String)),
+		testRule(cty.NumberIntVal(443), cty.NullVal(cty.String)),
+	)
+	want := testNestedTypeObject(
+		testRule(cty.NumberIntVal(80), cty.StringVal("r-1")),
+		testRule(cty.NumberIntVal(443), cty.NullVal(cty.String)),
+	)
+
+	got := ProposedNewObject(testNestedTypeSchema, prior, config)
+	if !want.RawEquals(got) {
+		t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, want)
+	}
+}
+
+func TestAssertPlanValidNestedType(t *testing.T) {
+	prior := cty.NullVal(testNestedTypeSchema.ImpliedCtyType())
+	config := testNestedTypeObject(
+		testRule(cty.NumberIntVal(80), cty.NullVal(cty.String)),
+	)
+
+	t.Run("valid", func(t *testing.T) {
+		planned := testNestedTypeObject(
+			testRule(cty.NumberIntVal(80), cty.UnknownVal(cty.String)),
+		)
+		if errs := AssertPlanValid(testNestedTypeSchema, prior, config, planned); len(errs) != 0 {
+			t.Errorf("unexpected errors: %#v", errs)
+		}
+	})
+	t.Run("changed required nested attribute", func(t *testing.T) {
+		planned := testNestedTypeObject(
+			testRule(cty.NumberIntVal(443), cty.UnknownVal(cty.String)),
+		)
+		errs := AssertPlanValid(testNestedTypeSchema, prior, config, planned)
+		if len(errs) != 1 {
+			t.Fatalf("wrong number of errors %d; want 1", len(errs))
+		}
+		want := cty.GetAttrPath("rule").Index(cty.NumberIntVal(0)).GetAttr("port")
+		if got := errs[0].(cty.PathError).Path; !want.Equals(got) {
+			t.Errorf("wrong error path %#v; want %#v", got, want)
+		}
+	})
+	t.Run("added element", func(t *testing.T) {
+		planned := testNestedTypeObject(
+			testRule(cty.NumberIntVal(80), cty.UnknownVal(cty.String)),
+			testRule(cty.NumberIntVal(443), cty.UnknownVal(cty.String)),
+		)
+		errs := AssertPlanValid(testNestedTypeSchema, prior, config, planned)
+		if len(errs) != 1 {
+			t.Fatalf("wrong number of errors %d; want 1", len(errs))
+		}
+		if got, want := errs[0].(cty.PathError).Path, cty.GetAttrPath("rule"); !want.Equals(got) {
+			t.Errorf("wrong error path %#v; want %#v", got, want)
+		}
+	})
+}
+
+func TestAssertObjectCompatibleNestedType(t *testing.T) {
+	planned := testNestedTypeObject(
+		testRule(cty.NumberIntVal(80), cty.UnknownVal(cty.String)),
+	)
+
+	t.Run("compatible", func(t *testing.T) {
+		actual := testNestedTypeObject(
+			testRule(cty.NumberIntVal(80), cty.StringVal("r-1")),
+		)
+		if errs := AssertObjectCompatible(testNestedTypeSchema, planned, actual); len(errs) != 0 {
+			t.Errorf("unexpected errors: %#v", errs)
+		}
+	})
+	t.Run("changed known nested value", func(t *testing.T) {
+		actual := testNestedTypeObject(
+			testRule(cty.NumberIntVal(443), cty.StringVal("r-1")),
+		)
+		errs := AssertObjectCompatible(testNestedTypeSchema, planned, actual)
+		if len(errs) != 1 {
+			t.Fatalf("wrong number of errors %d; want 1", len(errs))
+		}
+		want := cty.GetAttrPath("rule").Index(cty.NumberIntVal(0)).GetAttr("port")
+		if got := errs[0].(cty.PathError).Path; !want.Equals(got) {
+			t.Errorf("wrong error path %#v; want %#v", got, want)
+		}
+	})
+}
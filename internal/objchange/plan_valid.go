@@ -0,0 +1,310 @@
+package objchange
+
+import (
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// AssertPlanValid checks the given planned object against the prior and
+// config objects it was derived from, returning errors for any violations of
+// the rules that Terraform Core applies to a provider's planned new state.
+//
+// The returned errors are cty.PathError values whose paths indicate the
+// offending attribute or block within the object. If the result is empty
+// then the plan is valid.
+func AssertPlanValid(schema *tfschema.BlockType, prior, config, planned cty.Value) []error {
+	return assertPlanValid(schema, prior, config, planned, nil)
+}
+
+func assertPlanValid(schema *tfschema.BlockType, prior, config, planned cty.Value, path cty.Path) []error {
+	var errs []error
+	if planned.IsNull() && !config.IsNull() {
+		errs = append(errs, path.NewErrorf("planned for absence but config wants existence"))
+		return errs
+	}
+	if config.IsNull() && !planned.IsNull() {
+		errs = append(errs, path.NewErrorf("planned for existence but config wants absence"))
+		return errs
+	}
+	if planned.IsNull() {
+		// No further checks are needed for a planned destroy.
+		return errs
+	}
+	if !planned.IsKnown() {
+		errs = append(errs, path.NewErrorf("planned object must not be unknown itself; set nested attribute values to unknown instead"))
+		return errs
+	}
+	if prior.IsNull() || !prior.IsKnown() {
+		prior = allAttributesNull(schema)
+	}
+
+	for name, attrS := range schema.Attributes {
+		path := append(path, cty.GetAttrStep{Name: name})
+		errs = append(errs, assertPlannedValueValid(attrS, prior.GetAttr(name), config.GetAttr(name), planned.GetAttr(name), path)...)
+	}
+
+	for name, blockS := range schema.NestedBlockTypes {
+		path := append(path, cty.GetAttrStep{Name: name})
+		errs = append(errs, assertPlannedNestedBlockValid(blockS, prior.GetAttr(name), config.GetAttr(name), planned.GetAttr(name), path)...)
+	}
+
+	return errs
+}
+
+func assertPlannedValueValid(attrS *tfschema.Attribute, prior, config, planned cty.Value, path cty.Path) []error {
+	var errs []error
+	if !prior.IsNull() && !config.IsNull() && valuesSame(planned, prior) {
+		// The provider may return the prior value unchanged to indicate that
+		// it is functionally equivalent to the configured value.
+		return errs
+	}
+	if attrS.NestedType != nil && !config.IsNull() && config.IsKnown() {
+		// The attributes inside a nested type each have their own rules,
+		// so we check them individually rather than requiring the whole
+		// value to match the configuration.
+		return assertPlannedNestedTypeValid(attrS.NestedType, prior, config, planned, path)
+	}
+	if !isComputed(attrS) {
+		// A non-computed attribute must always be planned exactly as
+		// written in the configuration.
+		if !valuesSame(planned, config) {
+			errs = append(errs, path.NewErrorf("planned value %#v does not match config value %#v", planned, config))
+		}
+		return errs
+	}
+
+	if !config.IsNull() {
+		// An optional+computed attribute that is set in configuration is
+		// subject to the same rule as a non-computed attribute.
+		if !valuesSame(planned, config) {
+			errs = append(errs, path.NewErrorf("planned value %#v does not match config value %#v", planned, config))
+		}
+		return errs
+	}
+
+	// If we get here then the attribute is computed and not set in the
+	// configuration, so the provider may plan any value at all.
+	return errs
+}
+
+func assertPlannedNestedTypeValid(schema *tfschema.NestedAttributeType, prior, config, planned cty.Value, path cty.Path) []error {
+	var errs []error
+	if planned.IsNull() {
+		errs = append(errs, path.NewErrorf("planned for absence but config wants existence"))
+		return errs
+	}
+	if !planned.IsKnown() {
+		errs = append(errs, path.NewErrorf("planned unknown for configured value"))
+		return errs
+	}
+	if !prior.IsKnown() {
+		prior = cty.NullVal(planned.Type())
+	}
+
+	switch schema.Nesting {
+	case tfschema.NestingSingle, tfschema.NestingGroup:
+		errs = append(errs, assertPlannedNestedObjectValid(schema.Attributes, prior, config, planned, path)...)
+
+	case tfschema.NestingList:
+		plannedLen, configLen := planned.LengthInt(), config.LengthInt()
+		if plannedLen != configLen {
+			errs = append(errs, path.NewErrorf("count in plan (%d) disagrees with count in config (%d)", plannedLen, configLen))
+			return errs
+		}
+		priorLen := 0
+		if !prior.IsNull() {
+			priorLen = prior.LengthInt()
+		}
+		for it := planned.ElementIterator(); it.Next(); {
+			idx, plannedEV := it.Element()
+			path := append(path, cty.IndexStep{Key: idx})
+			priorEV := cty.NullVal(plannedEV.Type())
+			if i, _ := idx.AsBigFloat().Int64(); int(i) < priorLen {
+				priorEV = prior.Index(idx)
+			}
+			errs = append(errs, assertPlannedNestedObjectValid(schema.Attributes, priorEV, config.Index(idx), plannedEV, path)...)
+		}
+
+	case tfschema.NestingMap:
+		for it := planned.ElementIterator(); it.Next(); {
+			key, plannedEV := it.Element()
+			path := append(path, cty.IndexStep{Key: key})
+			if has := config.HasIndex(key); !has.True() {
+				errs = append(errs, path.NewErrorf("map key %q is planned but not present in config", key.AsString()))
+				continue
+			}
+			priorEV := cty.NullVal(plannedEV.Type())
+			if !prior.IsNull() {
+				if has := prior.HasIndex(key); has.IsKnown() && has.True() {
+					priorEV = prior.Index(key)
+				}
+			}
+			errs = append(errs, assertPlannedNestedObjectValid(schema.Attributes, priorEV, config.Index(key), plannedEV, path)...)
+		}
+		for it := config.ElementIterator(); it.Next(); {
+			key, _ := it.Element()
+			if has := planned.HasIndex(key); !has.True() {
+				errs = append(errs, path.Index(key).NewErrorf("map key %q is present in config but not planned", key.AsString()))
+			}
+		}
+
+	case tfschema.NestingSet:
+		// As for nested blocks backed by a set, we can't correlate the
+		// elements and so can only check that none have been added or
+		// removed. Elements containing unknown values may coalesce once
+		// they are known, so only a wholly-known plan is checked.
+		if !planned.IsWhollyKnown() || !config.IsWhollyKnown() {
+			return errs
+		}
+		if plannedLen, configLen := planned.LengthInt(), config.LengthInt(); plannedLen != configLen {
+			errs = append(errs, path.NewErrorf("count in plan (%d) disagrees with count in config (%d)", plannedLen, configLen))
+		}
+	}
+
+	return errs
+}
+
+// assertPlannedNestedObjectValid checks a single object within the value of
+// an attribute with a nested type, applying the rules for each of the given
+// attributes in turn.
+func assertPlannedNestedObjectValid(attrs map[string]*tfschema.Attribute, prior, config, planned cty.Value, path cty.Path) []error {
+	var errs []error
+	if planned.IsNull() || config.IsNull() {
+		// Unlike nested blocks, the objects in a nested type can be null.
+		if planned.IsNull() != config.IsNull() {
+			errs = append(errs, path.NewErrorf("planned for %s but config wants %s", existence(planned), existence(config)))
+		}
+		return errs
+	}
+	if !config.IsKnown() {
+		// The whole object is unknown in the configuration, so there's
+		// nothing we can check it against.
+		return errs
+	}
+	if !planned.IsKnown() {
+		errs = append(errs, path.NewErrorf("planned unknown for configured value"))
+		return errs
+	}
+
+	for name, attrS := range attrs {
+		path := append(path, cty.GetAttrStep{Name: name})
+		priorV := cty.NullVal(attrS.ImpliedCtyType())
+		if !prior.IsNull() && prior.IsKnown() {
+			priorV = prior.GetAttr(name)
+		}
+		errs = append(errs, assertPlannedValueValid(attrS, priorV, config.GetAttr(name), planned.GetAttr(name), path)...)
+	}
+	return errs
+}
+
+func assertPlannedNestedBlockValid(schema *tfschema.NestedBlockType, prior, config, planned cty.Value, path cty.Path) []error {
+	var errs []error
+	if !planned.IsKnown() {
+		errs = append(errs, path.NewErrorf("attribute representing nested block must not be unknown itself; set nested attribute values to unknown instead"))
+		return errs
+	}
+	if !config.IsKnown() {
+		// The configuration has an unknown number of blocks, so we can't
+		// correlate the planned blocks with it.
+		return errs
+	}
+
+	switch schema.Nesting {
+	case tfschema.NestingSingle, tfschema.NestingGroup:
+		if prior.IsNull() || !prior.IsKnown() {
+			prior = cty.NullVal(planned.Type())
+		}
+		errs = append(errs, assertPlanValid(&schema.Content, prior, config, planned, path)...)
+
+	case tfschema.NestingList:
+		if planned.IsNull() || config.IsNull() {
+			if planned.IsNull() != config.IsNull() {
+				errs = append(errs, path.NewErrorf("planned for %s but config wants %s", existence(planned), existence(config)))
+			}
+			return errs
+		}
+		plannedLen, configLen := planned.LengthInt(), config.LengthInt()
+		if plannedLen != configLen {
+			errs = append(errs, path.NewErrorf("block count in plan (%d) disagrees with count in config (%d)", plannedLen, configLen))
+			return errs
+		}
+		priorLen := 0
+		if !prior.IsNull() && prior.IsKnown() {
+			priorLen = prior.LengthInt()
+		}
+		for it := planned.ElementIterator(); it.Next(); {
+			idx, plannedEV := it.Element()
+			path := append(path, cty.IndexStep{Key: idx})
+			configEV := config.Index(idx)
+			priorEV := cty.NullVal(plannedEV.Type())
+			if i, _ := idx.AsBigFloat().Int64(); int(i) < priorLen {
+				priorEV = prior.Index(idx)
+			}
+			errs = append(errs, assertPlanValid(&schema.Content, priorEV, configEV, plannedEV, path)...)
+		}
+
+	case tfschema.NestingMap:
+		if planned.IsNull() || config.IsNull() {
+			if planned.IsNull() != config.IsNull() {
+				errs = append(errs, path.NewErrorf("planned for %s but config wants %s", existence(planned), existence(config)))
+			}
+			return errs
+		}
+		for it := planned.ElementIterator(); it.Next(); {
+			key, plannedEV := it.Element()
+			path := append(path, cty.IndexStep{Key: key})
+			if has := config.HasIndex(key); !has.True() {
+				errs = append(errs, path.NewErrorf("block key %q is planned but not present in config", key.AsString()))
+				continue
+			}
+			configEV := config.Index(key)
+			priorEV := cty.NullVal(plannedEV.Type())
+			if !prior.IsNull() && prior.IsKnown() {
+				if has := prior.HasIndex(key); has.IsKnown() && has.True() {
+					priorEV = prior.Index(key)
+				}
+			}
+			errs = append(errs, assertPlanValid(&schema.Content, priorEV, configEV, plannedEV, path)...)
+		}
+		for it := config.ElementIterator(); it.Next(); {
+			key, _ := it.Element()
+			if has := planned.HasIndex(key); !has.True() {
+				errs = append(errs, path.Index(key).NewErrorf("block key %q is present in config but not planned", key.AsString()))
+			}
+		}
+
+	case tfschema.NestingSet:
+		// Because set elements have no identifier with which to correlate
+		// them, we can't robustly validate the plan for a nested block
+		// backed by a set, and so we need to just trust the provider to do
+		// the right thing aside from the most basic checks.
+		if planned.IsNull() {
+			return errs
+		}
+		for it := planned.ElementIterator(); it.Next(); {
+			_, plannedEV := it.Element()
+			if !plannedEV.IsKnown() {
+				errs = append(errs, path.NewErrorf("element representing nested block must not be unknown itself; set nested attribute values to unknown instead"))
+			}
+		}
+	}
+
+	return errs
+}
+
+// valuesSame returns true if the two given values are equal, or if they are
+// both unknown values of the same type.
+func valuesSame(a, b cty.Value) bool {
+	if a.RawEquals(b) {
+		return true
+	}
+	eq := a.Equals(b)
+	return eq.IsKnown() && eq.True()
+}
+
+func existence(v cty.Value) string {
+	if v.IsNull() {
+		return "absence"
+	}
+	return "existence"
+}
@@ -0,0 +1,15 @@
+// Package sdkbridge allows other packages in the SDK module to reach
+// functionality of the main tfsdk package that is not part of its public
+// interface, without creating import cycles.
+//
+// The main tfsdk package populates the variables in this package during its
+// own initialization, so any package that uses them must also import tfsdk.
+package sdkbridge
+
+import (
+	"github.com/apparentlymart/terraform-sdk/internal/tfplugin5"
+)
+
+// NewProviderServer returns a protocol version 5 provider server for the
+// given provider, which must be a *tfsdk.Provider.
+var NewProviderServer func(p interface{}) tfplugin5.ProviderServer
@@ -134,7 +134,7 @@ func FormatPath(path cty.Path) string {
 				// but it's close enough for error reporting.
 				fmt.Fprintf(&buf, "[%q]", step.Key.AsString())
 			case cty.Number:
-				fmt.Fprintf(&buf, "[%s]", step.Key.AsBigFloat())
+				fmt.Fprintf(&buf, "[%s]", step.Key.AsBigFloat().Text('f', -1))
 			default:
 				// A path through a set can contain a key of any type in principle,
 				// but it will never be anything we can render compactly in a
@@ -46,7 +46,7 @@ func (x Diagnostic_Severity) String() string {
 	return proto.EnumName(Diagnostic_Severity_name, int32(x))
 }
 func (Diagnostic_Severity) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{1, 0}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{1, 0}
 }
 
 type Schema_NestedBlock_NestingMode int32
@@ -81,7 +81,7 @@ func (x Schema_NestedBlock_NestingMode) String() string {
 	return proto.EnumName(Schema_NestedBlock_NestingMode_name, int32(x))
 }
 func (Schema_NestedBlock_NestingMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{5, 2, 0}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{5, 2, 0}
 }
 
 // DynamicValue is an opaque encoding of terraform data, with the field name
@@ -98,7 +98,7 @@ func (m *DynamicValue) Reset()         { *m = DynamicValue{} }
 func (m *DynamicValue) String() string { return proto.CompactTextString(m) }
 func (*DynamicValue) ProtoMessage()    {}
 func (*DynamicValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{0}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{0}
 }
 func (m *DynamicValue) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DynamicValue.Unmarshal(m, b)
@@ -146,7 +146,7 @@ func (m *Diagnostic) Reset()         { *m = Diagnostic{} }
 func (m *Diagnostic) String() string { return proto.CompactTextString(m) }
 func (*Diagnostic) ProtoMessage()    {}
 func (*Diagnostic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{1}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{1}
 }
 func (m *Diagnostic) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Diagnostic.Unmarshal(m, b)
@@ -205,7 +205,7 @@ func (m *AttributePath) Reset()         { *m = AttributePath{} }
 func (m *AttributePath) String() string { return proto.CompactTextString(m) }
 func (*AttributePath) ProtoMessage()    {}
 func (*AttributePath) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{2}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{2}
 }
 func (m *AttributePath) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AttributePath.Unmarshal(m, b)
@@ -247,7 +247,7 @@ func (m *AttributePath_Step) Reset()         { *m = AttributePath_Step{} }
 func (m *AttributePath_Step) String() string { return proto.CompactTextString(m) }
 func (*AttributePath_Step) ProtoMessage()    {}
 func (*AttributePath_Step) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{2, 0}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{2, 0}
 }
 func (m *AttributePath_Step) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AttributePath_Step.Unmarshal(m, b)
@@ -407,7 +407,7 @@ func (m *Stop) Reset()         { *m = Stop{} }
 func (m *Stop) String() string { return proto.CompactTextString(m) }
 func (*Stop) ProtoMessage()    {}
 func (*Stop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{3}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{3}
 }
 func (m *Stop) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Stop.Unmarshal(m, b)
@@ -437,7 +437,7 @@ func (m *Stop_Request) Reset()         { *m = Stop_Request{} }
 func (m *Stop_Request) String() string { return proto.CompactTextString(m) }
 func (*Stop_Request) ProtoMessage()    {}
 func (*Stop_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{3, 0}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{3, 0}
 }
 func (m *Stop_Request) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Stop_Request.Unmarshal(m, b)
@@ -468,7 +468,7 @@ func (m *Stop_Response) Reset()         { *m = Stop_Response{} }
 func (m *Stop_Response) String() string { return proto.CompactTextString(m) }
 func (*Stop_Response) ProtoMessage()    {}
 func (*Stop_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{3, 1}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{3, 1}
 }
 func (m *Stop_Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Stop_Response.Unmarshal(m, b)
@@ -510,7 +510,7 @@ func (m *RawState) Reset()         { *m = RawState{} }
 func (m *RawState) String() string { return proto.CompactTextString(m) }
 func (*RawState) ProtoMessage()    {}
 func (*RawState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{4}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{4}
 }
 func (m *RawState) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RawState.Unmarshal(m, b)
@@ -561,7 +561,7 @@ func (m *Schema) Reset()         { *m = Schema{} }
 func (m *Schema) String() string { return proto.CompactTextString(m) }
 func (*Schema) ProtoMessage()    {}
 func (*Schema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{5}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{5}
 }
 func (m *Schema) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Schema.Unmarshal(m, b)
@@ -599,6 +599,7 @@ type Schema_Block struct {
 	Version              int64                 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 	Attributes           []*Schema_Attribute   `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
 	BlockTypes           []*Schema_NestedBlock `protobuf:"bytes,3,rep,name=block_types,json=blockTypes,proto3" json:"block_types,omitempty"`
+	Deprecated           bool                  `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -608,7 +609,7 @@ func (m *Schema_Block) Reset()         { *m = Schema_Block{} }
 func (m *Schema_Block) String() string { return proto.CompactTextString(m) }
 func (*Schema_Block) ProtoMessage()    {}
 func (*Schema_Block) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{5, 0}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{5, 0}
 }
 func (m *Schema_Block) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Schema_Block.Unmarshal(m, b)
@@ -649,6 +650,13 @@ func (m *Schema_Block) GetBlockTypes() []*Schema_NestedBlock {
 	return nil
 }
 
+func (m *Schema_Block) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
+}
+
 type Schema_Attribute struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Type                 []byte   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
@@ -657,6 +665,7 @@ type Schema_Attribute struct {
 	Optional             bool     `protobuf:"varint,5,opt,name=optional,proto3" json:"optional,omitempty"`
 	Computed             bool     `protobuf:"varint,6,opt,name=computed,proto3" json:"computed,omitempty"`
 	Sensitive            bool     `protobuf:"varint,7,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+	Deprecated           bool     `protobuf:"varint,9,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -666,7 +675,7 @@ func (m *Schema_Attribute) Reset()         { *m = Schema_Attribute{} }
 func (m *Schema_Attribute) String() string { return proto.CompactTextString(m) }
 func (*Schema_Attribute) ProtoMessage()    {}
 func (*Schema_Attribute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{5, 1}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{5, 1}
 }
 func (m *Schema_Attribute) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Schema_Attribute.Unmarshal(m, b)
@@ -735,6 +744,13 @@ func (m *Schema_Attribute) GetSensitive() bool {
 	return false
 }
 
+func (m *Schema_Attribute) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
+}
+
 type Schema_NestedBlock struct {
 	TypeName             string                         `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
 	Block                *Schema_Block                  `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
@@ -750,7 +766,7 @@ func (m *Schema_NestedBlock) Reset()         { *m = Schema_NestedBlock{} }
 func (m *Schema_NestedBlock) String() string { return proto.CompactTextString(m) }
 func (*Schema_NestedBlock) ProtoMessage()    {}
 func (*Schema_NestedBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{5, 2}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{5, 2}
 }
 func (m *Schema_NestedBlock) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Schema_NestedBlock.Unmarshal(m, b)
@@ -815,7 +831,7 @@ func (m *GetProviderSchema) Reset()         { *m = GetProviderSchema{} }
 func (m *GetProviderSchema) String() string { return proto.CompactTextString(m) }
 func (*GetProviderSchema) ProtoMessage()    {}
 func (*GetProviderSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{6}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{6}
 }
 func (m *GetProviderSchema) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetProviderSchema.Unmarshal(m, b)
@@ -845,7 +861,7 @@ func (m *GetProviderSchema_Request) Reset()         { *m = GetProviderSchema_Req
 func (m *GetProviderSchema_Request) String() string { return proto.CompactTextString(m) }
 func (*GetProviderSchema_Request) ProtoMessage()    {}
 func (*GetProviderSchema_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{6, 0}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{6, 0}
 }
 func (m *GetProviderSchema_Request) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetProviderSchema_Request.Unmarshal(m, b)
@@ -879,7 +895,7 @@ func (m *GetProviderSchema_Response) Reset()         { *m = GetProviderSchema_Re
 func (m *GetProviderSchema_Response) String() string { return proto.CompactTextString(m) }
 func (*GetProviderSchema_Response) ProtoMessage()    {}
 func (*GetProviderSchema_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{6, 1}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{6, 1}
 }
 func (m *GetProviderSchema_Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetProviderSchema_Response.Unmarshal(m, b)
@@ -937,7 +953,7 @@ func (m *PrepareProviderConfig) Reset()         { *m = PrepareProviderConfig{} }
 func (m *PrepareProviderConfig) String() string { return proto.CompactTextString(m) }
 func (*PrepareProviderConfig) ProtoMessage()    {}
 func (*PrepareProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{7}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{7}
 }
 func (m *PrepareProviderConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PrepareProviderConfig.Unmarshal(m, b)
@@ -968,7 +984,7 @@ func (m *PrepareProviderConfig_Request) Reset()         { *m = PrepareProviderCo
 func (m *PrepareProviderConfig_Request) String() string { return proto.CompactTextString(m) }
 func (*PrepareProviderConfig_Request) ProtoMessage()    {}
 func (*PrepareProviderConfig_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{7, 0}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{7, 0}
 }
 func (m *PrepareProviderConfig_Request) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PrepareProviderConfig_Request.Unmarshal(m, b)
@@ -1007,7 +1023,7 @@ func (m *PrepareProviderConfig_Response) Reset()         { *m = PrepareProviderC
 func (m *PrepareProviderConfig_Response) String() string { return proto.CompactTextString(m) }
 func (*PrepareProviderConfig_Response) ProtoMessage()    {}
 func (*PrepareProviderConfig_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{7, 1}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{7, 1}
 }
 func (m *PrepareProviderConfig_Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PrepareProviderConfig_Response.Unmarshal(m, b)
@@ -1051,7 +1067,7 @@ func (m *UpgradeResourceState) Reset()         { *m = UpgradeResourceState{} }
 func (m *UpgradeResourceState) String() string { return proto.CompactTextString(m) }
 func (*UpgradeResourceState) ProtoMessage()    {}
 func (*UpgradeResourceState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{8}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{8}
 }
 func (m *UpgradeResourceState) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpgradeResourceState.Unmarshal(m, b)
@@ -1090,7 +1106,7 @@ func (m *UpgradeResourceState_Request) Reset()         { *m = UpgradeResourceSta
 func (m *UpgradeResourceState_Request) String() string { return proto.CompactTextString(m) }
 func (*UpgradeResourceState_Request) ProtoMessage()    {}
 func (*UpgradeResourceState_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{8, 0}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{8, 0}
 }
 func (m *UpgradeResourceState_Request) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpgradeResourceState_Request.Unmarshal(m, b)
@@ -1149,7 +1165,7 @@ func (m *UpgradeResourceState_Response) Reset()         { *m = UpgradeResourceSt
 func (m *UpgradeResourceState_Response) String() string { return proto.CompactTextString(m) }
 func (*UpgradeResourceState_Response) ProtoMessage()    {}
 func (*UpgradeResourceState_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{8, 1}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{8, 1}
 }
 func (m *UpgradeResourceState_Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpgradeResourceState_Response.Unmarshal(m, b)
@@ -1193,7 +1209,7 @@ func (m *ValidateResourceTypeConfig) Reset()         { *m = ValidateResourceType
 func (m *ValidateResourceTypeConfig) String() string { return proto.CompactTextString(m) }
 func (*ValidateResourceTypeConfig) ProtoMessage()    {}
 func (*ValidateResourceTypeConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{9}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{9}
 }
 func (m *ValidateResourceTypeConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ValidateResourceTypeConfig.Unmarshal(m, b)
@@ -1225,7 +1241,7 @@ func (m *ValidateResourceTypeConfig_Request) Reset()         { *m = ValidateReso
 func (m *ValidateResourceTypeConfig_Request) String() string { return proto.CompactTextString(m) }
 func (*ValidateResourceTypeConfig_Request) ProtoMessage()    {}
 func (*ValidateResourceTypeConfig_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{9, 0}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{9, 0}
 }
 func (m *ValidateResourceTypeConfig_Request) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ValidateResourceTypeConfig_Request.Unmarshal(m, b)
@@ -1270,7 +1286,7 @@ func (m *ValidateResourceTypeConfig_Response) Reset()         { *m = ValidateRes
 func (m *ValidateResourceTypeConfig_Response) String() string { return proto.CompactTextString(m) }
 func (*ValidateResourceTypeConfig_Response) ProtoMessage()    {}
 func (*ValidateResourceTypeConfig_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{9, 1}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{9, 1}
 }
 func (m *ValidateResourceTypeConfig_Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ValidateResourceTypeConfig_Response.Unmarshal(m, b)
@@ -1307,7 +1323,7 @@ func (m *ValidateDataSourceConfig) Reset()         { *m = ValidateDataSourceConf
 func (m *ValidateDataSourceConfig) String() string { return proto.CompactTextString(m) }
 func (*ValidateDataSourceConfig) ProtoMessage()    {}
 func (*ValidateDataSourceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{10}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{10}
 }
 func (m *ValidateDataSourceConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ValidateDataSourceConfig.Unmarshal(m, b)
@@ -1339,7 +1355,7 @@ func (m *ValidateDataSourceConfig_Request) Reset()         { *m = ValidateDataSo
 func (m *ValidateDataSourceConfig_Request) String() string { return proto.CompactTextString(m) }
 func (*ValidateDataSourceConfig_Request) ProtoMessage()    {}
 func (*ValidateDataSourceConfig_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{10, 0}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{10, 0}
 }
 func (m *ValidateDataSourceConfig_Request) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ValidateDataSourceConfig_Request.Unmarshal(m, b)
@@ -1384,7 +1400,7 @@ func (m *ValidateDataSourceConfig_Response) Reset()         { *m = ValidateDataS
 func (m *ValidateDataSourceConfig_Response) String() string { return proto.CompactTextString(m) }
 func (*ValidateDataSourceConfig_Response) ProtoMessage()    {}
 func (*ValidateDataSourceConfig_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{10, 1}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{10, 1}
 }
 func (m *ValidateDataSourceConfig_Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ValidateDataSourceConfig_Response.Unmarshal(m, b)
@@ -1421,7 +1437,7 @@ func (m *Configure) Reset()         { *m = Configure{} }
 func (m *Configure) String() string { return proto.CompactTextString(m) }
 func (*Configure) ProtoMessage()    {}
 func (*Configure) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{11}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{11}
 }
 func (m *Configure) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Configure.Unmarshal(m, b)
@@ -1453,7 +1469,7 @@ func (m *Configure_Request) Reset()         { *m = Configure_Request{} }
 func (m *Configure_Request) String() string { return proto.CompactTextString(m) }
 func (*Configure_Request) ProtoMessage()    {}
 func (*Configure_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{11, 0}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{11, 0}
 }
 func (m *Configure_Request) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Configure_Request.Unmarshal(m, b)
@@ -1498,7 +1514,7 @@ func (m *Configure_Response) Reset()         { *m = Configure_Response{} }
 func (m *Configure_Response) String() string { return proto.CompactTextString(m) }
 func (*Configure_Response) ProtoMessage()    {}
 func (*Configure_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{11, 1}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{11, 1}
 }
 func (m *Configure_Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Configure_Response.Unmarshal(m, b)
@@ -1535,7 +1551,7 @@ func (m *ReadResource) Reset()         { *m = ReadResource{} }
 func (m *ReadResource) String() string { return proto.CompactTextString(m) }
 func (*ReadResource) ProtoMessage()    {}
 func (*ReadResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{12}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{12}
 }
 func (m *ReadResource) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReadResource.Unmarshal(m, b)
@@ -1558,6 +1574,7 @@ var xxx_messageInfo_ReadResource proto.InternalMessageInfo
 type ReadResource_Request struct {
 	TypeName             string        `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
 	CurrentState         *DynamicValue `protobuf:"bytes,2,opt,name=current_state,json=currentState,proto3" json:"current_state,omitempty"`
+	Private              []byte        `protobuf:"bytes,3,opt,name=private,proto3" json:"private,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -1567,7 +1584,7 @@ func (m *ReadResource_Request) Reset()         { *m = ReadResource_Request{} }
 func (m *ReadResource_Request) String() string { return proto.CompactTextString(m) }
 func (*ReadResource_Request) ProtoMessage()    {}
 func (*ReadResource_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{12, 0}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{12, 0}
 }
 func (m *ReadResource_Request) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReadResource_Request.Unmarshal(m, b)
@@ -1601,9 +1618,17 @@ func (m *ReadResource_Request) GetCurrentState() *DynamicValue {
 	return nil
 }
 
+func (m *ReadResource_Request) GetPrivate() []byte {
+	if m != nil {
+		return m.Private
+	}
+	return nil
+}
+
 type ReadResource_Response struct {
 	NewState             *DynamicValue `protobuf:"bytes,1,opt,name=new_state,json=newState,proto3" json:"new_state,omitempty"`
 	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Private              []byte        `protobuf:"bytes,3,opt,name=private,proto3" json:"private,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -1613,7 +1638,7 @@ func (m *ReadResource_Response) Reset()         { *m = ReadResource_Response{} }
 func (m *ReadResource_Response) String() string { return proto.CompactTextString(m) }
 func (*ReadResource_Response) ProtoMessage()    {}
 func (*ReadResource_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{12, 1}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{12, 1}
 }
 func (m *ReadResource_Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReadResource_Response.Unmarshal(m, b)
@@ -1647,6 +1672,13 @@ func (m *ReadResource_Response) GetDiagnostics() []*Diagnostic {
 	return nil
 }
 
+func (m *ReadResource_Response) GetPrivate() []byte {
+	if m != nil {
+		return m.Private
+	}
+	return nil
+}
+
 type PlanResourceChange struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -1657,7 +1689,7 @@ func (m *PlanResourceChange) Reset()         { *m = PlanResourceChange{} }
 func (m *PlanResourceChange) String() string { return proto.CompactTextString(m) }
 func (*PlanResourceChange) ProtoMessage()    {}
 func (*PlanResourceChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{13}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{13}
 }
 func (m *PlanResourceChange) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PlanResourceChange.Unmarshal(m, b)
@@ -1692,7 +1724,7 @@ func (m *PlanResourceChange_Request) Reset()         { *m = PlanResourceChange_R
 func (m *PlanResourceChange_Request) String() string { return proto.CompactTextString(m) }
 func (*PlanResourceChange_Request) ProtoMessage()    {}
 func (*PlanResourceChange_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{13, 0}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{13, 0}
 }
 func (m *PlanResourceChange_Request) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PlanResourceChange_Request.Unmarshal(m, b)
@@ -1773,7 +1805,7 @@ func (m *PlanResourceChange_Response) Reset()         { *m = PlanResourceChange_
 func (m *PlanResourceChange_Response) String() string { return proto.CompactTextString(m) }
 func (*PlanResourceChange_Response) ProtoMessage()    {}
 func (*PlanResourceChange_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{13, 1}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{13, 1}
 }
 func (m *PlanResourceChange_Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PlanResourceChange_Response.Unmarshal(m, b)
@@ -1838,7 +1870,7 @@ func (m *ApplyResourceChange) Reset()         { *m = ApplyResourceChange{} }
 func (m *ApplyResourceChange) String() string { return proto.CompactTextString(m) }
 func (*ApplyResourceChange) ProtoMessage()    {}
 func (*ApplyResourceChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{14}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{14}
 }
 func (m *ApplyResourceChange) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ApplyResourceChange.Unmarshal(m, b)
@@ -1873,7 +1905,7 @@ func (m *ApplyResourceChange_Request) Reset()         { *m = ApplyResourceChange
 func (m *ApplyResourceChange_Request) String() string { return proto.CompactTextString(m) }
 func (*ApplyResourceChange_Request) ProtoMessage()    {}
 func (*ApplyResourceChange_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{14, 0}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{14, 0}
 }
 func (m *ApplyResourceChange_Request) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ApplyResourceChange_Request.Unmarshal(m, b)
@@ -1953,7 +1985,7 @@ func (m *ApplyResourceChange_Response) Reset()         { *m = ApplyResourceChang
 func (m *ApplyResourceChange_Response) String() string { return proto.CompactTextString(m) }
 func (*ApplyResourceChange_Response) ProtoMessage()    {}
 func (*ApplyResourceChange_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{14, 1}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{14, 1}
 }
 func (m *ApplyResourceChange_Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ApplyResourceChange_Response.Unmarshal(m, b)
@@ -2011,7 +2043,7 @@ func (m *ImportResourceState) Reset()         { *m = ImportResourceState{} }
 func (m *ImportResourceState) String() string { return proto.CompactTextString(m) }
 func (*ImportResourceState) ProtoMessage()    {}
 func (*ImportResourceState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{15}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{15}
 }
 func (m *ImportResourceState) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImportResourceState.Unmarshal(m, b)
@@ -2043,7 +2075,7 @@ func (m *ImportResourceState_Request) Reset()         { *m = ImportResourceState
 func (m *ImportResourceState_Request) String() string { return proto.CompactTextString(m) }
 func (*ImportResourceState_Request) ProtoMessage()    {}
 func (*ImportResourceState_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{15, 0}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{15, 0}
 }
 func (m *ImportResourceState_Request) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImportResourceState_Request.Unmarshal(m, b)
@@ -2090,7 +2122,7 @@ func (m *ImportResourceState_ImportedResource) Reset()         { *m = ImportReso
 func (m *ImportResourceState_ImportedResource) String() string { return proto.CompactTextString(m) }
 func (*ImportResourceState_ImportedResource) ProtoMessage()    {}
 func (*ImportResourceState_ImportedResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{15, 1}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{15, 1}
 }
 func (m *ImportResourceState_ImportedResource) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImportResourceState_ImportedResource.Unmarshal(m, b)
@@ -2143,7 +2175,7 @@ func (m *ImportResourceState_Response) Reset()         { *m = ImportResourceStat
 func (m *ImportResourceState_Response) String() string { return proto.CompactTextString(m) }
 func (*ImportResourceState_Response) ProtoMessage()    {}
 func (*ImportResourceState_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{15, 2}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{15, 2}
 }
 func (m *ImportResourceState_Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImportResourceState_Response.Unmarshal(m, b)
@@ -2187,7 +2219,7 @@ func (m *ReadDataSource) Reset()         { *m = ReadDataSource{} }
 func (m *ReadDataSource) String() string { return proto.CompactTextString(m) }
 func (*ReadDataSource) ProtoMessage()    {}
 func (*ReadDataSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{16}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{16}
 }
 func (m *ReadDataSource) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReadDataSource.Unmarshal(m, b)
@@ -2219,7 +2251,7 @@ func (m *ReadDataSource_Request) Reset()         { *m = ReadDataSource_Request{}
 func (m *ReadDataSource_Request) String() string { return proto.CompactTextString(m) }
 func (*ReadDataSource_Request) ProtoMessage()    {}
 func (*ReadDataSource_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{16, 0}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{16, 0}
 }
 func (m *ReadDataSource_Request) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReadDataSource_Request.Unmarshal(m, b)
@@ -2265,7 +2297,7 @@ func (m *ReadDataSource_Response) Reset()         { *m = ReadDataSource_Response
 func (m *ReadDataSource_Response) String() string { return proto.CompactTextString(m) }
 func (*ReadDataSource_Response) ProtoMessage()    {}
 func (*ReadDataSource_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{16, 1}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{16, 1}
 }
 func (m *ReadDataSource_Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReadDataSource_Response.Unmarshal(m, b)
@@ -2309,7 +2341,7 @@ func (m *GetProvisionerSchema) Reset()         { *m = GetProvisionerSchema{} }
 func (m *GetProvisionerSchema) String() string { return proto.CompactTextString(m) }
 func (*GetProvisionerSchema) ProtoMessage()    {}
 func (*GetProvisionerSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{17}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{17}
 }
 func (m *GetProvisionerSchema) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetProvisionerSchema.Unmarshal(m, b)
@@ -2339,7 +2371,7 @@ func (m *GetProvisionerSchema_Request) Reset()         { *m = GetProvisionerSche
 func (m *GetProvisionerSchema_Request) String() string { return proto.CompactTextString(m) }
 func (*GetProvisionerSchema_Request) ProtoMessage()    {}
 func (*GetProvisionerSchema_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{17, 0}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{17, 0}
 }
 func (m *GetProvisionerSchema_Request) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetProvisionerSchema_Request.Unmarshal(m, b)
@@ -2371,7 +2403,7 @@ func (m *GetProvisionerSchema_Response) Reset()         { *m = GetProvisionerSch
 func (m *GetProvisionerSchema_Response) String() string { return proto.CompactTextString(m) }
 func (*GetProvisionerSchema_Response) ProtoMessage()    {}
 func (*GetProvisionerSchema_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{17, 1}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{17, 1}
 }
 func (m *GetProvisionerSchema_Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetProvisionerSchema_Response.Unmarshal(m, b)
@@ -2415,7 +2447,7 @@ func (m *ValidateProvisionerConfig) Reset()         { *m = ValidateProvisionerCo
 func (m *ValidateProvisionerConfig) String() string { return proto.CompactTextString(m) }
 func (*ValidateProvisionerConfig) ProtoMessage()    {}
 func (*ValidateProvisionerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{18}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{18}
 }
 func (m *ValidateProvisionerConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ValidateProvisionerConfig.Unmarshal(m, b)
@@ -2446,7 +2478,7 @@ func (m *ValidateProvisionerConfig_Request) Reset()         { *m = ValidateProvi
 func (m *ValidateProvisionerConfig_Request) String() string { return proto.CompactTextString(m) }
 func (*ValidateProvisionerConfig_Request) ProtoMessage()    {}
 func (*ValidateProvisionerConfig_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{18, 0}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{18, 0}
 }
 func (m *ValidateProvisionerConfig_Request) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ValidateProvisionerConfig_Request.Unmarshal(m, b)
@@ -2484,7 +2516,7 @@ func (m *ValidateProvisionerConfig_Response) Reset()         { *m = ValidateProv
 func (m *ValidateProvisionerConfig_Response) String() string { return proto.CompactTextString(m) }
 func (*ValidateProvisionerConfig_Response) ProtoMessage()    {}
 func (*ValidateProvisionerConfig_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{18, 1}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{18, 1}
 }
 func (m *ValidateProvisionerConfig_Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ValidateProvisionerConfig_Response.Unmarshal(m, b)
@@ -2521,7 +2553,7 @@ func (m *ProvisionResource) Reset()         { *m = ProvisionResource{} }
 func (m *ProvisionResource) String() string { return proto.CompactTextString(m) }
 func (*ProvisionResource) ProtoMessage()    {}
 func (*ProvisionResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{19}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{19}
 }
 func (m *ProvisionResource) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProvisionResource.Unmarshal(m, b)
@@ -2553,7 +2585,7 @@ func (m *ProvisionResource_Request) Reset()         { *m = ProvisionResource_Req
 func (m *ProvisionResource_Request) String() string { return proto.CompactTextString(m) }
 func (*ProvisionResource_Request) ProtoMessage()    {}
 func (*ProvisionResource_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{19, 0}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{19, 0}
 }
 func (m *ProvisionResource_Request) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProvisionResource_Request.Unmarshal(m, b)
@@ -2599,7 +2631,7 @@ func (m *ProvisionResource_Response) Reset()         { *m = ProvisionResource_Re
 func (m *ProvisionResource_Response) String() string { return proto.CompactTextString(m) }
 func (*ProvisionResource_Response) ProtoMessage()    {}
 func (*ProvisionResource_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tfplugin5_56820f4fb67360c5, []int{19, 1}
+	return fileDescriptor_tfplugin5_94c4426ace36547a, []int{19, 1}
 }
 func (m *ProvisionResource_Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProvisionResource_Response.Unmarshal(m, b)
@@ -3330,126 +3362,127 @@ var _Provisioner_serviceDesc = grpc.ServiceDesc{
 	Metadata: "tfplugin5.proto",
 }
 
-func init() { proto.RegisterFile("tfplugin5.proto", fileDescriptor_tfplugin5_56820f4fb67360c5) }
+func init() { proto.RegisterFile("tfplugin5.proto", fileDescriptor_tfplugin5_94c4426ace36547a) }
 
-var fileDescriptor_tfplugin5_56820f4fb67360c5 = []byte{
-	// 1876 bytes of a gzipped FileDescriptorProto
+var fileDescriptor_tfplugin5_94c4426ace36547a = []byte{
+	// 1896 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6f, 0x23, 0x49,
-	0x15, 0x9f, 0x76, 0xdb, 0x89, 0xfd, 0x9c, 0x0f, 0xa7, 0x66, 0x76, 0x30, 0xbd, 0xbb, 0x10, 0xcc,
-	0x47, 0xb2, 0xda, 0x1d, 0xcf, 0x2a, 0x03, 0xbb, 0x4b, 0x18, 0xad, 0xc8, 0x66, 0x42, 0x26, 0x62,
-	0x26, 0x1b, 0xca, 0xf3, 0x81, 0x84, 0xb4, 0x56, 0x8d, 0xbb, 0xe2, 0x69, 0xc6, 0xee, 0xee, 0xad,
-	0x2e, 0x67, 0x62, 0x71, 0x44, 0x70, 0xe6, 0xc2, 0x87, 0xc4, 0xc7, 0x85, 0x03, 0xff, 0x00, 0x07,
-	0xe0, 0xc6, 0x89, 0x7f, 0x80, 0x1b, 0x70, 0x42, 0x70, 0x43, 0x1c, 0xe1, 0x82, 0x84, 0xea, 0xab,
-	0xbb, 0x6c, 0xb7, 0x93, 0x9e, 0x64, 0x57, 0x88, 0x5b, 0x57, 0xbd, 0x5f, 0xbd, 0xf7, 0xab, 0xf7,
-	0x5e, 0xbd, 0x57, 0x65, 0xc3, 0x2a, 0x3f, 0x8e, 0x07, 0xa3, 0x7e, 0x10, 0x7e, 0xa9, 0x1d, 0xb3,
-	0x88, 0x47, 0xa8, 0x96, 0x4e, 0xb4, 0x6e, 0xc3, 0xd2, 0x9d, 0x71, 0x48, 0x86, 0x41, 0xef, 0x11,
-	0x19, 0x8c, 0x28, 0x6a, 0xc2, 0xe2, 0x30, 0xe9, 0xc7, 0xa4, 0xf7, 0xac, 0xe9, 0xac, 0x3b, 0x9b,
-	0x4b, 0xd8, 0x0c, 0x11, 0x82, 0xf2, 0xb7, 0x93, 0x28, 0x6c, 0x96, 0xe4, 0xb4, 0xfc, 0x6e, 0xfd,
-	0xd5, 0x01, 0xb8, 0x13, 0x90, 0x7e, 0x18, 0x25, 0x3c, 0xe8, 0xa1, 0x6d, 0xa8, 0x26, 0xf4, 0x84,
-	0xb2, 0x80, 0x8f, 0xe5, 0xea, 0x95, 0xad, 0x4f, 0xb5, 0x33, 0xdb, 0x19, 0xb0, 0xdd, 0xd1, 0x28,
-	0x9c, 0xe2, 0x85, 0xe1, 0x64, 0x34, 0x1c, 0x12, 0x36, 0x96, 0x16, 0x6a, 0xd8, 0x0c, 0xd1, 0x75,
-	0x58, 0xf0, 0x29, 0x27, 0xc1, 0xa0, 0xe9, 0x4a, 0x81, 0x1e, 0xa1, 0xb7, 0xa0, 0x46, 0x38, 0x67,
-	0xc1, 0x93, 0x11, 0xa7, 0xcd, 0xf2, 0xba, 0xb3, 0x59, 0xdf, 0x6a, 0x5a, 0xe6, 0x76, 0x8c, 0xec,
-	0x88, 0xf0, 0xa7, 0x38, 0x83, 0xb6, 0x6e, 0x42, 0xd5, 0xd8, 0x47, 0x75, 0x58, 0x3c, 0x38, 0x7c,
-	0xb4, 0x73, 0xef, 0xe0, 0x4e, 0xe3, 0x0a, 0xaa, 0x41, 0x65, 0x0f, 0xe3, 0xf7, 0x71, 0xc3, 0x11,
-	0xf3, 0x8f, 0x77, 0xf0, 0xe1, 0xc1, 0xe1, 0x7e, 0xa3, 0xd4, 0xfa, 0xb3, 0x03, 0xcb, 0x13, 0xda,
-	0xd0, 0x2d, 0xa8, 0x24, 0x9c, 0xc6, 0x49, 0xd3, 0x59, 0x77, 0x37, 0xeb, 0x5b, 0xaf, 0xce, 0x33,
-	0xdb, 0xee, 0x70, 0x1a, 0x63, 0x85, 0xf5, 0x7e, 0xe8, 0x40, 0x59, 0x8c, 0xd1, 0x06, 0xac, 0xa4,
-	0x6c, 0xba, 0x21, 0x19, 0x52, 0xe9, 0xac, 0xda, 0xdd, 0x2b, 0x78, 0x39, 0x9d, 0x3f, 0x24, 0x43,
-	0x8a, 0xda, 0x80, 0xe8, 0x80, 0x0e, 0x69, 0xc8, 0xbb, 0xcf, 0xe8, 0xb8, 0x9b, 0x70, 0x16, 0x84,
-	0x7d, 0xe5, 0x9e, 0xbb, 0x57, 0x70, 0x43, 0xcb, 0xbe, 0x4e, 0xc7, 0x1d, 0x29, 0x41, 0x9b, 0xb0,
-	0x6a, 0xe3, 0x83, 0x90, 0x4b, 0x97, 0xb9, 0x42, 0x73, 0x06, 0x3e, 0x08, 0xf9, 0x7b, 0x20, 0x22,
-	0x35, 0xa0, 0x3d, 0x1e, 0xb1, 0xd6, 0x2d, 0x41, 0x2b, 0x8a, 0xbd, 0x1a, 0x2c, 0x62, 0xfa, 0xe1,
-	0x88, 0x26, 0xdc, 0x5b, 0x87, 0x2a, 0xa6, 0x49, 0x1c, 0x85, 0x09, 0x45, 0xd7, 0xa0, 0xb2, 0xc7,
-	0x58, 0xc4, 0x14, 0x49, 0xac, 0x06, 0xad, 0x1f, 0x39, 0x50, 0xc5, 0xe4, 0x79, 0x87, 0x13, 0x4e,
-	0xd3, 0xd4, 0x70, 0xb2, 0xd4, 0x40, 0xdb, 0xb0, 0x78, 0x3c, 0x20, 0x7c, 0x48, 0xe2, 0x66, 0x49,
-	0x3a, 0x69, 0xdd, 0x72, 0x92, 0x59, 0xd9, 0xfe, 0x9a, 0x82, 0xec, 0x85, 0x9c, 0x8d, 0xb1, 0x59,
-	0xe0, 0x6d, 0xc3, 0x92, 0x2d, 0x40, 0x0d, 0x70, 0x9f, 0xd1, 0xb1, 0x26, 0x20, 0x3e, 0x05, 0xa9,
-	0x13, 0x91, 0xaf, 0x3a, 0x57, 0xd4, 0x60, 0xbb, 0xf4, 0x8e, 0xd3, 0xfa, 0x7b, 0x05, 0x16, 0x3a,
-	0xbd, 0xa7, 0x74, 0x48, 0x44, 0x4a, 0x9d, 0x50, 0x96, 0x04, 0x9a, 0x99, 0x8b, 0xcd, 0x10, 0xdd,
-	0x80, 0xca, 0x93, 0x41, 0xd4, 0x7b, 0x26, 0x97, 0xd7, 0xb7, 0x3e, 0x61, 0x51, 0x53, 0x6b, 0xdb,
-	0xef, 0x09, 0x31, 0x56, 0x28, 0xef, 0x17, 0x0e, 0x54, 0xe4, 0xc4, 0x19, 0x2a, 0xbf, 0x02, 0x90,
-	0x06, 0x2f, 0xd1, 0x5b, 0x7e, 0x79, 0x56, 0x6f, 0x9a, 0x1e, 0xd8, 0x82, 0xa3, 0x77, 0xa1, 0x2e,
-	0x2d, 0x75, 0xf9, 0x38, 0xa6, 0x49, 0xd3, 0x9d, 0xc9, 0x2a, 0xbd, 0xfa, 0x90, 0x26, 0x9c, 0xfa,
-	0x8a, 0x1b, 0xc8, 0x15, 0x0f, 0xc4, 0x02, 0xef, 0x0f, 0x0e, 0xd4, 0x52, 0xcd, 0x22, 0x1c, 0x59,
-	0x56, 0x61, 0xf9, 0x2d, 0xe6, 0x84, 0x6e, 0x73, 0x7a, 0xc5, 0x37, 0x5a, 0x87, 0xba, 0x4f, 0x93,
-	0x1e, 0x0b, 0x62, 0x2e, 0x36, 0xa4, 0x4e, 0x97, 0x3d, 0x85, 0x3c, 0xa8, 0x32, 0xfa, 0xe1, 0x28,
-	0x60, 0xd4, 0x97, 0x27, 0xac, 0x8a, 0xd3, 0xb1, 0x90, 0x45, 0x12, 0x45, 0x06, 0xcd, 0x8a, 0x92,
-	0x99, 0xb1, 0x90, 0xf5, 0xa2, 0x61, 0x3c, 0xe2, 0xd4, 0x6f, 0x2e, 0x28, 0x99, 0x19, 0xa3, 0x57,
-	0xa0, 0x96, 0xd0, 0x30, 0x09, 0x78, 0x70, 0x42, 0x9b, 0x8b, 0x52, 0x98, 0x4d, 0x78, 0xbf, 0x2a,
-	0x41, 0xdd, 0xda, 0x25, 0x7a, 0x19, 0x6a, 0x82, 0xab, 0x75, 0x4c, 0x70, 0x55, 0x4c, 0xc8, 0xf3,
-	0xf1, 0x62, 0x61, 0x44, 0xbb, 0xb0, 0x18, 0xd2, 0x84, 0x8b, 0x33, 0xe4, 0xca, 0xea, 0xf4, 0xda,
-	0x99, 0x1e, 0x96, 0xdf, 0x41, 0xd8, 0xbf, 0x1f, 0xf9, 0x14, 0x9b, 0x95, 0x82, 0xd0, 0x30, 0x08,
-	0xbb, 0x01, 0xa7, 0xc3, 0x44, 0xfa, 0xc4, 0xc5, 0xd5, 0x61, 0x10, 0x1e, 0x88, 0xb1, 0x14, 0x92,
-	0x53, 0x2d, 0xac, 0x68, 0x21, 0x39, 0x95, 0xc2, 0xd6, 0x7d, 0xb5, 0x33, 0xad, 0x71, 0xb2, 0xf4,
-	0x00, 0x2c, 0x74, 0x0e, 0x0e, 0xf7, 0xef, 0xed, 0x35, 0x1c, 0x54, 0x85, 0xf2, 0xbd, 0x83, 0xce,
-	0x83, 0x46, 0x09, 0x2d, 0x82, 0xdb, 0xd9, 0x7b, 0xd0, 0x70, 0xc5, 0xc7, 0xfd, 0x9d, 0xa3, 0x46,
-	0x59, 0x94, 0xa8, 0x7d, 0xfc, 0xfe, 0xc3, 0xa3, 0x46, 0xa5, 0xf5, 0x93, 0x32, 0xac, 0xed, 0x53,
-	0x7e, 0xc4, 0xa2, 0x93, 0xc0, 0xa7, 0x4c, 0xf1, 0xb7, 0x0f, 0xf1, 0xbf, 0x5c, 0xeb, 0x14, 0xdf,
-	0x80, 0x6a, 0xac, 0x91, 0xd2, 0x8d, 0xf5, 0xad, 0xb5, 0x99, 0xcd, 0xe3, 0x14, 0x82, 0x28, 0x34,
-	0x18, 0x4d, 0xa2, 0x11, 0xeb, 0xd1, 0x6e, 0x22, 0x85, 0x26, 0xa7, 0xb7, 0xad, 0x65, 0x33, 0xe6,
-	0xdb, 0xc6, 0x9e, 0xf8, 0x90, 0xab, 0xd5, 0x7c, 0xa2, 0x0e, 0xf8, 0x2a, 0x9b, 0x9c, 0x45, 0x03,
-	0xb8, 0xea, 0x13, 0x4e, 0xba, 0x53, 0x96, 0x54, 0xfe, 0xdf, 0x2e, 0x66, 0xe9, 0x0e, 0xe1, 0xa4,
-	0x33, 0x6b, 0x6b, 0xcd, 0x9f, 0x9e, 0x47, 0x6f, 0x43, 0xdd, 0x4f, 0x7b, 0x90, 0x08, 0x9e, 0xb0,
-	0xf2, 0x52, 0x6e, 0x87, 0xc2, 0x36, 0xd2, 0x7b, 0x08, 0xd7, 0xf2, 0xf6, 0x93, 0x53, 0x97, 0x36,
-	0xec, 0xba, 0x94, 0xeb, 0xe3, 0xac, 0x54, 0x79, 0x8f, 0xe1, 0x7a, 0x3e, 0xf9, 0x4b, 0x2a, 0x6e,
-	0xfd, 0xc9, 0x81, 0x97, 0x8e, 0x18, 0x8d, 0x09, 0xa3, 0xc6, 0x6b, 0xbb, 0x51, 0x78, 0x1c, 0xf4,
-	0xbd, 0xed, 0x34, 0x3d, 0xd0, 0x4d, 0x58, 0xe8, 0xc9, 0x49, 0x9d, 0x0f, 0xf6, 0xe9, 0xb1, 0xaf,
-	0x04, 0x58, 0xc3, 0xbc, 0xef, 0x39, 0x56, 0x3e, 0x7d, 0x15, 0x56, 0x63, 0x65, 0xc1, 0xef, 0x16,
-	0x53, 0xb3, 0x62, 0xf0, 0x8a, 0xca, 0x74, 0x34, 0x4a, 0x45, 0xa3, 0xd1, 0xfa, 0x41, 0x09, 0xae,
-	0x3d, 0x8c, 0xfb, 0x8c, 0xf8, 0x34, 0x8d, 0x8a, 0x68, 0x26, 0x1e, 0xcb, 0x36, 0x77, 0x66, 0xd9,
-	0xb0, 0x8a, 0x78, 0x69, 0xb2, 0x88, 0xbf, 0x09, 0x35, 0x46, 0x9e, 0x77, 0x13, 0xa1, 0x4e, 0xd6,
-	0x88, 0xfa, 0xd6, 0xd5, 0x9c, 0xb6, 0x85, 0xab, 0x4c, 0x7f, 0x79, 0xdf, 0xb5, 0x9d, 0xf2, 0x2e,
-	0xac, 0x8c, 0x14, 0x31, 0x5f, 0xeb, 0x38, 0xc7, 0x27, 0xcb, 0x06, 0xae, 0xfa, 0xe8, 0x85, 0x5d,
-	0xf2, 0x3b, 0x07, 0xbc, 0x47, 0x64, 0x10, 0xf8, 0x82, 0x9c, 0xf6, 0x89, 0xe8, 0x0c, 0x3a, 0xea,
-	0x8f, 0x0b, 0x3a, 0x26, 0x4b, 0x89, 0x52, 0xb1, 0x94, 0xd8, 0xb5, 0x36, 0x3f, 0x45, 0xde, 0x29,
-	0x4c, 0xfe, 0x37, 0x0e, 0x34, 0x0d, 0xf9, 0xec, 0x3c, 0xfc, 0x5f, 0x50, 0xff, 0xad, 0x03, 0x35,
-	0x45, 0x74, 0xc4, 0xa8, 0xd7, 0xcf, 0xb8, 0xbe, 0x0e, 0x6b, 0x9c, 0x32, 0x46, 0x8e, 0x23, 0x36,
-	0xec, 0xda, 0x37, 0x86, 0x1a, 0x6e, 0xa4, 0x82, 0x47, 0x3a, 0xeb, 0xfe, 0x37, 0xdc, 0xff, 0xe9,
-	0xc0, 0x12, 0xa6, 0xc4, 0x37, 0xf9, 0xe2, 0xf9, 0x05, 0x5d, 0x7d, 0x1b, 0x96, 0x7b, 0x23, 0xc6,
-	0xc4, 0x2d, 0x53, 0x25, 0xf9, 0x39, 0xac, 0x97, 0x34, 0x5a, 0x1d, 0x98, 0xb1, 0xc5, 0xfd, 0x8b,
-	0x50, 0x0b, 0xe9, 0xf3, 0x62, 0x47, 0xa5, 0x1a, 0xd2, 0xe7, 0x97, 0x3c, 0x25, 0xbf, 0x2e, 0x03,
-	0x3a, 0x1a, 0x90, 0xd0, 0xec, 0x78, 0xf7, 0x29, 0x09, 0xfb, 0xd4, 0xfb, 0x8f, 0x53, 0x70, 0xe3,
-	0xef, 0x40, 0x3d, 0x66, 0x41, 0xc4, 0x8a, 0x6d, 0x1b, 0x24, 0x56, 0x51, 0xde, 0x03, 0x14, 0xb3,
-	0x28, 0x8e, 0x12, 0xea, 0x77, 0xb3, 0x1d, 0xbb, 0x67, 0x2b, 0x68, 0x98, 0x25, 0x87, 0x66, 0xe7,
-	0x59, 0xa2, 0x94, 0x0b, 0x25, 0x0a, 0xfa, 0x2c, 0x2c, 0x2b, 0xc6, 0x31, 0x0b, 0x4e, 0x84, 0xc9,
-	0x8a, 0xbc, 0xfe, 0x2d, 0xc9, 0xc9, 0x23, 0x35, 0xe7, 0xfd, 0xbc, 0x64, 0x85, 0xe4, 0x36, 0x2c,
-	0xc7, 0x03, 0x12, 0x86, 0x45, 0x2b, 0xd8, 0x92, 0x46, 0x2b, 0x82, 0xbb, 0xe2, 0xda, 0x20, 0xef,
-	0x87, 0x49, 0x97, 0xd1, 0x78, 0x40, 0x7a, 0x54, 0xc7, 0x67, 0xfe, 0xcb, 0x6c, 0xd5, 0xac, 0xc0,
-	0x6a, 0x01, 0xda, 0x80, 0x55, 0x43, 0xc1, 0xd0, 0x76, 0x25, 0xed, 0x15, 0x3d, 0xad, 0x89, 0x5f,
-	0xb8, 0x9f, 0xa3, 0x37, 0x00, 0x0d, 0x68, 0x9f, 0xf4, 0xc6, 0xf2, 0xbe, 0xdd, 0x4d, 0xc6, 0x09,
-	0xa7, 0x43, 0x7d, 0x89, 0x6d, 0x28, 0x89, 0xa8, 0x9e, 0x1d, 0x39, 0xdf, 0xfa, 0xa3, 0x0b, 0x57,
-	0x77, 0xe2, 0x78, 0x30, 0x9e, 0xca, 0x9b, 0x7f, 0x7f, 0xfc, 0x79, 0x33, 0x13, 0x0d, 0xf7, 0x45,
-	0xa2, 0xf1, 0xc2, 0xe9, 0x92, 0xe3, 0xf9, 0x4a, 0x9e, 0xe7, 0xbd, 0xdf, 0x3b, 0x97, 0x3e, 0xc5,
-	0x4d, 0x58, 0x34, 0x36, 0xd4, 0x9b, 0xc4, 0x0c, 0xa7, 0xc3, 0xea, 0x5e, 0x32, 0xac, 0xe5, 0x39,
-	0x61, 0xfd, 0x47, 0x09, 0xae, 0x1e, 0x0c, 0xe3, 0x88, 0xf1, 0xc9, 0x5b, 0xc4, 0x5b, 0x05, 0xa3,
-	0xba, 0x02, 0xa5, 0xc0, 0xd7, 0xef, 0xcf, 0x52, 0xe0, 0x7b, 0xa7, 0xd0, 0x50, 0xea, 0x68, 0x5a,
-	0x52, 0xcf, 0x7d, 0xbd, 0x14, 0x4a, 0x08, 0x85, 0xb2, 0x1d, 0xe6, 0x4e, 0x38, 0xcc, 0xfb, 0xa5,
-	0x1d, 0x8d, 0x0f, 0x00, 0x05, 0x9a, 0x46, 0xd7, 0x5c, 0xb7, 0x4d, 0x5b, 0xb8, 0x69, 0x99, 0xc8,
-	0xd9, 0x7a, 0x7b, 0x9a, 0x3f, 0x5e, 0x0b, 0xa6, 0x66, 0x92, 0x8b, 0x57, 0xdf, 0xbf, 0x38, 0xb0,
-	0x22, 0xfa, 0x4d, 0xd6, 0xe2, 0x3f, 0xbe, 0xe6, 0xce, 0x26, 0x5e, 0x3e, 0x95, 0x42, 0xa9, 0xa9,
-	0xdd, 0x7c, 0xe1, 0xfd, 0xfd, 0xd4, 0x81, 0x6b, 0xe6, 0x99, 0x22, 0xda, 0x7a, 0xde, 0x93, 0xec,
-	0xd4, 0xe2, 0x75, 0x4b, 0x54, 0x85, 0x14, 0x3b, 0xff, 0x51, 0x66, 0xa3, 0x2e, 0xce, 0xee, 0x67,
-	0x0e, 0x7c, 0xd2, 0x5c, 0xb2, 0x2c, 0x8a, 0x1f, 0xc1, 0xb3, 0xe0, 0x23, 0xb9, 0x8c, 0xfc, 0xcd,
-	0x81, 0xb5, 0x94, 0x56, 0x7a, 0x23, 0x49, 0x2e, 0x4e, 0x0b, 0xbd, 0x0d, 0xd0, 0x8b, 0xc2, 0x90,
-	0xf6, 0xb8, 0xb9, 0xe7, 0x9f, 0x55, 0x73, 0x33, 0xa8, 0xf7, 0x2d, 0x6b, 0x3f, 0xd7, 0x61, 0x21,
-	0x1a, 0xf1, 0x78, 0xc4, 0x75, 0x4a, 0xea, 0xd1, 0x85, 0xc3, 0xb0, 0xf5, 0xe3, 0x1a, 0x54, 0xcd,
-	0x93, 0x0c, 0x7d, 0x13, 0x6a, 0xfb, 0x94, 0xeb, 0x1f, 0xab, 0x3e, 0x77, 0xce, 0x6b, 0x57, 0x25,
-	0xd0, 0xe7, 0x0b, 0xbd, 0x89, 0xd1, 0x60, 0xce, 0xfb, 0x0f, 0x6d, 0x5a, 0xeb, 0x73, 0x11, 0xa9,
-	0xa5, 0xd7, 0x0a, 0x20, 0xb5, 0xb5, 0xef, 0x9c, 0xf5, 0xf8, 0x40, 0x37, 0x2c, 0x45, 0xf3, 0x61,
-	0xa9, 0xdd, 0x76, 0x51, 0xb8, 0x36, 0x3e, 0x9a, 0xff, 0x78, 0x40, 0xaf, 0xe7, 0xe8, 0x9a, 0x06,
-	0xa5, 0x86, 0xdf, 0x28, 0x06, 0xd6, 0x66, 0x83, 0xfc, 0x37, 0x28, 0xda, 0xb0, 0xb4, 0xe4, 0x01,
-	0x52, 0x73, 0x9b, 0xe7, 0x03, 0xb5, 0xa9, 0xbb, 0xd6, 0x1b, 0x03, 0xbd, 0x62, 0x2d, 0x4b, 0x67,
-	0x53, 0xa5, 0xaf, 0xce, 0x91, 0x6a, 0x4d, 0xdf, 0x98, 0xbc, 0xf1, 0xa3, 0x4f, 0xdb, 0x6f, 0x5b,
-	0x4b, 0x90, 0xea, 0x5b, 0x9f, 0x0f, 0xd0, 0x2a, 0x7b, 0x79, 0x57, 0x6a, 0x64, 0xa7, 0xe9, 0xac,
-	0x38, 0x55, 0xff, 0x85, 0xf3, 0x60, 0xda, 0xc8, 0x71, 0xee, 0x05, 0x0c, 0xd9, 0xcb, 0x73, 0xe4,
-	0xa9, 0x99, 0x8d, 0x73, 0x71, 0x99, 0x9d, 0x9c, 0xb6, 0x38, 0x61, 0x27, 0xaf, 0x6d, 0xe6, 0xd9,
-	0xc9, 0xc7, 0x69, 0x3b, 0x8f, 0xa7, 0x3b, 0x21, 0xfa, 0xcc, 0x94, 0xa3, 0x33, 0x51, 0xaa, 0xbd,
-	0x75, 0x16, 0x44, 0x2b, 0xfe, 0xb2, 0xfa, 0x29, 0x1f, 0x4d, 0xfc, 0x12, 0xca, 0xa3, 0x38, 0x55,
-	0xd2, 0x9c, 0x15, 0xa8, 0xa5, 0x5b, 0xdf, 0x77, 0xa1, 0x6e, 0x35, 0x06, 0xf4, 0x81, 0x5d, 0x9c,
-	0x36, 0x72, 0xca, 0x8e, 0xdd, 0xe3, 0x72, 0xb3, 0x7a, 0x0e, 0x50, 0x53, 0x3d, 0x3d, 0xa3, 0x1f,
-	0xa1, 0xbc, 0xb3, 0x38, 0x83, 0x4a, 0x8d, 0xde, 0x28, 0x88, 0xd6, 0x96, 0x9f, 0xe4, 0xb4, 0x9a,
-	0x89, 0xf2, 0x3b, 0x23, 0xcd, 0x2d, 0xbf, 0x79, 0x28, 0x65, 0xe1, 0x4d, 0xe7, 0x12, 0x81, 0x78,
-	0xb2, 0x20, 0xff, 0xa3, 0xbb, 0xf5, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x16, 0x0b, 0x32,
-	0xb6, 0x1b, 0x00, 0x00,
+	0x15, 0x9f, 0xf6, 0x47, 0x62, 0x3f, 0xe7, 0xc3, 0xa9, 0x99, 0x1d, 0x4c, 0xef, 0x07, 0xc1, 0x7c,
+	0x24, 0xab, 0xdd, 0xf1, 0xac, 0x32, 0xb0, 0xbb, 0x84, 0xd1, 0x8a, 0x6c, 0x26, 0x64, 0x22, 0x66,
+	0xb2, 0xa1, 0x3c, 0x1f, 0x48, 0x48, 0x6b, 0xd5, 0x74, 0x57, 0x3c, 0xcd, 0xb8, 0x3f, 0xb6, 0xba,
+	0x9c, 0xc4, 0x42, 0xe2, 0x82, 0xe0, 0x8c, 0x84, 0xf8, 0x90, 0x80, 0x13, 0x08, 0xfe, 0x01, 0x0e,
+	0xc0, 0x01, 0x89, 0xff, 0x81, 0x1b, 0x70, 0x42, 0x88, 0x13, 0x67, 0xb8, 0x20, 0xa1, 0xaa, 0xae,
+	0xae, 0x2e, 0xdb, 0x6d, 0xa7, 0x27, 0xd9, 0x15, 0xda, 0x5b, 0xd7, 0x7b, 0xbf, 0xf7, 0x51, 0xef,
+	0xbd, 0x7a, 0x55, 0xcf, 0x86, 0x55, 0x7e, 0x1c, 0x0d, 0x86, 0x7d, 0x2f, 0xf8, 0x62, 0x27, 0x62,
+	0x21, 0x0f, 0x51, 0x5d, 0x13, 0xda, 0xb7, 0x61, 0xe9, 0xce, 0x28, 0x20, 0xbe, 0xe7, 0x3c, 0x22,
+	0x83, 0x21, 0x45, 0x2d, 0x58, 0xf4, 0xe3, 0x7e, 0x44, 0x9c, 0x67, 0x2d, 0x6b, 0xdd, 0xda, 0x5c,
+	0xc2, 0xe9, 0x12, 0x21, 0xa8, 0x7c, 0x2b, 0x0e, 0x83, 0x56, 0x49, 0x92, 0xe5, 0x77, 0xfb, 0xef,
+	0x16, 0xc0, 0x1d, 0x8f, 0xf4, 0x83, 0x30, 0xe6, 0x9e, 0x83, 0xb6, 0xa1, 0x16, 0xd3, 0x13, 0xca,
+	0x3c, 0x3e, 0x92, 0xd2, 0x2b, 0x5b, 0xaf, 0x74, 0x32, 0xdb, 0x19, 0xb0, 0xd3, 0x55, 0x28, 0xac,
+	0xf1, 0xc2, 0x70, 0x3c, 0xf4, 0x7d, 0xc2, 0x46, 0xd2, 0x42, 0x1d, 0xa7, 0x4b, 0x74, 0x1d, 0x16,
+	0x5c, 0xca, 0x89, 0x37, 0x68, 0x95, 0x25, 0x43, 0xad, 0xd0, 0x9b, 0x50, 0x27, 0x9c, 0x33, 0xef,
+	0xc9, 0x90, 0xd3, 0x56, 0x65, 0xdd, 0xda, 0x6c, 0x6c, 0xb5, 0x0c, 0x73, 0x3b, 0x29, 0xef, 0x88,
+	0xf0, 0xa7, 0x38, 0x83, 0xb6, 0x6f, 0x42, 0x2d, 0xb5, 0x8f, 0x1a, 0xb0, 0x78, 0x70, 0xf8, 0x68,
+	0xe7, 0xde, 0xc1, 0x9d, 0xe6, 0x15, 0x54, 0x87, 0xea, 0x1e, 0xc6, 0xef, 0xe1, 0xa6, 0x25, 0xe8,
+	0x8f, 0x77, 0xf0, 0xe1, 0xc1, 0xe1, 0x7e, 0xb3, 0xd4, 0xfe, 0xab, 0x05, 0xcb, 0x63, 0xda, 0xd0,
+	0x2d, 0xa8, 0xc6, 0x9c, 0x46, 0x71, 0xcb, 0x5a, 0x2f, 0x6f, 0x36, 0xb6, 0x5e, 0x9e, 0x65, 0xb6,
+	0xd3, 0xe5, 0x34, 0xc2, 0x09, 0xd6, 0xfe, 0x91, 0x05, 0x15, 0xb1, 0x46, 0x1b, 0xb0, 0xa2, 0xbd,
+	0xe9, 0x05, 0xc4, 0xa7, 0x32, 0x58, 0xf5, 0xbb, 0x57, 0xf0, 0xb2, 0xa6, 0x1f, 0x12, 0x9f, 0xa2,
+	0x0e, 0x20, 0x3a, 0xa0, 0x3e, 0x0d, 0x78, 0xef, 0x19, 0x1d, 0xf5, 0x62, 0xce, 0xbc, 0xa0, 0x9f,
+	0x84, 0xe7, 0xee, 0x15, 0xdc, 0x54, 0xbc, 0xaf, 0xd1, 0x51, 0x57, 0x72, 0xd0, 0x26, 0xac, 0x9a,
+	0x78, 0x2f, 0xe0, 0x32, 0x64, 0x65, 0xa1, 0x39, 0x03, 0x1f, 0x04, 0xfc, 0x5d, 0x10, 0x99, 0x1a,
+	0x50, 0x87, 0x87, 0xac, 0x7d, 0x4b, 0xb8, 0x15, 0x46, 0x76, 0x1d, 0x16, 0x31, 0xfd, 0x60, 0x48,
+	0x63, 0x6e, 0xaf, 0x43, 0x0d, 0xd3, 0x38, 0x0a, 0x83, 0x98, 0xa2, 0x6b, 0x50, 0xdd, 0x63, 0x2c,
+	0x64, 0x89, 0x93, 0x38, 0x59, 0xb4, 0x7f, 0x6c, 0x41, 0x0d, 0x93, 0xd3, 0x2e, 0x27, 0x9c, 0xea,
+	0xd2, 0xb0, 0xb2, 0xd2, 0x40, 0xdb, 0xb0, 0x78, 0x3c, 0x20, 0xdc, 0x27, 0x51, 0xab, 0x24, 0x83,
+	0xb4, 0x6e, 0x04, 0x29, 0x95, 0xec, 0x7c, 0x35, 0x81, 0xec, 0x05, 0x9c, 0x8d, 0x70, 0x2a, 0x60,
+	0x6f, 0xc3, 0x92, 0xc9, 0x40, 0x4d, 0x28, 0x3f, 0xa3, 0x23, 0xe5, 0x80, 0xf8, 0x14, 0x4e, 0x9d,
+	0x88, 0x7a, 0x55, 0xb5, 0x92, 0x2c, 0xb6, 0x4b, 0x6f, 0x5b, 0xed, 0x5f, 0x2f, 0xc0, 0x42, 0xd7,
+	0x79, 0x4a, 0x7d, 0x22, 0x4a, 0xea, 0x84, 0xb2, 0xd8, 0x53, 0x9e, 0x95, 0x71, 0xba, 0x44, 0x37,
+	0xa0, 0xfa, 0x64, 0x10, 0x3a, 0xcf, 0xa4, 0x78, 0x63, 0xeb, 0x13, 0x86, 0x6b, 0x89, 0x6c, 0xe7,
+	0x5d, 0xc1, 0xc6, 0x09, 0xca, 0xfe, 0xa3, 0x05, 0x55, 0x49, 0x98, 0xa3, 0xf2, 0xcb, 0x00, 0x3a,
+	0x79, 0xb1, 0xda, 0xf2, 0x8b, 0xd3, 0x7a, 0x75, 0x79, 0x60, 0x03, 0x8e, 0xde, 0x81, 0x86, 0xb4,
+	0xd4, 0xe3, 0xa3, 0x88, 0xc6, 0xad, 0xf2, 0x54, 0x55, 0x29, 0xe9, 0x43, 0x1a, 0x73, 0xea, 0x26,
+	0xbe, 0x81, 0x94, 0x78, 0x20, 0x04, 0xd0, 0x2b, 0x00, 0x2e, 0x8d, 0x18, 0x75, 0x08, 0xa7, 0x6e,
+	0x6b, 0x61, 0xdd, 0xda, 0xac, 0x61, 0x83, 0x62, 0xff, 0xd3, 0x82, 0xba, 0xb6, 0x2c, 0xd2, 0x95,
+	0x55, 0x1d, 0x96, 0xdf, 0x82, 0x26, 0x6c, 0xa7, 0xa7, 0x5b, 0x7c, 0xa3, 0x75, 0x68, 0xb8, 0x34,
+	0x76, 0x98, 0x17, 0x71, 0xb1, 0xe1, 0xe4, 0xf4, 0x99, 0x24, 0x64, 0x43, 0x8d, 0xd1, 0x0f, 0x86,
+	0x1e, 0xa3, 0xae, 0x3c, 0x81, 0x35, 0xac, 0xd7, 0x82, 0x17, 0x4a, 0x14, 0x19, 0xb4, 0xaa, 0x09,
+	0x2f, 0x5d, 0x0b, 0x9e, 0x13, 0xfa, 0xd1, 0x30, 0xf3, 0x56, 0xaf, 0xd1, 0x4b, 0x50, 0x8f, 0x69,
+	0x10, 0x7b, 0xdc, 0x3b, 0xa1, 0xad, 0x45, 0xc9, 0xcc, 0x08, 0x13, 0x3b, 0xad, 0x4f, 0xed, 0xf4,
+	0x37, 0x25, 0x68, 0x18, 0x51, 0x42, 0x2f, 0x42, 0x5d, 0xec, 0xc5, 0x38, 0x66, 0xb8, 0x26, 0x08,
+	0xf2, 0x7c, 0x3d, 0x5f, 0x19, 0xa0, 0x5d, 0x58, 0x0c, 0x68, 0xcc, 0xc5, 0x19, 0x2c, 0xcb, 0xee,
+	0xf6, 0xea, 0xdc, 0x0c, 0xc9, 0x6f, 0x2f, 0xe8, 0xdf, 0x0f, 0x5d, 0x8a, 0x53, 0x49, 0xe1, 0x90,
+	0xef, 0x05, 0x3d, 0x8f, 0x53, 0x3f, 0x96, 0x31, 0x2b, 0xe3, 0x9a, 0xef, 0x05, 0x07, 0x62, 0x2d,
+	0x99, 0xe4, 0x4c, 0x31, 0xab, 0x8a, 0x49, 0xce, 0x24, 0xb3, 0x7d, 0x1f, 0x1a, 0x86, 0xc6, 0xf1,
+	0xd6, 0x05, 0xb0, 0xd0, 0x3d, 0x38, 0xdc, 0xbf, 0xb7, 0xd7, 0xb4, 0x50, 0x0d, 0x2a, 0xf7, 0x0e,
+	0xba, 0x0f, 0x9a, 0x25, 0xb4, 0x08, 0xe5, 0xee, 0xde, 0x83, 0x66, 0x59, 0x7c, 0xdc, 0xdf, 0x39,
+	0x6a, 0x56, 0x44, 0x8b, 0xdb, 0xc7, 0xef, 0x3d, 0x3c, 0x6a, 0x56, 0xdb, 0x3f, 0xad, 0xc0, 0xda,
+	0x3e, 0xe5, 0x47, 0x2c, 0x3c, 0xf1, 0x5c, 0xca, 0x12, 0xff, 0xcd, 0x26, 0xf0, 0xef, 0xb2, 0xd1,
+	0x05, 0x6e, 0x40, 0x2d, 0x52, 0x48, 0x19, 0xc6, 0xc6, 0xd6, 0xda, 0xd4, 0xe6, 0xb1, 0x86, 0x20,
+	0x0a, 0x4d, 0x46, 0xe3, 0x70, 0xc8, 0x1c, 0xda, 0x8b, 0x25, 0x33, 0x3d, 0x13, 0xdb, 0x86, 0xd8,
+	0x94, 0xf9, 0x4e, 0x6a, 0xaf, 0x83, 0x95, 0x74, 0x42, 0x8f, 0x93, 0x06, 0xb1, 0xca, 0xc6, 0xa9,
+	0x68, 0x00, 0x57, 0x5d, 0xc2, 0x49, 0x6f, 0xc2, 0x52, 0x72, 0x7e, 0x6e, 0x17, 0xb3, 0x74, 0x87,
+	0x70, 0xd2, 0x9d, 0xb6, 0xb5, 0xe6, 0x4e, 0xd2, 0xd1, 0x5b, 0xd0, 0x70, 0xf5, 0x1d, 0x26, 0x92,
+	0x27, 0xac, 0xbc, 0x90, 0x7b, 0xc3, 0x61, 0x13, 0x69, 0x3f, 0x84, 0x6b, 0x79, 0xfb, 0xc9, 0xe9,
+	0x6b, 0x1b, 0x66, 0x5f, 0xcb, 0x8d, 0x71, 0xd6, 0xea, 0xec, 0xc7, 0x70, 0x3d, 0xdf, 0xf9, 0x4b,
+	0x2a, 0x6e, 0xff, 0xc5, 0x82, 0x17, 0x8e, 0x18, 0x8d, 0x08, 0xa3, 0x69, 0xd4, 0x76, 0xc3, 0xe0,
+	0xd8, 0xeb, 0xdb, 0xdb, 0xba, 0x3c, 0xd0, 0x4d, 0x58, 0x70, 0x24, 0xb1, 0x65, 0x4d, 0x9d, 0x1e,
+	0xf3, 0x49, 0x81, 0x15, 0xcc, 0xfe, 0x9e, 0x65, 0xd4, 0xd3, 0x57, 0x60, 0x35, 0x4a, 0x2c, 0xb8,
+	0xbd, 0x62, 0x6a, 0x56, 0x52, 0x7c, 0xe2, 0xca, 0x64, 0x36, 0x4a, 0x45, 0xb3, 0xd1, 0xfe, 0x41,
+	0x09, 0xae, 0x3d, 0x8c, 0xfa, 0x8c, 0xb8, 0x54, 0x67, 0x85, 0x13, 0x4e, 0x6d, 0x96, 0x6d, 0x6e,
+	0x6e, 0xdb, 0x30, 0x2e, 0x81, 0xd2, 0xf8, 0x25, 0xf0, 0x06, 0xd4, 0x19, 0x39, 0xed, 0xc5, 0x42,
+	0x9d, 0xec, 0x11, 0x8d, 0xad, 0xab, 0x39, 0xd7, 0x1e, 0xae, 0x31, 0xf5, 0x65, 0x7f, 0xd7, 0x0c,
+	0xca, 0x3b, 0xb0, 0x32, 0x4c, 0x1c, 0x73, 0x95, 0x8e, 0x73, 0x62, 0xb2, 0x9c, 0xc2, 0x93, 0x7b,
+	0xf8, 0xc2, 0x21, 0xf9, 0x83, 0x05, 0xf6, 0x23, 0x32, 0xf0, 0x5c, 0xc2, 0x75, 0x4c, 0xc4, 0xcd,
+	0xa2, 0xb2, 0xfe, 0xb8, 0x60, 0x60, 0xb2, 0x92, 0x28, 0x15, 0x2b, 0x89, 0x5d, 0x63, 0xf3, 0x13,
+	0xce, 0x5b, 0x85, 0x9d, 0xff, 0x9d, 0x05, 0xad, 0xd4, 0xf9, 0xec, 0x3c, 0x7c, 0x2c, 0x5c, 0xff,
+	0xbd, 0x05, 0xf5, 0xc4, 0xd1, 0x21, 0xa3, 0x76, 0x3f, 0xf3, 0xf5, 0x35, 0x58, 0xe3, 0x94, 0x31,
+	0x72, 0x1c, 0x32, 0xbf, 0x67, 0xbe, 0x38, 0xea, 0xb8, 0xa9, 0x19, 0x8f, 0x54, 0xd5, 0xfd, 0x7f,
+	0x7c, 0xff, 0x55, 0x09, 0x96, 0x30, 0x25, 0x6e, 0x5a, 0x2f, 0xf6, 0x77, 0x0a, 0x86, 0xfa, 0x36,
+	0x2c, 0x3b, 0x43, 0xc6, 0xc4, 0x2b, 0x35, 0x29, 0xf2, 0x73, 0xbc, 0x5e, 0x52, 0xe8, 0xa4, 0xc6,
+	0x5b, 0xb0, 0x18, 0x31, 0xef, 0x24, 0x3d, 0x60, 0x4b, 0x38, 0x5d, 0xda, 0x3f, 0x34, 0x8f, 0xd2,
+	0x17, 0xa0, 0x1e, 0xd0, 0xd3, 0x62, 0xa7, 0xa8, 0x16, 0xd0, 0xd3, 0xcb, 0x1d, 0xa0, 0xd9, 0x5e,
+	0xb5, 0x7f, 0x5b, 0x01, 0x74, 0x34, 0x20, 0x41, 0x1a, 0xa6, 0xdd, 0xa7, 0x24, 0xe8, 0x53, 0xfb,
+	0xbf, 0x56, 0xc1, 0x68, 0xbd, 0x0d, 0x8d, 0x88, 0x79, 0x21, 0x2b, 0x16, 0x2b, 0x90, 0xd8, 0x64,
+	0x33, 0x7b, 0x80, 0x22, 0x16, 0x46, 0x61, 0x4c, 0xdd, 0x5e, 0x16, 0x8b, 0xf2, 0x7c, 0x05, 0xcd,
+	0x54, 0xe4, 0x30, 0x8d, 0x49, 0x56, 0x5d, 0x95, 0x42, 0xd5, 0x85, 0x3e, 0x03, 0xcb, 0x89, 0xc7,
+	0x69, 0x44, 0xaa, 0x32, 0x22, 0x4b, 0x92, 0x78, 0xa4, 0x92, 0xf5, 0x8b, 0x92, 0x91, 0xac, 0xdb,
+	0xb0, 0x1c, 0x0d, 0x48, 0x10, 0x14, 0x6d, 0x7b, 0x4b, 0x0a, 0x9d, 0x38, 0xb8, 0x0b, 0x4d, 0xf5,
+	0xe8, 0x8c, 0x7b, 0x8c, 0x46, 0x03, 0xe2, 0x50, 0x95, 0xb9, 0xd9, 0xe3, 0xe0, 0x6a, 0x2a, 0x81,
+	0x13, 0x01, 0xb4, 0x01, 0xab, 0xa9, 0x0b, 0xe3, 0x89, 0x5c, 0x51, 0x64, 0xe5, 0xf8, 0x85, 0x1f,
+	0x01, 0xe8, 0x75, 0x40, 0x03, 0xda, 0x27, 0xce, 0x48, 0x3e, 0xf2, 0x7b, 0xf1, 0x28, 0xe6, 0xd4,
+	0x57, 0x2f, 0xe3, 0x66, 0xc2, 0x11, 0x2d, 0xb7, 0x2b, 0xe9, 0xed, 0x3f, 0x97, 0xe1, 0xea, 0x4e,
+	0x14, 0x0d, 0x46, 0x13, 0x75, 0xf3, 0x9f, 0x8f, 0xbe, 0x6e, 0xa6, 0xb2, 0x51, 0x7e, 0x9e, 0x6c,
+	0x3c, 0x77, 0xb9, 0xe4, 0x44, 0xbe, 0x9a, 0x17, 0x79, 0xfb, 0x4f, 0x97, 0x3f, 0xdf, 0xc6, 0x31,
+	0x2d, 0x8d, 0x1d, 0xd3, 0xc9, 0xb4, 0x96, 0x2f, 0x99, 0xd6, 0xca, 0x8c, 0xb4, 0xfe, 0xab, 0x04,
+	0x57, 0x0f, 0xfc, 0x28, 0x64, 0x7c, 0xfc, 0xe9, 0xf1, 0x66, 0xc1, 0xac, 0xae, 0x40, 0xc9, 0x73,
+	0xd5, 0xd0, 0x5b, 0xf2, 0x5c, 0xfb, 0x0c, 0x9a, 0x89, 0x3a, 0xaa, 0xfb, 0xf0, 0xb9, 0x23, 0x4f,
+	0xa1, 0x82, 0xa8, 0xc6, 0x93, 0x01, 0x9b, 0xe8, 0xb6, 0xbf, 0x34, 0xb3, 0xf1, 0x3e, 0x20, 0x4f,
+	0xb9, 0xd1, 0x4b, 0xdf, 0xe8, 0xe9, 0x5d, 0x72, 0xd3, 0x30, 0x91, 0xb3, 0xf5, 0xce, 0xa4, 0xff,
+	0x78, 0xcd, 0x9b, 0xa0, 0xc4, 0x17, 0x7f, 0xd8, 0xfc, 0xcd, 0x82, 0x15, 0x71, 0x49, 0x65, 0xef,
+	0x82, 0x8f, 0xee, 0x45, 0xc0, 0xc6, 0xc6, 0xa5, 0x6a, 0xa1, 0xd2, 0x54, 0x61, 0xbe, 0xf0, 0xfe,
+	0x7e, 0x66, 0xc1, 0xb5, 0x74, 0xb6, 0x11, 0x6f, 0x81, 0xbc, 0x39, 0xee, 0xcc, 0xf0, 0xeb, 0x96,
+	0xe8, 0x0a, 0x1a, 0x3b, 0x7b, 0x92, 0x33, 0x51, 0x17, 0xf7, 0xee, 0xe7, 0x16, 0x7c, 0x32, 0x7d,
+	0x99, 0x19, 0x2e, 0x7e, 0x08, 0xb3, 0xc4, 0x87, 0xf2, 0x82, 0xf9, 0x87, 0x05, 0x6b, 0xda, 0x2d,
+	0xfd, 0x8c, 0x89, 0x2f, 0xee, 0x16, 0x7a, 0x0b, 0xc0, 0x09, 0x83, 0x80, 0x3a, 0x3c, 0x1d, 0x0e,
+	0xe6, 0x08, 0x19, 0x50, 0xfb, 0x9b, 0xc6, 0x7e, 0xae, 0xc3, 0x42, 0x38, 0xe4, 0xd1, 0x90, 0xab,
+	0x92, 0x54, 0xab, 0x0b, 0xa7, 0x61, 0xeb, 0x27, 0x75, 0xa8, 0xa5, 0x73, 0x1c, 0xfa, 0x06, 0xd4,
+	0xf7, 0x29, 0x57, 0xbf, 0x90, 0x7d, 0xf6, 0x9c, 0x11, 0x39, 0x29, 0xa0, 0xcf, 0x15, 0x1a, 0xa4,
+	0xd1, 0x60, 0xc6, 0xd0, 0x88, 0x36, 0x0d, 0xf9, 0x5c, 0x84, 0xb6, 0xf4, 0x6a, 0x01, 0xa4, 0xb2,
+	0xf6, 0xed, 0x79, 0x13, 0x0b, 0xba, 0x61, 0x28, 0x9a, 0x0d, 0xd3, 0x76, 0x3b, 0x45, 0xe1, 0xca,
+	0xf8, 0x70, 0xf6, 0xc4, 0x81, 0x5e, 0xcb, 0xd1, 0x35, 0x09, 0xd2, 0x86, 0x5f, 0x2f, 0x06, 0x56,
+	0x66, 0xbd, 0xfc, 0xc1, 0x15, 0x6d, 0x18, 0x5a, 0xf2, 0x00, 0xda, 0xdc, 0xe6, 0xf9, 0x40, 0x65,
+	0xea, 0xae, 0x31, 0x98, 0xa0, 0x97, 0x0c, 0x31, 0x4d, 0xd5, 0x4a, 0x5f, 0x9e, 0xc1, 0x55, 0x9a,
+	0xbe, 0x3e, 0x3e, 0x26, 0xa0, 0x4f, 0x19, 0x70, 0x93, 0xa1, 0xf5, 0xad, 0xcf, 0x06, 0x28, 0x95,
+	0x4e, 0xde, 0x93, 0x1a, 0x99, 0x65, 0x3a, 0xcd, 0xd6, 0xea, 0x3f, 0x7f, 0x1e, 0x4c, 0x19, 0x39,
+	0xce, 0x7d, 0x80, 0x21, 0x53, 0x3c, 0x87, 0xaf, 0xcd, 0x6c, 0x9c, 0x8b, 0xcb, 0xec, 0xe4, 0x5c,
+	0x8b, 0x63, 0x76, 0x72, 0xf8, 0xb9, 0x76, 0xf2, 0x71, 0xca, 0xce, 0xe3, 0xc9, 0x9b, 0x10, 0x7d,
+	0x7a, 0x22, 0xd0, 0x19, 0x4b, 0x6b, 0x6f, 0xcf, 0x83, 0x28, 0xc5, 0x5f, 0x4a, 0xfe, 0x3f, 0x40,
+	0x63, 0x3f, 0x9f, 0xf2, 0x30, 0xd2, 0x4a, 0x5a, 0xd3, 0x8c, 0x44, 0x74, 0xeb, 0xfb, 0x65, 0x68,
+	0x18, 0x17, 0x03, 0x7a, 0xdf, 0x6c, 0x4e, 0x1b, 0x39, 0x6d, 0xc7, 0xbc, 0xe3, 0x72, 0xab, 0x7a,
+	0x06, 0x50, 0xb9, 0x7a, 0x36, 0xe7, 0x3e, 0x42, 0x79, 0x67, 0x71, 0x0a, 0xa5, 0x8d, 0xde, 0x28,
+	0x88, 0x56, 0x96, 0x9f, 0xe4, 0x5c, 0x35, 0x63, 0xed, 0x77, 0x8a, 0x9b, 0xdb, 0x7e, 0xf3, 0x50,
+	0x89, 0x85, 0x37, 0xac, 0x4b, 0x24, 0xe2, 0xc9, 0x82, 0xfc, 0x63, 0xf0, 0xd6, 0xff, 0x06, 0x00,
+	0x1c, 0xf2, 0x66, 0x40, 0x2b, 0x1c, 0x00, 0x00,
 }
@@ -0,0 +1,3136 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: tfplugin6.proto
+
+package tfplugin6
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type StringKind int32
+
+const (
+	StringKind_PLAIN    StringKind = 0
+	StringKind_MARKDOWN StringKind = 1
+)
+
+var StringKind_name = map[int32]string{
+	0: "PLAIN",
+	1: "MARKDOWN",
+}
+var StringKind_value = map[string]int32{
+	"PLAIN":    0,
+	"MARKDOWN": 1,
+}
+
+func (x StringKind) String() string {
+	return proto.EnumName(StringKind_name, int32(x))
+}
+func (StringKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{0}
+}
+
+type Diagnostic_Severity int32
+
+const (
+	Diagnostic_INVALID Diagnostic_Severity = 0
+	Diagnostic_ERROR   Diagnostic_Severity = 1
+	Diagnostic_WARNING Diagnostic_Severity = 2
+)
+
+var Diagnostic_Severity_name = map[int32]string{
+	0: "INVALID",
+	1: "ERROR",
+	2: "WARNING",
+}
+var Diagnostic_Severity_value = map[string]int32{
+	"INVALID": 0,
+	"ERROR":   1,
+	"WARNING": 2,
+}
+
+func (x Diagnostic_Severity) String() string {
+	return proto.EnumName(Diagnostic_Severity_name, int32(x))
+}
+func (Diagnostic_Severity) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{1, 0}
+}
+
+type Schema_NestedBlock_NestingMode int32
+
+const (
+	Schema_NestedBlock_INVALID Schema_NestedBlock_NestingMode = 0
+	Schema_NestedBlock_SINGLE  Schema_NestedBlock_NestingMode = 1
+	Schema_NestedBlock_LIST    Schema_NestedBlock_NestingMode = 2
+	Schema_NestedBlock_SET     Schema_NestedBlock_NestingMode = 3
+	Schema_NestedBlock_MAP     Schema_NestedBlock_NestingMode = 4
+	Schema_NestedBlock_GROUP   Schema_NestedBlock_NestingMode = 5
+)
+
+var Schema_NestedBlock_NestingMode_name = map[int32]string{
+	0: "INVALID",
+	1: "SINGLE",
+	2: "LIST",
+	3: "SET",
+	4: "MAP",
+	5: "GROUP",
+}
+var Schema_NestedBlock_NestingMode_value = map[string]int32{
+	"INVALID": 0,
+	"SINGLE":  1,
+	"LIST":    2,
+	"SET":     3,
+	"MAP":     4,
+	"GROUP":   5,
+}
+
+func (x Schema_NestedBlock_NestingMode) String() string {
+	return proto.EnumName(Schema_NestedBlock_NestingMode_name, int32(x))
+}
+func (Schema_NestedBlock_NestingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{5, 2, 0}
+}
+
+type Schema_Object_NestingMode int32
+
+const (
+	Schema_Object_INVALID Schema_Object_NestingMode = 0
+	Schema_Object_SINGLE  Schema_Object_NestingMode = 1
+	Schema_Object_LIST    Schema_Object_NestingMode = 2
+	Schema_Object_SET     Schema_Object_NestingMode = 3
+	Schema_Object_MAP     Schema_Object_NestingMode = 4
+)
+
+var Schema_Object_NestingMode_name = map[int32]string{
+	0: "INVALID",
+	1: "SINGLE",
+	2: "LIST",
+	3: "SET",
+	4: "MAP",
+}
+var Schema_Object_NestingMode_value = map[string]int32{
+	"INVALID": 0,
+	"SINGLE":  1,
+	"LIST":    2,
+	"SET":     3,
+	"MAP":     4,
+}
+
+func (x Schema_Object_NestingMode) String() string {
+	return proto.EnumName(Schema_Object_NestingMode_name, int32(x))
+}
+func (Schema_Object_NestingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{5, 3, 0}
+}
+
+// DynamicValue is an opaque encoding of terraform data, with the field name
+// indicating the encoding scheme used.
+type DynamicValue struct {
+	Msgpack              []byte   `protobuf:"bytes,1,opt,name=msgpack,proto3" json:"msgpack,omitempty"`
+	Json                 []byte   `protobuf:"bytes,2,opt,name=json,proto3" json:"json,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DynamicValue) Reset()         { *m = DynamicValue{} }
+func (m *DynamicValue) String() string { return proto.CompactTextString(m) }
+func (*DynamicValue) ProtoMessage()    {}
+func (*DynamicValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{0}
+}
+func (m *DynamicValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DynamicValue.Unmarshal(m, b)
+}
+func (m *DynamicValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DynamicValue.Marshal(b, m, deterministic)
+}
+func (dst *DynamicValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicValue.Merge(dst, src)
+}
+func (m *DynamicValue) XXX_Size() int {
+	return xxx_messageInfo_DynamicValue.Size(m)
+}
+func (m *DynamicValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicValue proto.InternalMessageInfo
+
+func (m *DynamicValue) GetMsgpack() []byte {
+	if m != nil {
+		return m.Msgpack
+	}
+	return nil
+}
+
+func (m *DynamicValue) GetJson() []byte {
+	if m != nil {
+		return m.Json
+	}
+	return nil
+}
+
+type Diagnostic struct {
+	Severity             Diagnostic_Severity `protobuf:"varint,1,opt,name=severity,proto3,enum=tfplugin6.Diagnostic_Severity" json:"severity,omitempty"`
+	Summary              string              `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	Detail               string              `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	Attribute            *AttributePath      `protobuf:"bytes,4,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *Diagnostic) Reset()         { *m = Diagnostic{} }
+func (m *Diagnostic) String() string { return proto.CompactTextString(m) }
+func (*Diagnostic) ProtoMessage()    {}
+func (*Diagnostic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{1}
+}
+func (m *Diagnostic) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Diagnostic.Unmarshal(m, b)
+}
+func (m *Diagnostic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Diagnostic.Marshal(b, m, deterministic)
+}
+func (dst *Diagnostic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Diagnostic.Merge(dst, src)
+}
+func (m *Diagnostic) XXX_Size() int {
+	return xxx_messageInfo_Diagnostic.Size(m)
+}
+func (m *Diagnostic) XXX_DiscardUnknown() {
+	xxx_messageInfo_Diagnostic.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Diagnostic proto.InternalMessageInfo
+
+func (m *Diagnostic) GetSeverity() Diagnostic_Severity {
+	if m != nil {
+		return m.Severity
+	}
+	return Diagnostic_INVALID
+}
+
+func (m *Diagnostic) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
+}
+
+func (m *Diagnostic) GetDetail() string {
+	if m != nil {
+		return m.Detail
+	}
+	return ""
+}
+
+func (m *Diagnostic) GetAttribute() *AttributePath {
+	if m != nil {
+		return m.Attribute
+	}
+	return nil
+}
+
+type AttributePath struct {
+	Steps                []*AttributePath_Step `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *AttributePath) Reset()         { *m = AttributePath{} }
+func (m *AttributePath) String() string { return proto.CompactTextString(m) }
+func (*AttributePath) ProtoMessage()    {}
+func (*AttributePath) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{2}
+}
+func (m *AttributePath) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttributePath.Unmarshal(m, b)
+}
+func (m *AttributePath) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttributePath.Marshal(b, m, deterministic)
+}
+func (dst *AttributePath) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributePath.Merge(dst, src)
+}
+func (m *AttributePath) XXX_Size() int {
+	return xxx_messageInfo_AttributePath.Size(m)
+}
+func (m *AttributePath) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributePath.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributePath proto.InternalMessageInfo
+
+func (m *AttributePath) GetSteps() []*AttributePath_Step {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+type AttributePath_Step struct {
+	// Types that are valid to be assigned to Selector:
+	//	*AttributePath_Step_AttributeName
+	//	*AttributePath_Step_ElementKeyString
+	//	*AttributePath_Step_ElementKeyInt
+	Selector             isAttributePath_Step_Selector `protobuf_oneof:"selector"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *AttributePath_Step) Reset()         { *m = AttributePath_Step{} }
+func (m *AttributePath_Step) String() string { return proto.CompactTextString(m) }
+func (*AttributePath_Step) ProtoMessage()    {}
+func (*AttributePath_Step) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{2, 0}
+}
+func (m *AttributePath_Step) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttributePath_Step.Unmarshal(m, b)
+}
+func (m *AttributePath_Step) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttributePath_Step.Marshal(b, m, deterministic)
+}
+func (dst *AttributePath_Step) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributePath_Step.Merge(dst, src)
+}
+func (m *AttributePath_Step) XXX_Size() int {
+	return xxx_messageInfo_AttributePath_Step.Size(m)
+}
+func (m *AttributePath_Step) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributePath_Step.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributePath_Step proto.InternalMessageInfo
+
+type isAttributePath_Step_Selector interface {
+	isAttributePath_Step_Selector()
+}
+
+type AttributePath_Step_AttributeName struct {
+	AttributeName string `protobuf:"bytes,1,opt,name=attribute_name,json=attributeName,proto3,oneof"`
+}
+
+type AttributePath_Step_ElementKeyString struct {
+	ElementKeyString string `protobuf:"bytes,2,opt,name=element_key_string,json=elementKeyString,proto3,oneof"`
+}
+
+type AttributePath_Step_ElementKeyInt struct {
+	ElementKeyInt int64 `protobuf:"varint,3,opt,name=element_key_int,json=elementKeyInt,proto3,oneof"`
+}
+
+func (*AttributePath_Step_AttributeName) isAttributePath_Step_Selector() {}
+
+func (*AttributePath_Step_ElementKeyString) isAttributePath_Step_Selector() {}
+
+func (*AttributePath_Step_ElementKeyInt) isAttributePath_Step_Selector() {}
+
+func (m *AttributePath_Step) GetSelector() isAttributePath_Step_Selector {
+	if m != nil {
+		return m.Selector
+	}
+	return nil
+}
+
+func (m *AttributePath_Step) GetAttributeName() string {
+	if x, ok := m.GetSelector().(*AttributePath_Step_AttributeName); ok {
+		return x.AttributeName
+	}
+	return ""
+}
+
+func (m *AttributePath_Step) GetElementKeyString() string {
+	if x, ok := m.GetSelector().(*AttributePath_Step_ElementKeyString); ok {
+		return x.ElementKeyString
+	}
+	return ""
+}
+
+func (m *AttributePath_Step) GetElementKeyInt() int64 {
+	if x, ok := m.GetSelector().(*AttributePath_Step_ElementKeyInt); ok {
+		return x.ElementKeyInt
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*AttributePath_Step) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _AttributePath_Step_OneofMarshaler, _AttributePath_Step_OneofUnmarshaler, _AttributePath_Step_OneofSizer, []interface{}{
+		(*AttributePath_Step_AttributeName)(nil),
+		(*AttributePath_Step_ElementKeyString)(nil),
+		(*AttributePath_Step_ElementKeyInt)(nil),
+	}
+}
+
+func _AttributePath_Step_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*AttributePath_Step)
+	// selector
+	switch x := m.Selector.(type) {
+	case *AttributePath_Step_AttributeName:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.AttributeName)
+	case *AttributePath_Step_ElementKeyString:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.ElementKeyString)
+	case *AttributePath_Step_ElementKeyInt:
+		b.EncodeVarint(3<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.ElementKeyInt))
+	case nil:
+	default:
+		return fmt.Errorf("AttributePath_Step.Selector has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _AttributePath_Step_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*AttributePath_Step)
+	switch tag {
+	case 1: // selector.attribute_name
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Selector = &AttributePath_Step_AttributeName{x}
+		return true, err
+	case 2: // selector.element_key_string
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Selector = &AttributePath_Step_ElementKeyString{x}
+		return true, err
+	case 3: // selector.element_key_int
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Selector = &AttributePath_Step_ElementKeyInt{int64(x)}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _AttributePath_Step_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*AttributePath_Step)
+	// selector
+	switch x := m.Selector.(type) {
+	case *AttributePath_Step_AttributeName:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.AttributeName)))
+		n += len(x.AttributeName)
+	case *AttributePath_Step_ElementKeyString:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.ElementKeyString)))
+		n += len(x.ElementKeyString)
+	case *AttributePath_Step_ElementKeyInt:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(x.ElementKeyInt))
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type StopProvider struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StopProvider) Reset()         { *m = StopProvider{} }
+func (m *StopProvider) String() string { return proto.CompactTextString(m) }
+func (*StopProvider) ProtoMessage()    {}
+func (*StopProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{3}
+}
+func (m *StopProvider) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StopProvider.Unmarshal(m, b)
+}
+func (m *StopProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StopProvider.Marshal(b, m, deterministic)
+}
+func (dst *StopProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopProvider.Merge(dst, src)
+}
+func (m *StopProvider) XXX_Size() int {
+	return xxx_messageInfo_StopProvider.Size(m)
+}
+func (m *StopProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopProvider proto.InternalMessageInfo
+
+type StopProvider_Request struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StopProvider_Request) Reset()         { *m = StopProvider_Request{} }
+func (m *StopProvider_Request) String() string { return proto.CompactTextString(m) }
+func (*StopProvider_Request) ProtoMessage()    {}
+func (*StopProvider_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{3, 0}
+}
+func (m *StopProvider_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StopProvider_Request.Unmarshal(m, b)
+}
+func (m *StopProvider_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StopProvider_Request.Marshal(b, m, deterministic)
+}
+func (dst *StopProvider_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopProvider_Request.Merge(dst, src)
+}
+func (m *StopProvider_Request) XXX_Size() int {
+	return xxx_messageInfo_StopProvider_Request.Size(m)
+}
+func (m *StopProvider_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopProvider_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopProvider_Request proto.InternalMessageInfo
+
+type StopProvider_Response struct {
+	Error                string   `protobuf:"bytes,1,opt,name=Error,proto3" json:"Error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StopProvider_Response) Reset()         { *m = StopProvider_Response{} }
+func (m *StopProvider_Response) String() string { return proto.CompactTextString(m) }
+func (*StopProvider_Response) ProtoMessage()    {}
+func (*StopProvider_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{3, 1}
+}
+func (m *StopProvider_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StopProvider_Response.Unmarshal(m, b)
+}
+func (m *StopProvider_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StopProvider_Response.Marshal(b, m, deterministic)
+}
+func (dst *StopProvider_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopProvider_Response.Merge(dst, src)
+}
+func (m *StopProvider_Response) XXX_Size() int {
+	return xxx_messageInfo_StopProvider_Response.Size(m)
+}
+func (m *StopProvider_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopProvider_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopProvider_Response proto.InternalMessageInfo
+
+func (m *StopProvider_Response) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// RawState holds the stored state for a resource to be upgraded by the
+// provider. It can be in one of two formats, the current json encoded format
+// in bytes, or the legacy flatmap format as a map of strings.
+type RawState struct {
+	Json                 []byte            `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
+	Flatmap              map[string]string `protobuf:"bytes,2,rep,name=flatmap,proto3" json:"flatmap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RawState) Reset()         { *m = RawState{} }
+func (m *RawState) String() string { return proto.CompactTextString(m) }
+func (*RawState) ProtoMessage()    {}
+func (*RawState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{4}
+}
+func (m *RawState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RawState.Unmarshal(m, b)
+}
+func (m *RawState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RawState.Marshal(b, m, deterministic)
+}
+func (dst *RawState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RawState.Merge(dst, src)
+}
+func (m *RawState) XXX_Size() int {
+	return xxx_messageInfo_RawState.Size(m)
+}
+func (m *RawState) XXX_DiscardUnknown() {
+	xxx_messageInfo_RawState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RawState proto.InternalMessageInfo
+
+func (m *RawState) GetJson() []byte {
+	if m != nil {
+		return m.Json
+	}
+	return nil
+}
+
+func (m *RawState) GetFlatmap() map[string]string {
+	if m != nil {
+		return m.Flatmap
+	}
+	return nil
+}
+
+// Schema is the configuration schema for a Resource or Provider.
+type Schema struct {
+	// The version of the schema.
+	// Schemas are versioned, so that providers can upgrade a saved resource
+	// state when the schema is changed.
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Block is the top level configuration block for this schema.
+	Block                *Schema_Block `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Schema) Reset()         { *m = Schema{} }
+func (m *Schema) String() string { return proto.CompactTextString(m) }
+func (*Schema) ProtoMessage()    {}
+func (*Schema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{5}
+}
+func (m *Schema) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Schema.Unmarshal(m, b)
+}
+func (m *Schema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Schema.Marshal(b, m, deterministic)
+}
+func (dst *Schema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schema.Merge(dst, src)
+}
+func (m *Schema) XXX_Size() int {
+	return xxx_messageInfo_Schema.Size(m)
+}
+func (m *Schema) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schema proto.InternalMessageInfo
+
+func (m *Schema) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Schema) GetBlock() *Schema_Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+type Schema_Block struct {
+	Version              int64                 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Attributes           []*Schema_Attribute   `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	BlockTypes           []*Schema_NestedBlock `protobuf:"bytes,3,rep,name=block_types,json=blockTypes,proto3" json:"block_types,omitempty"`
+	Description          string                `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	DescriptionKind      StringKind            `protobuf:"varint,5,opt,name=description_kind,json=descriptionKind,proto3,enum=tfplugin6.StringKind" json:"description_kind,omitempty"`
+	Deprecated           bool                  `protobuf:"varint,6,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Schema_Block) Reset()         { *m = Schema_Block{} }
+func (m *Schema_Block) String() string { return proto.CompactTextString(m) }
+func (*Schema_Block) ProtoMessage()    {}
+func (*Schema_Block) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{5, 0}
+}
+func (m *Schema_Block) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Schema_Block.Unmarshal(m, b)
+}
+func (m *Schema_Block) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Schema_Block.Marshal(b, m, deterministic)
+}
+func (dst *Schema_Block) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schema_Block.Merge(dst, src)
+}
+func (m *Schema_Block) XXX_Size() int {
+	return xxx_messageInfo_Schema_Block.Size(m)
+}
+func (m *Schema_Block) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schema_Block.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schema_Block proto.InternalMessageInfo
+
+func (m *Schema_Block) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Schema_Block) GetAttributes() []*Schema_Attribute {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *Schema_Block) GetBlockTypes() []*Schema_NestedBlock {
+	if m != nil {
+		return m.BlockTypes
+	}
+	return nil
+}
+
+func (m *Schema_Block) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Schema_Block) GetDescriptionKind() StringKind {
+	if m != nil {
+		return m.DescriptionKind
+	}
+	return StringKind_PLAIN
+}
+
+func (m *Schema_Block) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
+}
+
+type Schema_Attribute struct {
+	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 []byte         `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	NestedType           *Schema_Object `protobuf:"bytes,10,opt,name=nested_type,json=nestedType,proto3" json:"nested_type,omitempty"`
+	Description          string         `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Required             bool           `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	Optional             bool           `protobuf:"varint,5,opt,name=optional,proto3" json:"optional,omitempty"`
+	Computed             bool           `protobuf:"varint,6,opt,name=computed,proto3" json:"computed,omitempty"`
+	Sensitive            bool           `protobuf:"varint,7,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+	DescriptionKind      StringKind     `protobuf:"varint,8,opt,name=description_kind,json=descriptionKind,proto3,enum=tfplugin6.StringKind" json:"description_kind,omitempty"`
+	Deprecated           bool           `protobuf:"varint,9,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Schema_Attribute) Reset()         { *m = Schema_Attribute{} }
+func (m *Schema_Attribute) String() string { return proto.CompactTextString(m) }
+func (*Schema_Attribute) ProtoMessage()    {}
+func (*Schema_Attribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{5, 1}
+}
+func (m *Schema_Attribute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Schema_Attribute.Unmarshal(m, b)
+}
+func (m *Schema_Attribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Schema_Attribute.Marshal(b, m, deterministic)
+}
+func (dst *Schema_Attribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schema_Attribute.Merge(dst, src)
+}
+func (m *Schema_Attribute) XXX_Size() int {
+	return xxx_messageInfo_Schema_Attribute.Size(m)
+}
+func (m *Schema_Attribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schema_Attribute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schema_Attribute proto.InternalMessageInfo
+
+func (m *Schema_Attribute) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Schema_Attribute) GetType() []byte {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (m *Schema_Attribute) GetNestedType() *Schema_Object {
+	if m != nil {
+		return m.NestedType
+	}
+	return nil
+}
+
+func (m *Schema_Attribute) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Schema_Attribute) GetRequired() bool {
+	if m != nil {
+		return m.Required
+	}
+	return false
+}
+
+func (m *Schema_Attribute) GetOptional() bool {
+	if m != nil {
+		return m.Optional
+	}
+	return false
+}
+
+func (m *Schema_Attribute) GetComputed() bool {
+	if m != nil {
+		return m.Computed
+	}
+	return false
+}
+
+func (m *Schema_Attribute) GetSensitive() bool {
+	if m != nil {
+		return m.Sensitive
+	}
+	return false
+}
+
+func (m *Schema_Attribute) GetDescriptionKind() StringKind {
+	if m != nil {
+		return m.DescriptionKind
+	}
+	return StringKind_PLAIN
+}
+
+func (m *Schema_Attribute) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
+}
+
+type Schema_NestedBlock struct {
+	TypeName             string                         `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	Block                *Schema_Block                  `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	Nesting              Schema_NestedBlock_NestingMode `protobuf:"varint,3,opt,name=nesting,proto3,enum=tfplugin6.Schema_NestedBlock_NestingMode" json:"nesting,omitempty"`
+	MinItems             int64                          `protobuf:"varint,4,opt,name=min_items,json=minItems,proto3" json:"min_items,omitempty"`
+	MaxItems             int64                          `protobuf:"varint,5,opt,name=max_items,json=maxItems,proto3" json:"max_items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *Schema_NestedBlock) Reset()         { *m = Schema_NestedBlock{} }
+func (m *Schema_NestedBlock) String() string { return proto.CompactTextString(m) }
+func (*Schema_NestedBlock) ProtoMessage()    {}
+func (*Schema_NestedBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{5, 2}
+}
+func (m *Schema_NestedBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Schema_NestedBlock.Unmarshal(m, b)
+}
+func (m *Schema_NestedBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Schema_NestedBlock.Marshal(b, m, deterministic)
+}
+func (dst *Schema_NestedBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schema_NestedBlock.Merge(dst, src)
+}
+func (m *Schema_NestedBlock) XXX_Size() int {
+	return xxx_messageInfo_Schema_NestedBlock.Size(m)
+}
+func (m *Schema_NestedBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schema_NestedBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schema_NestedBlock proto.InternalMessageInfo
+
+func (m *Schema_NestedBlock) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *Schema_NestedBlock) GetBlock() *Schema_Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *Schema_NestedBlock) GetNesting() Schema_NestedBlock_NestingMode {
+	if m != nil {
+		return m.Nesting
+	}
+	return Schema_NestedBlock_INVALID
+}
+
+func (m *Schema_NestedBlock) GetMinItems() int64 {
+	if m != nil {
+		return m.MinItems
+	}
+	return 0
+}
+
+func (m *Schema_NestedBlock) GetMaxItems() int64 {
+	if m != nil {
+		return m.MaxItems
+	}
+	return 0
+}
+
+type Schema_Object struct {
+	Attributes           []*Schema_Attribute       `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	Nesting              Schema_Object_NestingMode `protobuf:"varint,3,opt,name=nesting,proto3,enum=tfplugin6.Schema_Object_NestingMode" json:"nesting,omitempty"`
+	MinItems             int64                     `protobuf:"varint,4,opt,name=min_items,json=minItems,proto3" json:"min_items,omitempty"`
+	MaxItems             int64                     `protobuf:"varint,5,opt,name=max_items,json=maxItems,proto3" json:"max_items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *Schema_Object) Reset()         { *m = Schema_Object{} }
+func (m *Schema_Object) String() string { return proto.CompactTextString(m) }
+func (*Schema_Object) ProtoMessage()    {}
+func (*Schema_Object) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{5, 3}
+}
+func (m *Schema_Object) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Schema_Object.Unmarshal(m, b)
+}
+func (m *Schema_Object) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Schema_Object.Marshal(b, m, deterministic)
+}
+func (dst *Schema_Object) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schema_Object.Merge(dst, src)
+}
+func (m *Schema_Object) XXX_Size() int {
+	return xxx_messageInfo_Schema_Object.Size(m)
+}
+func (m *Schema_Object) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schema_Object.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schema_Object proto.InternalMessageInfo
+
+func (m *Schema_Object) GetAttributes() []*Schema_Attribute {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *Schema_Object) GetNesting() Schema_Object_NestingMode {
+	if m != nil {
+		return m.Nesting
+	}
+	return Schema_Object_INVALID
+}
+
+func (m *Schema_Object) GetMinItems() int64 {
+	if m != nil {
+		return m.MinItems
+	}
+	return 0
+}
+
+func (m *Schema_Object) GetMaxItems() int64 {
+	if m != nil {
+		return m.MaxItems
+	}
+	return 0
+}
+
+type GetProviderSchema struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProviderSchema) Reset()         { *m = GetProviderSchema{} }
+func (m *GetProviderSchema) String() string { return proto.CompactTextString(m) }
+func (*GetProviderSchema) ProtoMessage()    {}
+func (*GetProviderSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{6}
+}
+func (m *GetProviderSchema) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProviderSchema.Unmarshal(m, b)
+}
+func (m *GetProviderSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProviderSchema.Marshal(b, m, deterministic)
+}
+func (dst *GetProviderSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProviderSchema.Merge(dst, src)
+}
+func (m *GetProviderSchema) XXX_Size() int {
+	return xxx_messageInfo_GetProviderSchema.Size(m)
+}
+func (m *GetProviderSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProviderSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProviderSchema proto.InternalMessageInfo
+
+type GetProviderSchema_Request struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProviderSchema_Request) Reset()         { *m = GetProviderSchema_Request{} }
+func (m *GetProviderSchema_Request) String() string { return proto.CompactTextString(m) }
+func (*GetProviderSchema_Request) ProtoMessage()    {}
+func (*GetProviderSchema_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{6, 0}
+}
+func (m *GetProviderSchema_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProviderSchema_Request.Unmarshal(m, b)
+}
+func (m *GetProviderSchema_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProviderSchema_Request.Marshal(b, m, deterministic)
+}
+func (dst *GetProviderSchema_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProviderSchema_Request.Merge(dst, src)
+}
+func (m *GetProviderSchema_Request) XXX_Size() int {
+	return xxx_messageInfo_GetProviderSchema_Request.Size(m)
+}
+func (m *GetProviderSchema_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProviderSchema_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProviderSchema_Request proto.InternalMessageInfo
+
+type GetProviderSchema_Response struct {
+	Provider             *Schema            `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ResourceSchemas      map[string]*Schema `protobuf:"bytes,2,rep,name=resource_schemas,json=resourceSchemas,proto3" json:"resource_schemas,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DataSourceSchemas    map[string]*Schema `protobuf:"bytes,3,rep,name=data_source_schemas,json=dataSourceSchemas,proto3" json:"data_source_schemas,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Diagnostics          []*Diagnostic      `protobuf:"bytes,4,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	ProviderMeta         *Schema            `protobuf:"bytes,5,opt,name=provider_meta,json=providerMeta,proto3" json:"provider_meta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetProviderSchema_Response) Reset()         { *m = GetProviderSchema_Response{} }
+func (m *GetProviderSchema_Response) String() string { return proto.CompactTextString(m) }
+func (*GetProviderSchema_Response) ProtoMessage()    {}
+func (*GetProviderSchema_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{6, 1}
+}
+func (m *GetProviderSchema_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProviderSchema_Response.Unmarshal(m, b)
+}
+func (m *GetProviderSchema_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProviderSchema_Response.Marshal(b, m, deterministic)
+}
+func (dst *GetProviderSchema_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProviderSchema_Response.Merge(dst, src)
+}
+func (m *GetProviderSchema_Response) XXX_Size() int {
+	return xxx_messageInfo_GetProviderSchema_Response.Size(m)
+}
+func (m *GetProviderSchema_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProviderSchema_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProviderSchema_Response proto.InternalMessageInfo
+
+func (m *GetProviderSchema_Response) GetProvider() *Schema {
+	if m != nil {
+		return m.Provider
+	}
+	return nil
+}
+
+func (m *GetProviderSchema_Response) GetResourceSchemas() map[string]*Schema {
+	if m != nil {
+		return m.ResourceSchemas
+	}
+	return nil
+}
+
+func (m *GetProviderSchema_Response) GetDataSourceSchemas() map[string]*Schema {
+	if m != nil {
+		return m.DataSourceSchemas
+	}
+	return nil
+}
+
+func (m *GetProviderSchema_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+func (m *GetProviderSchema_Response) GetProviderMeta() *Schema {
+	if m != nil {
+		return m.ProviderMeta
+	}
+	return nil
+}
+
+type ValidateProviderConfig struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateProviderConfig) Reset()         { *m = ValidateProviderConfig{} }
+func (m *ValidateProviderConfig) String() string { return proto.CompactTextString(m) }
+func (*ValidateProviderConfig) ProtoMessage()    {}
+func (*ValidateProviderConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{7}
+}
+func (m *ValidateProviderConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateProviderConfig.Unmarshal(m, b)
+}
+func (m *ValidateProviderConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateProviderConfig.Marshal(b, m, deterministic)
+}
+func (dst *ValidateProviderConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateProviderConfig.Merge(dst, src)
+}
+func (m *ValidateProviderConfig) XXX_Size() int {
+	return xxx_messageInfo_ValidateProviderConfig.Size(m)
+}
+func (m *ValidateProviderConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateProviderConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateProviderConfig proto.InternalMessageInfo
+
+type ValidateProviderConfig_Request struct {
+	Config               *DynamicValue `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ValidateProviderConfig_Request) Reset()         { *m = ValidateProviderConfig_Request{} }
+func (m *ValidateProviderConfig_Request) String() string { return proto.CompactTextString(m) }
+func (*ValidateProviderConfig_Request) ProtoMessage()    {}
+func (*ValidateProviderConfig_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{7, 0}
+}
+func (m *ValidateProviderConfig_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateProviderConfig_Request.Unmarshal(m, b)
+}
+func (m *ValidateProviderConfig_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateProviderConfig_Request.Marshal(b, m, deterministic)
+}
+func (dst *ValidateProviderConfig_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateProviderConfig_Request.Merge(dst, src)
+}
+func (m *ValidateProviderConfig_Request) XXX_Size() int {
+	return xxx_messageInfo_ValidateProviderConfig_Request.Size(m)
+}
+func (m *ValidateProviderConfig_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateProviderConfig_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateProviderConfig_Request proto.InternalMessageInfo
+
+func (m *ValidateProviderConfig_Request) GetConfig() *DynamicValue {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type ValidateProviderConfig_Response struct {
+	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ValidateProviderConfig_Response) Reset()         { *m = ValidateProviderConfig_Response{} }
+func (m *ValidateProviderConfig_Response) String() string { return proto.CompactTextString(m) }
+func (*ValidateProviderConfig_Response) ProtoMessage()    {}
+func (*ValidateProviderConfig_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{7, 1}
+}
+func (m *ValidateProviderConfig_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateProviderConfig_Response.Unmarshal(m, b)
+}
+func (m *ValidateProviderConfig_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateProviderConfig_Response.Marshal(b, m, deterministic)
+}
+func (dst *ValidateProviderConfig_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateProviderConfig_Response.Merge(dst, src)
+}
+func (m *ValidateProviderConfig_Response) XXX_Size() int {
+	return xxx_messageInfo_ValidateProviderConfig_Response.Size(m)
+}
+func (m *ValidateProviderConfig_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateProviderConfig_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateProviderConfig_Response proto.InternalMessageInfo
+
+func (m *ValidateProviderConfig_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type UpgradeResourceState struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpgradeResourceState) Reset()         { *m = UpgradeResourceState{} }
+func (m *UpgradeResourceState) String() string { return proto.CompactTextString(m) }
+func (*UpgradeResourceState) ProtoMessage()    {}
+func (*UpgradeResourceState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{8}
+}
+func (m *UpgradeResourceState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpgradeResourceState.Unmarshal(m, b)
+}
+func (m *UpgradeResourceState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpgradeResourceState.Marshal(b, m, deterministic)
+}
+func (dst *UpgradeResourceState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeResourceState.Merge(dst, src)
+}
+func (m *UpgradeResourceState) XXX_Size() int {
+	return xxx_messageInfo_UpgradeResourceState.Size(m)
+}
+func (m *UpgradeResourceState) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeResourceState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeResourceState proto.InternalMessageInfo
+
+type UpgradeResourceState_Request struct {
+	TypeName string `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	// version is the schema_version number recorded in the state file
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// raw_state is the raw states as stored for the resource.  Core does
+	// not have access to the schema of prior_version, so it's the
+	// provider's responsibility to interpret this value using the
+	// appropriate older schema. The raw_state will be the json encoded
+	// state, or a legacy flat-mapped format.
+	RawState             *RawState `protobuf:"bytes,3,opt,name=raw_state,json=rawState,proto3" json:"raw_state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *UpgradeResourceState_Request) Reset()         { *m = UpgradeResourceState_Request{} }
+func (m *UpgradeResourceState_Request) String() string { return proto.CompactTextString(m) }
+func (*UpgradeResourceState_Request) ProtoMessage()    {}
+func (*UpgradeResourceState_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{8, 0}
+}
+func (m *UpgradeResourceState_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpgradeResourceState_Request.Unmarshal(m, b)
+}
+func (m *UpgradeResourceState_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpgradeResourceState_Request.Marshal(b, m, deterministic)
+}
+func (dst *UpgradeResourceState_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeResourceState_Request.Merge(dst, src)
+}
+func (m *UpgradeResourceState_Request) XXX_Size() int {
+	return xxx_messageInfo_UpgradeResourceState_Request.Size(m)
+}
+func (m *UpgradeResourceState_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeResourceState_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeResourceState_Request proto.InternalMessageInfo
+
+func (m *UpgradeResourceState_Request) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *UpgradeResourceState_Request) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *UpgradeResourceState_Request) GetRawState() *RawState {
+	if m != nil {
+		return m.RawState
+	}
+	return nil
+}
+
+type UpgradeResourceState_Response struct {
+	// new_state is a msgpack-encoded data structure that, when interpreted with
+	// the _current_ schema for this resource type, is functionally equivalent to
+	// that which was given in prior_state_raw.
+	UpgradedState *DynamicValue `protobuf:"bytes,1,opt,name=upgraded_state,json=upgradedState,proto3" json:"upgraded_state,omitempty"`
+	// diagnostics describes any errors encountered during migration that could not
+	// be safely resolved, and warnings about any possibly-risky assumptions made
+	// in the upgrade process.
+	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *UpgradeResourceState_Response) Reset()         { *m = UpgradeResourceState_Response{} }
+func (m *UpgradeResourceState_Response) String() string { return proto.CompactTextString(m) }
+func (*UpgradeResourceState_Response) ProtoMessage()    {}
+func (*UpgradeResourceState_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{8, 1}
+}
+func (m *UpgradeResourceState_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpgradeResourceState_Response.Unmarshal(m, b)
+}
+func (m *UpgradeResourceState_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpgradeResourceState_Response.Marshal(b, m, deterministic)
+}
+func (dst *UpgradeResourceState_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeResourceState_Response.Merge(dst, src)
+}
+func (m *UpgradeResourceState_Response) XXX_Size() int {
+	return xxx_messageInfo_UpgradeResourceState_Response.Size(m)
+}
+func (m *UpgradeResourceState_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeResourceState_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeResourceState_Response proto.InternalMessageInfo
+
+func (m *UpgradeResourceState_Response) GetUpgradedState() *DynamicValue {
+	if m != nil {
+		return m.UpgradedState
+	}
+	return nil
+}
+
+func (m *UpgradeResourceState_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type ValidateResourceConfig struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateResourceConfig) Reset()         { *m = ValidateResourceConfig{} }
+func (m *ValidateResourceConfig) String() string { return proto.CompactTextString(m) }
+func (*ValidateResourceConfig) ProtoMessage()    {}
+func (*ValidateResourceConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{9}
+}
+func (m *ValidateResourceConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateResourceConfig.Unmarshal(m, b)
+}
+func (m *ValidateResourceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateResourceConfig.Marshal(b, m, deterministic)
+}
+func (dst *ValidateResourceConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateResourceConfig.Merge(dst, src)
+}
+func (m *ValidateResourceConfig) XXX_Size() int {
+	return xxx_messageInfo_ValidateResourceConfig.Size(m)
+}
+func (m *ValidateResourceConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateResourceConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateResourceConfig proto.InternalMessageInfo
+
+type ValidateResourceConfig_Request struct {
+	TypeName             string        `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	Config               *DynamicValue `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ValidateResourceConfig_Request) Reset()         { *m = ValidateResourceConfig_Request{} }
+func (m *ValidateResourceConfig_Request) String() string { return proto.CompactTextString(m) }
+func (*ValidateResourceConfig_Request) ProtoMessage()    {}
+func (*ValidateResourceConfig_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{9, 0}
+}
+func (m *ValidateResourceConfig_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateResourceConfig_Request.Unmarshal(m, b)
+}
+func (m *ValidateResourceConfig_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateResourceConfig_Request.Marshal(b, m, deterministic)
+}
+func (dst *ValidateResourceConfig_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateResourceConfig_Request.Merge(dst, src)
+}
+func (m *ValidateResourceConfig_Request) XXX_Size() int {
+	return xxx_messageInfo_ValidateResourceConfig_Request.Size(m)
+}
+func (m *ValidateResourceConfig_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateResourceConfig_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateResourceConfig_Request proto.InternalMessageInfo
+
+func (m *ValidateResourceConfig_Request) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *ValidateResourceConfig_Request) GetConfig() *DynamicValue {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type ValidateResourceConfig_Response struct {
+	Diagnostics          []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ValidateResourceConfig_Response) Reset()         { *m = ValidateResourceConfig_Response{} }
+func (m *ValidateResourceConfig_Response) String() string { return proto.CompactTextString(m) }
+func (*ValidateResourceConfig_Response) ProtoMessage()    {}
+func (*ValidateResourceConfig_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{9, 1}
+}
+func (m *ValidateResourceConfig_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateResourceConfig_Response.Unmarshal(m, b)
+}
+func (m *ValidateResourceConfig_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateResourceConfig_Response.Marshal(b, m, deterministic)
+}
+func (dst *ValidateResourceConfig_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateResourceConfig_Response.Merge(dst, src)
+}
+func (m *ValidateResourceConfig_Response) XXX_Size() int {
+	return xxx_messageInfo_ValidateResourceConfig_Response.Size(m)
+}
+func (m *ValidateResourceConfig_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateResourceConfig_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateResourceConfig_Response proto.InternalMessageInfo
+
+func (m *ValidateResourceConfig_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type ValidateDataResourceConfig struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateDataResourceConfig) Reset()         { *m = ValidateDataResourceConfig{} }
+func (m *ValidateDataResourceConfig) String() string { return proto.CompactTextString(m) }
+func (*ValidateDataResourceConfig) ProtoMessage()    {}
+func (*ValidateDataResourceConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{10}
+}
+func (m *ValidateDataResourceConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateDataResourceConfig.Unmarshal(m, b)
+}
+func (m *ValidateDataResourceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateDataResourceConfig.Marshal(b, m, deterministic)
+}
+func (dst *ValidateDataResourceConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateDataResourceConfig.Merge(dst, src)
+}
+func (m *ValidateDataResourceConfig) XXX_Size() int {
+	return xxx_messageInfo_ValidateDataResourceConfig.Size(m)
+}
+func (m *ValidateDataResourceConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateDataResourceConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateDataResourceConfig proto.InternalMessageInfo
+
+type ValidateDataResourceConfig_Request struct {
+	TypeName             string        `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	Config               *DynamicValue `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ValidateDataResourceConfig_Request) Reset()         { *m = ValidateDataResourceConfig_Request{} }
+func (m *ValidateDataResourceConfig_Request) String() string { return proto.CompactTextString(m) }
+func (*ValidateDataResourceConfig_Request) ProtoMessage()    {}
+func (*ValidateDataResourceConfig_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{10, 0}
+}
+func (m *ValidateDataResourceConfig_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateDataResourceConfig_Request.Unmarshal(m, b)
+}
+func (m *ValidateDataResourceConfig_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateDataResourceConfig_Request.Marshal(b, m, deterministic)
+}
+func (dst *ValidateDataResourceConfig_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateDataResourceConfig_Request.Merge(dst, src)
+}
+func (m *ValidateDataResourceConfig_Request) XXX_Size() int {
+	return xxx_messageInfo_ValidateDataResourceConfig_Request.Size(m)
+}
+func (m *ValidateDataResourceConfig_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateDataResourceConfig_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateDataResourceConfig_Request proto.InternalMessageInfo
+
+func (m *ValidateDataResourceConfig_Request) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *ValidateDataResourceConfig_Request) GetConfig() *DynamicValue {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type ValidateDataResourceConfig_Response struct {
+	Diagnostics          []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ValidateDataResourceConfig_Response) Reset()         { *m = ValidateDataResourceConfig_Response{} }
+func (m *ValidateDataResourceConfig_Response) String() string { return proto.CompactTextString(m) }
+func (*ValidateDataResourceConfig_Response) ProtoMessage()    {}
+func (*ValidateDataResourceConfig_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{10, 1}
+}
+func (m *ValidateDataResourceConfig_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateDataResourceConfig_Response.Unmarshal(m, b)
+}
+func (m *ValidateDataResourceConfig_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateDataResourceConfig_Response.Marshal(b, m, deterministic)
+}
+func (dst *ValidateDataResourceConfig_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateDataResourceConfig_Response.Merge(dst, src)
+}
+func (m *ValidateDataResourceConfig_Response) XXX_Size() int {
+	return xxx_messageInfo_ValidateDataResourceConfig_Response.Size(m)
+}
+func (m *ValidateDataResourceConfig_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateDataResourceConfig_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateDataResourceConfig_Response proto.InternalMessageInfo
+
+func (m *ValidateDataResourceConfig_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type ConfigureProvider struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfigureProvider) Reset()         { *m = ConfigureProvider{} }
+func (m *ConfigureProvider) String() string { return proto.CompactTextString(m) }
+func (*ConfigureProvider) ProtoMessage()    {}
+func (*ConfigureProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{11}
+}
+func (m *ConfigureProvider) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigureProvider.Unmarshal(m, b)
+}
+func (m *ConfigureProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigureProvider.Marshal(b, m, deterministic)
+}
+func (dst *ConfigureProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigureProvider.Merge(dst, src)
+}
+func (m *ConfigureProvider) XXX_Size() int {
+	return xxx_messageInfo_ConfigureProvider.Size(m)
+}
+func (m *ConfigureProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigureProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigureProvider proto.InternalMessageInfo
+
+type ConfigureProvider_Request struct {
+	TerraformVersion     string        `protobuf:"bytes,1,opt,name=terraform_version,json=terraformVersion,proto3" json:"terraform_version,omitempty"`
+	Config               *DynamicValue `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ConfigureProvider_Request) Reset()         { *m = ConfigureProvider_Request{} }
+func (m *ConfigureProvider_Request) String() string { return proto.CompactTextString(m) }
+func (*ConfigureProvider_Request) ProtoMessage()    {}
+func (*ConfigureProvider_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{11, 0}
+}
+func (m *ConfigureProvider_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigureProvider_Request.Unmarshal(m, b)
+}
+func (m *ConfigureProvider_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigureProvider_Request.Marshal(b, m, deterministic)
+}
+func (dst *ConfigureProvider_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigureProvider_Request.Merge(dst, src)
+}
+func (m *ConfigureProvider_Request) XXX_Size() int {
+	return xxx_messageInfo_ConfigureProvider_Request.Size(m)
+}
+func (m *ConfigureProvider_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigureProvider_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigureProvider_Request proto.InternalMessageInfo
+
+func (m *ConfigureProvider_Request) GetTerraformVersion() string {
+	if m != nil {
+		return m.TerraformVersion
+	}
+	return ""
+}
+
+func (m *ConfigureProvider_Request) GetConfig() *DynamicValue {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type ConfigureProvider_Response struct {
+	Diagnostics          []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ConfigureProvider_Response) Reset()         { *m = ConfigureProvider_Response{} }
+func (m *ConfigureProvider_Response) String() string { return proto.CompactTextString(m) }
+func (*ConfigureProvider_Response) ProtoMessage()    {}
+func (*ConfigureProvider_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{11, 1}
+}
+func (m *ConfigureProvider_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigureProvider_Response.Unmarshal(m, b)
+}
+func (m *ConfigureProvider_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigureProvider_Response.Marshal(b, m, deterministic)
+}
+func (dst *ConfigureProvider_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigureProvider_Response.Merge(dst, src)
+}
+func (m *ConfigureProvider_Response) XXX_Size() int {
+	return xxx_messageInfo_ConfigureProvider_Response.Size(m)
+}
+func (m *ConfigureProvider_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigureProvider_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigureProvider_Response proto.InternalMessageInfo
+
+func (m *ConfigureProvider_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type ReadResource struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadResource) Reset()         { *m = ReadResource{} }
+func (m *ReadResource) String() string { return proto.CompactTextString(m) }
+func (*ReadResource) ProtoMessage()    {}
+func (*ReadResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{12}
+}
+func (m *ReadResource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadResource.Unmarshal(m, b)
+}
+func (m *ReadResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadResource.Marshal(b, m, deterministic)
+}
+func (dst *ReadResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadResource.Merge(dst, src)
+}
+func (m *ReadResource) XXX_Size() int {
+	return xxx_messageInfo_ReadResource.Size(m)
+}
+func (m *ReadResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadResource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadResource proto.InternalMessageInfo
+
+type ReadResource_Request struct {
+	TypeName             string        `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	CurrentState         *DynamicValue `protobuf:"bytes,2,opt,name=current_state,json=currentState,proto3" json:"current_state,omitempty"`
+	Private              []byte        `protobuf:"bytes,3,opt,name=private,proto3" json:"private,omitempty"`
+	ProviderMeta         *DynamicValue `protobuf:"bytes,4,opt,name=provider_meta,json=providerMeta,proto3" json:"provider_meta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReadResource_Request) Reset()         { *m = ReadResource_Request{} }
+func (m *ReadResource_Request) String() string { return proto.CompactTextString(m) }
+func (*ReadResource_Request) ProtoMessage()    {}
+func (*ReadResource_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{12, 0}
+}
+func (m *ReadResource_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadResource_Request.Unmarshal(m, b)
+}
+func (m *ReadResource_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadResource_Request.Marshal(b, m, deterministic)
+}
+func (dst *ReadResource_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadResource_Request.Merge(dst, src)
+}
+func (m *ReadResource_Request) XXX_Size() int {
+	return xxx_messageInfo_ReadResource_Request.Size(m)
+}
+func (m *ReadResource_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadResource_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadResource_Request proto.InternalMessageInfo
+
+func (m *ReadResource_Request) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *ReadResource_Request) GetCurrentState() *DynamicValue {
+	if m != nil {
+		return m.CurrentState
+	}
+	return nil
+}
+
+func (m *ReadResource_Request) GetPrivate() []byte {
+	if m != nil {
+		return m.Private
+	}
+	return nil
+}
+
+func (m *ReadResource_Request) GetProviderMeta() *DynamicValue {
+	if m != nil {
+		return m.ProviderMeta
+	}
+	return nil
+}
+
+type ReadResource_Response struct {
+	NewState             *DynamicValue `protobuf:"bytes,1,opt,name=new_state,json=newState,proto3" json:"new_state,omitempty"`
+	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Private              []byte        `protobuf:"bytes,3,opt,name=private,proto3" json:"private,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReadResource_Response) Reset()         { *m = ReadResource_Response{} }
+func (m *ReadResource_Response) String() string { return proto.CompactTextString(m) }
+func (*ReadResource_Response) ProtoMessage()    {}
+func (*ReadResource_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{12, 1}
+}
+func (m *ReadResource_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadResource_Response.Unmarshal(m, b)
+}
+func (m *ReadResource_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadResource_Response.Marshal(b, m, deterministic)
+}
+func (dst *ReadResource_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadResource_Response.Merge(dst, src)
+}
+func (m *ReadResource_Response) XXX_Size() int {
+	return xxx_messageInfo_ReadResource_Response.Size(m)
+}
+func (m *ReadResource_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadResource_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadResource_Response proto.InternalMessageInfo
+
+func (m *ReadResource_Response) GetNewState() *DynamicValue {
+	if m != nil {
+		return m.NewState
+	}
+	return nil
+}
+
+func (m *ReadResource_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+func (m *ReadResource_Response) GetPrivate() []byte {
+	if m != nil {
+		return m.Private
+	}
+	return nil
+}
+
+type PlanResourceChange struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlanResourceChange) Reset()         { *m = PlanResourceChange{} }
+func (m *PlanResourceChange) String() string { return proto.CompactTextString(m) }
+func (*PlanResourceChange) ProtoMessage()    {}
+func (*PlanResourceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{13}
+}
+func (m *PlanResourceChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlanResourceChange.Unmarshal(m, b)
+}
+func (m *PlanResourceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlanResourceChange.Marshal(b, m, deterministic)
+}
+func (dst *PlanResourceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanResourceChange.Merge(dst, src)
+}
+func (m *PlanResourceChange) XXX_Size() int {
+	return xxx_messageInfo_PlanResourceChange.Size(m)
+}
+func (m *PlanResourceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanResourceChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanResourceChange proto.InternalMessageInfo
+
+type PlanResourceChange_Request struct {
+	TypeName             string        `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	PriorState           *DynamicValue `protobuf:"bytes,2,opt,name=prior_state,json=priorState,proto3" json:"prior_state,omitempty"`
+	ProposedNewState     *DynamicValue `protobuf:"bytes,3,opt,name=proposed_new_state,json=proposedNewState,proto3" json:"proposed_new_state,omitempty"`
+	Config               *DynamicValue `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	PriorPrivate         []byte        `protobuf:"bytes,5,opt,name=prior_private,json=priorPrivate,proto3" json:"prior_private,omitempty"`
+	ProviderMeta         *DynamicValue `protobuf:"bytes,6,opt,name=provider_meta,json=providerMeta,proto3" json:"provider_meta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PlanResourceChange_Request) Reset()         { *m = PlanResourceChange_Request{} }
+func (m *PlanResourceChange_Request) String() string { return proto.CompactTextString(m) }
+func (*PlanResourceChange_Request) ProtoMessage()    {}
+func (*PlanResourceChange_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{13, 0}
+}
+func (m *PlanResourceChange_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlanResourceChange_Request.Unmarshal(m, b)
+}
+func (m *PlanResourceChange_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlanResourceChange_Request.Marshal(b, m, deterministic)
+}
+func (dst *PlanResourceChange_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanResourceChange_Request.Merge(dst, src)
+}
+func (m *PlanResourceChange_Request) XXX_Size() int {
+	return xxx_messageInfo_PlanResourceChange_Request.Size(m)
+}
+func (m *PlanResourceChange_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanResourceChange_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanResourceChange_Request proto.InternalMessageInfo
+
+func (m *PlanResourceChange_Request) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *PlanResourceChange_Request) GetPriorState() *DynamicValue {
+	if m != nil {
+		return m.PriorState
+	}
+	return nil
+}
+
+func (m *PlanResourceChange_Request) GetProposedNewState() *DynamicValue {
+	if m != nil {
+		return m.ProposedNewState
+	}
+	return nil
+}
+
+func (m *PlanResourceChange_Request) GetConfig() *DynamicValue {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *PlanResourceChange_Request) GetPriorPrivate() []byte {
+	if m != nil {
+		return m.PriorPrivate
+	}
+	return nil
+}
+
+func (m *PlanResourceChange_Request) GetProviderMeta() *DynamicValue {
+	if m != nil {
+		return m.ProviderMeta
+	}
+	return nil
+}
+
+type PlanResourceChange_Response struct {
+	PlannedState    *DynamicValue    `protobuf:"bytes,1,opt,name=planned_state,json=plannedState,proto3" json:"planned_state,omitempty"`
+	RequiresReplace []*AttributePath `protobuf:"bytes,2,rep,name=requires_replace,json=requiresReplace,proto3" json:"requires_replace,omitempty"`
+	PlannedPrivate  []byte           `protobuf:"bytes,3,opt,name=planned_private,json=plannedPrivate,proto3" json:"planned_private,omitempty"`
+	Diagnostics     []*Diagnostic    `protobuf:"bytes,4,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	// This may be set only by the helper/schema "SDK" in the main Terraform
+	// repository, to request that Terraform Core >=0.12 permit additional
+	// inconsistencies that can result from the legacy SDK type system
+	// and its imprecise mapping to the >=0.12 type system.
+	// The change in behavior implied by this flag makes sense only for the
+	// specific details of the legacy SDK type system, and are not a general
+	// mechanism to avoid proper type handling in providers.
+	//
+	//     ====              DO NOT USE THIS              ====
+	//     ==== THIS MUST BE LEFT UNSET IN ALL OTHER SDKS ====
+	//     ====              DO NOT USE THIS              ====
+	LegacyTypeSystem     bool     `protobuf:"varint,5,opt,name=legacy_type_system,json=legacyTypeSystem,proto3" json:"legacy_type_system,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlanResourceChange_Response) Reset()         { *m = PlanResourceChange_Response{} }
+func (m *PlanResourceChange_Response) String() string { return proto.CompactTextString(m) }
+func (*PlanResourceChange_Response) ProtoMessage()    {}
+func (*PlanResourceChange_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{13, 1}
+}
+func (m *PlanResourceChange_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlanResourceChange_Response.Unmarshal(m, b)
+}
+func (m *PlanResourceChange_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlanResourceChange_Response.Marshal(b, m, deterministic)
+}
+func (dst *PlanResourceChange_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanResourceChange_Response.Merge(dst, src)
+}
+func (m *PlanResourceChange_Response) XXX_Size() int {
+	return xxx_messageInfo_PlanResourceChange_Response.Size(m)
+}
+func (m *PlanResourceChange_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanResourceChange_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanResourceChange_Response proto.InternalMessageInfo
+
+func (m *PlanResourceChange_Response) GetPlannedState() *DynamicValue {
+	if m != nil {
+		return m.PlannedState
+	}
+	return nil
+}
+
+func (m *PlanResourceChange_Response) GetRequiresReplace() []*AttributePath {
+	if m != nil {
+		return m.RequiresReplace
+	}
+	return nil
+}
+
+func (m *PlanResourceChange_Response) GetPlannedPrivate() []byte {
+	if m != nil {
+		return m.PlannedPrivate
+	}
+	return nil
+}
+
+func (m *PlanResourceChange_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+func (m *PlanResourceChange_Response) GetLegacyTypeSystem() bool {
+	if m != nil {
+		return m.LegacyTypeSystem
+	}
+	return false
+}
+
+type ApplyResourceChange struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplyResourceChange) Reset()         { *m = ApplyResourceChange{} }
+func (m *ApplyResourceChange) String() string { return proto.CompactTextString(m) }
+func (*ApplyResourceChange) ProtoMessage()    {}
+func (*ApplyResourceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{14}
+}
+func (m *ApplyResourceChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyResourceChange.Unmarshal(m, b)
+}
+func (m *ApplyResourceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyResourceChange.Marshal(b, m, deterministic)
+}
+func (dst *ApplyResourceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyResourceChange.Merge(dst, src)
+}
+func (m *ApplyResourceChange) XXX_Size() int {
+	return xxx_messageInfo_ApplyResourceChange.Size(m)
+}
+func (m *ApplyResourceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyResourceChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyResourceChange proto.InternalMessageInfo
+
+type ApplyResourceChange_Request struct {
+	TypeName             string        `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	PriorState           *DynamicValue `protobuf:"bytes,2,opt,name=prior_state,json=priorState,proto3" json:"prior_state,omitempty"`
+	PlannedState         *DynamicValue `protobuf:"bytes,3,opt,name=planned_state,json=plannedState,proto3" json:"planned_state,omitempty"`
+	Config               *DynamicValue `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	PlannedPrivate       []byte        `protobuf:"bytes,5,opt,name=planned_private,json=plannedPrivate,proto3" json:"planned_private,omitempty"`
+	ProviderMeta         *DynamicValue `protobuf:"bytes,6,opt,name=provider_meta,json=providerMeta,proto3" json:"provider_meta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ApplyResourceChange_Request) Reset()         { *m = ApplyResourceChange_Request{} }
+func (m *ApplyResourceChange_Request) String() string { return proto.CompactTextString(m) }
+func (*ApplyResourceChange_Request) ProtoMessage()    {}
+func (*ApplyResourceChange_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{14, 0}
+}
+func (m *ApplyResourceChange_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyResourceChange_Request.Unmarshal(m, b)
+}
+func (m *ApplyResourceChange_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyResourceChange_Request.Marshal(b, m, deterministic)
+}
+func (dst *ApplyResourceChange_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyResourceChange_Request.Merge(dst, src)
+}
+func (m *ApplyResourceChange_Request) XXX_Size() int {
+	return xxx_messageInfo_ApplyResourceChange_Request.Size(m)
+}
+func (m *ApplyResourceChange_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyResourceChange_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyResourceChange_Request proto.InternalMessageInfo
+
+func (m *ApplyResourceChange_Request) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *ApplyResourceChange_Request) GetPriorState() *DynamicValue {
+	if m != nil {
+		return m.PriorState
+	}
+	return nil
+}
+
+func (m *ApplyResourceChange_Request) GetPlannedState() *DynamicValue {
+	if m != nil {
+		return m.PlannedState
+	}
+	return nil
+}
+
+func (m *ApplyResourceChange_Request) GetConfig() *DynamicValue {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *ApplyResourceChange_Request) GetPlannedPrivate() []byte {
+	if m != nil {
+		return m.PlannedPrivate
+	}
+	return nil
+}
+
+func (m *ApplyResourceChange_Request) GetProviderMeta() *DynamicValue {
+	if m != nil {
+		return m.ProviderMeta
+	}
+	return nil
+}
+
+type ApplyResourceChange_Response struct {
+	NewState    *DynamicValue `protobuf:"bytes,1,opt,name=new_state,json=newState,proto3" json:"new_state,omitempty"`
+	Private     []byte        `protobuf:"bytes,2,opt,name=private,proto3" json:"private,omitempty"`
+	Diagnostics []*Diagnostic `protobuf:"bytes,3,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	// This may be set only by the helper/schema "SDK" in the main Terraform
+	// repository, to request that Terraform Core >=0.12 permit additional
+	// inconsistencies that can result from the legacy SDK type system
+	// and its imprecise mapping to the >=0.12 type system.
+	// The change in behavior implied by this flag makes sense only for the
+	// specific details of the legacy SDK type system, and are not a general
+	// mechanism to avoid proper type handling in providers.
+	//
+	//     ====              DO NOT USE THIS              ====
+	//     ==== THIS MUST BE LEFT UNSET IN ALL OTHER SDKS ====
+	//     ====              DO NOT USE THIS              ====
+	LegacyTypeSystem     bool     `protobuf:"varint,4,opt,name=legacy_type_system,json=legacyTypeSystem,proto3" json:"legacy_type_system,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplyResourceChange_Response) Reset()         { *m = ApplyResourceChange_Response{} }
+func (m *ApplyResourceChange_Response) String() string { return proto.CompactTextString(m) }
+func (*ApplyResourceChange_Response) ProtoMessage()    {}
+func (*ApplyResourceChange_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{14, 1}
+}
+func (m *ApplyResourceChange_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyResourceChange_Response.Unmarshal(m, b)
+}
+func (m *ApplyResourceChange_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyResourceChange_Response.Marshal(b, m, deterministic)
+}
+func (dst *ApplyResourceChange_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyResourceChange_Response.Merge(dst, src)
+}
+func (m *ApplyResourceChange_Response) XXX_Size() int {
+	return xxx_messageInfo_ApplyResourceChange_Response.Size(m)
+}
+func (m *ApplyResourceChange_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyResourceChange_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyResourceChange_Response proto.InternalMessageInfo
+
+func (m *ApplyResourceChange_Response) GetNewState() *DynamicValue {
+	if m != nil {
+		return m.NewState
+	}
+	return nil
+}
+
+func (m *ApplyResourceChange_Response) GetPrivate() []byte {
+	if m != nil {
+		return m.Private
+	}
+	return nil
+}
+
+func (m *ApplyResourceChange_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+func (m *ApplyResourceChange_Response) GetLegacyTypeSystem() bool {
+	if m != nil {
+		return m.LegacyTypeSystem
+	}
+	return false
+}
+
+type ImportResourceState struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportResourceState) Reset()         { *m = ImportResourceState{} }
+func (m *ImportResourceState) String() string { return proto.CompactTextString(m) }
+func (*ImportResourceState) ProtoMessage()    {}
+func (*ImportResourceState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{15}
+}
+func (m *ImportResourceState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportResourceState.Unmarshal(m, b)
+}
+func (m *ImportResourceState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportResourceState.Marshal(b, m, deterministic)
+}
+func (dst *ImportResourceState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResourceState.Merge(dst, src)
+}
+func (m *ImportResourceState) XXX_Size() int {
+	return xxx_messageInfo_ImportResourceState.Size(m)
+}
+func (m *ImportResourceState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResourceState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportResourceState proto.InternalMessageInfo
+
+type ImportResourceState_Request struct {
+	TypeName             string   `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportResourceState_Request) Reset()         { *m = ImportResourceState_Request{} }
+func (m *ImportResourceState_Request) String() string { return proto.CompactTextString(m) }
+func (*ImportResourceState_Request) ProtoMessage()    {}
+func (*ImportResourceState_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{15, 0}
+}
+func (m *ImportResourceState_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportResourceState_Request.Unmarshal(m, b)
+}
+func (m *ImportResourceState_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportResourceState_Request.Marshal(b, m, deterministic)
+}
+func (dst *ImportResourceState_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResourceState_Request.Merge(dst, src)
+}
+func (m *ImportResourceState_Request) XXX_Size() int {
+	return xxx_messageInfo_ImportResourceState_Request.Size(m)
+}
+func (m *ImportResourceState_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResourceState_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportResourceState_Request proto.InternalMessageInfo
+
+func (m *ImportResourceState_Request) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *ImportResourceState_Request) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ImportResourceState_ImportedResource struct {
+	TypeName             string        `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	State                *DynamicValue `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Private              []byte        `protobuf:"bytes,3,opt,name=private,proto3" json:"private,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ImportResourceState_ImportedResource) Reset()         { *m = ImportResourceState_ImportedResource{} }
+func (m *ImportResourceState_ImportedResource) String() string { return proto.CompactTextString(m) }
+func (*ImportResourceState_ImportedResource) ProtoMessage()    {}
+func (*ImportResourceState_ImportedResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{15, 1}
+}
+func (m *ImportResourceState_ImportedResource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportResourceState_ImportedResource.Unmarshal(m, b)
+}
+func (m *ImportResourceState_ImportedResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportResourceState_ImportedResource.Marshal(b, m, deterministic)
+}
+func (dst *ImportResourceState_ImportedResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResourceState_ImportedResource.Merge(dst, src)
+}
+func (m *ImportResourceState_ImportedResource) XXX_Size() int {
+	return xxx_messageInfo_ImportResourceState_ImportedResource.Size(m)
+}
+func (m *ImportResourceState_ImportedResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResourceState_ImportedResource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportResourceState_ImportedResource proto.InternalMessageInfo
+
+func (m *ImportResourceState_ImportedResource) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *ImportResourceState_ImportedResource) GetState() *DynamicValue {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *ImportResourceState_ImportedResource) GetPrivate() []byte {
+	if m != nil {
+		return m.Private
+	}
+	return nil
+}
+
+type ImportResourceState_Response struct {
+	ImportedResources    []*ImportResourceState_ImportedResource `protobuf:"bytes,1,rep,name=imported_resources,json=importedResources,proto3" json:"imported_resources,omitempty"`
+	Diagnostics          []*Diagnostic                           `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
+	XXX_unrecognized     []byte                                  `json:"-"`
+	XXX_sizecache        int32                                   `json:"-"`
+}
+
+func (m *ImportResourceState_Response) Reset()         { *m = ImportResourceState_Response{} }
+func (m *ImportResourceState_Response) String() string { return proto.CompactTextString(m) }
+func (*ImportResourceState_Response) ProtoMessage()    {}
+func (*ImportResourceState_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{15, 2}
+}
+func (m *ImportResourceState_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportResourceState_Response.Unmarshal(m, b)
+}
+func (m *ImportResourceState_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportResourceState_Response.Marshal(b, m, deterministic)
+}
+func (dst *ImportResourceState_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResourceState_Response.Merge(dst, src)
+}
+func (m *ImportResourceState_Response) XXX_Size() int {
+	return xxx_messageInfo_ImportResourceState_Response.Size(m)
+}
+func (m *ImportResourceState_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResourceState_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportResourceState_Response proto.InternalMessageInfo
+
+func (m *ImportResourceState_Response) GetImportedResources() []*ImportResourceState_ImportedResource {
+	if m != nil {
+		return m.ImportedResources
+	}
+	return nil
+}
+
+func (m *ImportResourceState_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type ReadDataSource struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadDataSource) Reset()         { *m = ReadDataSource{} }
+func (m *ReadDataSource) String() string { return proto.CompactTextString(m) }
+func (*ReadDataSource) ProtoMessage()    {}
+func (*ReadDataSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{16}
+}
+func (m *ReadDataSource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadDataSource.Unmarshal(m, b)
+}
+func (m *ReadDataSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadDataSource.Marshal(b, m, deterministic)
+}
+func (dst *ReadDataSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadDataSource.Merge(dst, src)
+}
+func (m *ReadDataSource) XXX_Size() int {
+	return xxx_messageInfo_ReadDataSource.Size(m)
+}
+func (m *ReadDataSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadDataSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadDataSource proto.InternalMessageInfo
+
+type ReadDataSource_Request struct {
+	TypeName             string        `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	Config               *DynamicValue `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	ProviderMeta         *DynamicValue `protobuf:"bytes,3,opt,name=provider_meta,json=providerMeta,proto3" json:"provider_meta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReadDataSource_Request) Reset()         { *m = ReadDataSource_Request{} }
+func (m *ReadDataSource_Request) String() string { return proto.CompactTextString(m) }
+func (*ReadDataSource_Request) ProtoMessage()    {}
+func (*ReadDataSource_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{16, 0}
+}
+func (m *ReadDataSource_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadDataSource_Request.Unmarshal(m, b)
+}
+func (m *ReadDataSource_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadDataSource_Request.Marshal(b, m, deterministic)
+}
+func (dst *ReadDataSource_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadDataSource_Request.Merge(dst, src)
+}
+func (m *ReadDataSource_Request) XXX_Size() int {
+	return xxx_messageInfo_ReadDataSource_Request.Size(m)
+}
+func (m *ReadDataSource_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadDataSource_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadDataSource_Request proto.InternalMessageInfo
+
+func (m *ReadDataSource_Request) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *ReadDataSource_Request) GetConfig() *DynamicValue {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *ReadDataSource_Request) GetProviderMeta() *DynamicValue {
+	if m != nil {
+		return m.ProviderMeta
+	}
+	return nil
+}
+
+type ReadDataSource_Response struct {
+	State                *DynamicValue `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReadDataSource_Response) Reset()         { *m = ReadDataSource_Response{} }
+func (m *ReadDataSource_Response) String() string { return proto.CompactTextString(m) }
+func (*ReadDataSource_Response) ProtoMessage()    {}
+func (*ReadDataSource_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tfplugin6_0e1d20d564c63656, []int{16, 1}
+}
+func (m *ReadDataSource_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadDataSource_Response.Unmarshal(m, b)
+}
+func (m *ReadDataSource_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadDataSource_Response.Marshal(b, m, deterministic)
+}
+func (dst *ReadDataSource_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadDataSource_Response.Merge(dst, src)
+}
+func (m *ReadDataSource_Response) XXX_Size() int {
+	return xxx_messageInfo_ReadDataSource_Response.Size(m)
+}
+func (m *ReadDataSource_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadDataSource_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadDataSource_Response proto.InternalMessageInfo
+
+func (m *ReadDataSource_Response) GetState() *DynamicValue {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *ReadDataSource_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DynamicValue)(nil), "tfplugin6.DynamicValue")
+	proto.RegisterType((*Diagnostic)(nil), "tfplugin6.Diagnostic")
+	proto.RegisterType((*AttributePath)(nil), "tfplugin6.AttributePath")
+	proto.RegisterType((*AttributePath_Step)(nil), "tfplugin6.AttributePath.Step")
+	proto.RegisterType((*StopProvider)(nil), "tfplugin6.StopProvider")
+	proto.RegisterType((*StopProvider_Request)(nil), "tfplugin6.StopProvider.Request")
+	proto.RegisterType((*StopProvider_Response)(nil), "tfplugin6.StopProvider.Response")
+	proto.RegisterType((*RawState)(nil), "tfplugin6.RawState")
+	proto.RegisterMapType((map[string]string)(nil), "tfplugin6.RawState.FlatmapEntry")
+	proto.RegisterType((*Schema)(nil), "tfplugin6.Schema")
+	proto.RegisterType((*Schema_Block)(nil), "tfplugin6.Schema.Block")
+	proto.RegisterType((*Schema_Attribute)(nil), "tfplugin6.Schema.Attribute")
+	proto.RegisterType((*Schema_NestedBlock)(nil), "tfplugin6.Schema.NestedBlock")
+	proto.RegisterType((*Schema_Object)(nil), "tfplugin6.Schema.Object")
+	proto.RegisterType((*GetProviderSchema)(nil), "tfplugin6.GetProviderSchema")
+	proto.RegisterType((*GetProviderSchema_Request)(nil), "tfplugin6.GetProviderSchema.Request")
+	proto.RegisterType((*GetProviderSchema_Response)(nil), "tfplugin6.GetProviderSchema.Response")
+	proto.RegisterMapType((map[string]*Schema)(nil), "tfplugin6.GetProviderSchema.Response.DataSourceSchemasEntry")
+	proto.RegisterMapType((map[string]*Schema)(nil), "tfplugin6.GetProviderSchema.Response.ResourceSchemasEntry")
+	proto.RegisterType((*ValidateProviderConfig)(nil), "tfplugin6.ValidateProviderConfig")
+	proto.RegisterType((*ValidateProviderConfig_Request)(nil), "tfplugin6.ValidateProviderConfig.Request")
+	proto.RegisterType((*ValidateProviderConfig_Response)(nil), "tfplugin6.ValidateProviderConfig.Response")
+	proto.RegisterType((*UpgradeResourceState)(nil), "tfplugin6.UpgradeResourceState")
+	proto.RegisterType((*UpgradeResourceState_Request)(nil), "tfplugin6.UpgradeResourceState.Request")
+	proto.RegisterType((*UpgradeResourceState_Response)(nil), "tfplugin6.UpgradeResourceState.Response")
+	proto.RegisterType((*ValidateResourceConfig)(nil), "tfplugin6.ValidateResourceConfig")
+	proto.RegisterType((*ValidateResourceConfig_Request)(nil), "tfplugin6.ValidateResourceConfig.Request")
+	proto.RegisterType((*ValidateResourceConfig_Response)(nil), "tfplugin6.ValidateResourceConfig.Response")
+	proto.RegisterType((*ValidateDataResourceConfig)(nil), "tfplugin6.ValidateDataResourceConfig")
+	proto.RegisterType((*ValidateDataResourceConfig_Request)(nil), "tfplugin6.ValidateDataResourceConfig.Request")
+	proto.RegisterType((*ValidateDataResourceConfig_Response)(nil), "tfplugin6.ValidateDataResourceConfig.Response")
+	proto.RegisterType((*ConfigureProvider)(nil), "tfplugin6.ConfigureProvider")
+	proto.RegisterType((*ConfigureProvider_Request)(nil), "tfplugin6.ConfigureProvider.Request")
+	proto.RegisterType((*ConfigureProvider_Response)(nil), "tfplugin6.ConfigureProvider.Response")
+	proto.RegisterType((*ReadResource)(nil), "tfplugin6.ReadResource")
+	proto.RegisterType((*ReadResource_Request)(nil), "tfplugin6.ReadResource.Request")
+	proto.RegisterType((*ReadResource_Response)(nil), "tfplugin6.ReadResource.Response")
+	proto.RegisterType((*PlanResourceChange)(nil), "tfplugin6.PlanResourceChange")
+	proto.RegisterType((*PlanResourceChange_Request)(nil), "tfplugin6.PlanResourceChange.Request")
+	proto.RegisterType((*PlanResourceChange_Response)(nil), "tfplugin6.PlanResourceChange.Response")
+	proto.RegisterType((*ApplyResourceChange)(nil), "tfplugin6.ApplyResourceChange")
+	proto.RegisterType((*ApplyResourceChange_Request)(nil), "tfplugin6.ApplyResourceChange.Request")
+	proto.RegisterType((*ApplyResourceChange_Response)(nil), "tfplugin6.ApplyResourceChange.Response")
+	proto.RegisterType((*ImportResourceState)(nil), "tfplugin6.ImportResourceState")
+	proto.RegisterType((*ImportResourceState_Request)(nil), "tfplugin6.ImportResourceState.Request")
+	proto.RegisterType((*ImportResourceState_ImportedResource)(nil), "tfplugin6.ImportResourceState.ImportedResource")
+	proto.RegisterType((*ImportResourceState_Response)(nil), "tfplugin6.ImportResourceState.Response")
+	proto.RegisterType((*ReadDataSource)(nil), "tfplugin6.ReadDataSource")
+	proto.RegisterType((*ReadDataSource_Request)(nil), "tfplugin6.ReadDataSource.Request")
+	proto.RegisterType((*ReadDataSource_Response)(nil), "tfplugin6.ReadDataSource.Response")
+	proto.RegisterEnum("tfplugin6.StringKind", StringKind_name, StringKind_value)
+	proto.RegisterEnum("tfplugin6.Diagnostic_Severity", Diagnostic_Severity_name, Diagnostic_Severity_value)
+	proto.RegisterEnum("tfplugin6.Schema_NestedBlock_NestingMode", Schema_NestedBlock_NestingMode_name, Schema_NestedBlock_NestingMode_value)
+	proto.RegisterEnum("tfplugin6.Schema_Object_NestingMode", Schema_Object_NestingMode_name, Schema_Object_NestingMode_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ProviderClient is the client API for Provider service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProviderClient interface {
+	// ////// Information about what a provider supports/expects
+	GetProviderSchema(ctx context.Context, in *GetProviderSchema_Request, opts ...grpc.CallOption) (*GetProviderSchema_Response, error)
+	ValidateProviderConfig(ctx context.Context, in *ValidateProviderConfig_Request, opts ...grpc.CallOption) (*ValidateProviderConfig_Response, error)
+	ValidateResourceConfig(ctx context.Context, in *ValidateResourceConfig_Request, opts ...grpc.CallOption) (*ValidateResourceConfig_Response, error)
+	ValidateDataResourceConfig(ctx context.Context, in *ValidateDataResourceConfig_Request, opts ...grpc.CallOption) (*ValidateDataResourceConfig_Response, error)
+	UpgradeResourceState(ctx context.Context, in *UpgradeResourceState_Request, opts ...grpc.CallOption) (*UpgradeResourceState_Response, error)
+	// ////// One-time initialization, called before other functions below
+	ConfigureProvider(ctx context.Context, in *ConfigureProvider_Request, opts ...grpc.CallOption) (*ConfigureProvider_Response, error)
+	// ////// Managed Resource Lifecycle
+	ReadResource(ctx context.Context, in *ReadResource_Request, opts ...grpc.CallOption) (*ReadResource_Response, error)
+	PlanResourceChange(ctx context.Context, in *PlanResourceChange_Request, opts ...grpc.CallOption) (*PlanResourceChange_Response, error)
+	ApplyResourceChange(ctx context.Context, in *ApplyResourceChange_Request, opts ...grpc.CallOption) (*ApplyResourceChange_Response, error)
+	ImportResourceState(ctx context.Context, in *ImportResourceState_Request, opts ...grpc.CallOption) (*ImportResourceState_Response, error)
+	ReadDataSource(ctx context.Context, in *ReadDataSource_Request, opts ...grpc.CallOption) (*ReadDataSource_Response, error)
+	// ////// Graceful Shutdown
+	StopProvider(ctx context.Context, in *StopProvider_Request, opts ...grpc.CallOption) (*StopProvider_Response, error)
+}
+
+type providerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewProviderClient(cc *grpc.ClientConn) ProviderClient {
+	return &providerClient{cc}
+}
+
+func (c *providerClient) GetProviderSchema(ctx context.Context, in *GetProviderSchema_Request, opts ...grpc.CallOption) (*GetProviderSchema_Response, error) {
+	out := new(GetProviderSchema_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin6.Provider/GetProviderSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ValidateProviderConfig(ctx context.Context, in *ValidateProviderConfig_Request, opts ...grpc.CallOption) (*ValidateProviderConfig_Response, error) {
+	out := new(ValidateProviderConfig_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin6.Provider/ValidateProviderConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ValidateResourceConfig(ctx context.Context, in *ValidateResourceConfig_Request, opts ...grpc.CallOption) (*ValidateResourceConfig_Response, error) {
+	out := new(ValidateResourceConfig_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin6.Provider/ValidateResourceConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ValidateDataResourceConfig(ctx context.Context, in *ValidateDataResourceConfig_Request, opts ...grpc.CallOption) (*ValidateDataResourceConfig_Response, error) {
+	out := new(ValidateDataResourceConfig_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin6.Provider/ValidateDataResourceConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) UpgradeResourceState(ctx context.Context, in *UpgradeResourceState_Request, opts ...grpc.CallOption) (*UpgradeResourceState_Response, error) {
+	out := new(UpgradeResourceState_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin6.Provider/UpgradeResourceState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ConfigureProvider(ctx context.Context, in *ConfigureProvider_Request, opts ...grpc.CallOption) (*ConfigureProvider_Response, error) {
+	out := new(ConfigureProvider_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin6.Provider/ConfigureProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ReadResource(ctx context.Context, in *ReadResource_Request, opts ...grpc.CallOption) (*ReadResource_Response, error) {
+	out := new(ReadResource_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin6.Provider/ReadResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) PlanResourceChange(ctx context.Context, in *PlanResourceChange_Request, opts ...grpc.CallOption) (*PlanResourceChange_Response, error) {
+	out := new(PlanResourceChange_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin6.Provider/PlanResourceChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ApplyResourceChange(ctx context.Context, in *ApplyResourceChange_Request, opts ...grpc.CallOption) (*ApplyResourceChange_Response, error) {
+	out := new(ApplyResourceChange_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin6.Provider/ApplyResourceChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ImportResourceState(ctx context.Context, in *ImportResourceState_Request, opts ...grpc.CallOption) (*ImportResourceState_Response, error) {
+	out := new(ImportResourceState_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin6.Provider/ImportResourceState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ReadDataSource(ctx context.Context, in *ReadDataSource_Request, opts ...grpc.CallOption) (*ReadDataSource_Response, error) {
+	out := new(ReadDataSource_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin6.Provider/ReadDataSource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) StopProvider(ctx context.Context, in *StopProvider_Request, opts ...grpc.CallOption) (*StopProvider_Response, error) {
+	out := new(StopProvider_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin6.Provider/StopProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProviderServer is the server API for Provider service.
+type ProviderServer interface {
+	// ////// Information about what a provider supports/expects
+	GetProviderSchema(context.Context, *GetProviderSchema_Request) (*GetProviderSchema_Response, error)
+	ValidateProviderConfig(context.Context, *ValidateProviderConfig_Request) (*ValidateProviderConfig_Response, error)
+	ValidateResourceConfig(context.Context, *ValidateResourceConfig_Request) (*ValidateResourceConfig_Response, error)
+	ValidateDataResourceConfig(context.Context, *ValidateDataResourceConfig_Request) (*ValidateDataResourceConfig_Response, error)
+	UpgradeResourceState(context.Context, *UpgradeResourceState_Request) (*UpgradeResourceState_Response, error)
+	// ////// One-time initialization, called before other functions below
+	ConfigureProvider(context.Context, *ConfigureProvider_Request) (*ConfigureProvider_Response, error)
+	// ////// Managed Resource Lifecycle
+	ReadResource(context.Context, *ReadResource_Request) (*ReadResource_Response, error)
+	PlanResourceChange(context.Context, *PlanResourceChange_Request) (*PlanResourceChange_Response, error)
+	ApplyResourceChange(context.Context, *ApplyResourceChange_Request) (*ApplyResourceChange_Response, error)
+	ImportResourceState(context.Context, *ImportResourceState_Request) (*ImportResourceState_Response, error)
+	ReadDataSource(context.Context, *ReadDataSource_Request) (*ReadDataSource_Response, error)
+	// ////// Graceful Shutdown
+	StopProvider(context.Context, *StopProvider_Request) (*StopProvider_Response, error)
+}
+
+func RegisterProviderServer(s *grpc.Server, srv ProviderServer) {
+	s.RegisterService(&_Provider_serviceDesc, srv)
+}
+
+func _Provider_GetProviderSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProviderSchema_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).GetProviderSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin6.Provider/GetProviderSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).GetProviderSchema(ctx, req.(*GetProviderSchema_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_ValidateProviderConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateProviderConfig_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ValidateProviderConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin6.Provider/ValidateProviderConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ValidateProviderConfig(ctx, req.(*ValidateProviderConfig_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_ValidateResourceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateResourceConfig_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ValidateResourceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin6.Provider/ValidateResourceConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ValidateResourceConfig(ctx, req.(*ValidateResourceConfig_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_ValidateDataResourceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateDataResourceConfig_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ValidateDataResourceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin6.Provider/ValidateDataResourceConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ValidateDataResourceConfig(ctx, req.(*ValidateDataResourceConfig_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_UpgradeResourceState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeResourceState_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).UpgradeResourceState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin6.Provider/UpgradeResourceState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).UpgradeResourceState(ctx, req.(*UpgradeResourceState_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_ConfigureProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureProvider_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ConfigureProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin6.Provider/ConfigureProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ConfigureProvider(ctx, req.(*ConfigureProvider_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_ReadResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadResource_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ReadResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin6.Provider/ReadResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ReadResource(ctx, req.(*ReadResource_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_PlanResourceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanResourceChange_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).PlanResourceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin6.Provider/PlanResourceChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).PlanResourceChange(ctx, req.(*PlanResourceChange_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_ApplyResourceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyResourceChange_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ApplyResourceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin6.Provider/ApplyResourceChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ApplyResourceChange(ctx, req.(*ApplyResourceChange_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_ImportResourceState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportResourceState_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ImportResourceState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin6.Provider/ImportResourceState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ImportResourceState(ctx, req.(*ImportResourceState_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_ReadDataSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadDataSource_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ReadDataSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin6.Provider/ReadDataSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ReadDataSource(ctx, req.(*ReadDataSource_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_StopProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopProvider_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).StopProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin6.Provider/StopProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).StopProvider(ctx, req.(*StopProvider_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Provider_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tfplugin6.Provider",
+	HandlerType: (*ProviderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProviderSchema",
+			Handler:    _Provider_GetProviderSchema_Handler,
+		},
+		{
+			MethodName: "ValidateProviderConfig",
+			Handler:    _Provider_ValidateProviderConfig_Handler,
+		},
+		{
+			MethodName: "ValidateResourceConfig",
+			Handler:    _Provider_ValidateResourceConfig_Handler,
+		},
+		{
+			MethodName: "ValidateDataResourceConfig",
+			Handler:    _Provider_ValidateDataResourceConfig_Handler,
+		},
+		{
+			MethodName: "UpgradeResourceState",
+			Handler:    _Provider_UpgradeResourceState_Handler,
+		},
+		{
+			MethodName: "ConfigureProvider",
+			Handler:    _Provider_ConfigureProvider_Handler,
+		},
+		{
+			MethodName: "ReadResource",
+			Handler:    _Provider_ReadResource_Handler,
+		},
+		{
+			MethodName: "PlanResourceChange",
+			Handler:    _Provider_PlanResourceChange_Handler,
+		},
+		{
+			MethodName: "ApplyResourceChange",
+			Handler:    _Provider_ApplyResourceChange_Handler,
+		},
+		{
+			MethodName: "ImportResourceState",
+			Handler:    _Provider_ImportResourceState_Handler,
+		},
+		{
+			MethodName: "ReadDataSource",
+			Handler:    _Provider_ReadDataSource_Handler,
+		},
+		{
+			MethodName: "StopProvider",
+			Handler:    _Provider_StopProvider_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tfplugin6.proto",
+}
+
+func init() { proto.RegisterFile("tfplugin6.proto", fileDescriptor_tfplugin6_0e1d20d564c63656) }
+
+var fileDescriptor_tfplugin6_0e1d20d564c63656 = []byte{
+	// 1871 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4f, 0x73, 0x1b, 0x49,
+	0x15, 0xcf, 0x8c, 0x24, 0x5b, 0x7a, 0x92, 0xed, 0x71, 0x27, 0x1b, 0x54, 0xb3, 0xb0, 0x18, 0xb1,
+	0x8b, 0xcd, 0x42, 0x14, 0xca, 0x4b, 0x85, 0xc5, 0x9b, 0xda, 0xc2, 0x89, 0x4d, 0x56, 0x95, 0x58,
+	0x31, 0xad, 0x24, 0xbe, 0xa1, 0xed, 0x68, 0xda, 0xca, 0xac, 0x35, 0x7f, 0xb6, 0xa7, 0xe5, 0x44,
+	0xc5, 0x71, 0xcf, 0x54, 0x51, 0x50, 0x50, 0x45, 0x15, 0x5c, 0xe0, 0xc0, 0x91, 0xdb, 0x1e, 0x80,
+	0xcb, 0xde, 0x39, 0xf0, 0x01, 0xb8, 0x2d, 0x57, 0x2e, 0x7c, 0x02, 0xaa, 0x7b, 0x7a, 0x66, 0x7a,
+	0xa4, 0x91, 0x2d, 0xdb, 0x6c, 0x51, 0x7b, 0x9b, 0x7e, 0xef, 0xf5, 0xfb, 0xf3, 0x7b, 0xbf, 0x79,
+	0xd3, 0x2d, 0xc1, 0x1a, 0x3f, 0x0e, 0x47, 0xe3, 0xa1, 0xeb, 0xdf, 0x69, 0x87, 0x2c, 0xe0, 0x01,
+	0xaa, 0xa5, 0x82, 0xd6, 0x5d, 0x68, 0xec, 0x4d, 0x7c, 0xe2, 0xb9, 0x83, 0x67, 0x64, 0x34, 0xa6,
+	0xa8, 0x09, 0xcb, 0x5e, 0x34, 0x0c, 0xc9, 0xe0, 0xa4, 0x69, 0x6c, 0x18, 0x5b, 0x0d, 0x9c, 0x2c,
+	0x11, 0x82, 0xf2, 0x47, 0x51, 0xe0, 0x37, 0x4d, 0x29, 0x96, 0xcf, 0xad, 0xcf, 0x0d, 0x80, 0x3d,
+	0x97, 0x0c, 0xfd, 0x20, 0xe2, 0xee, 0x00, 0xed, 0x40, 0x35, 0xa2, 0xa7, 0x94, 0xb9, 0x7c, 0x22,
+	0x77, 0xaf, 0x6e, 0xbf, 0xd1, 0xce, 0x62, 0x67, 0x86, 0xed, 0x9e, 0xb2, 0xc2, 0xa9, 0xbd, 0x08,
+	0x1c, 0x8d, 0x3d, 0x8f, 0xb0, 0x89, 0x8c, 0x50, 0xc3, 0xc9, 0x12, 0xdd, 0x84, 0x25, 0x87, 0x72,
+	0xe2, 0x8e, 0x9a, 0x25, 0xa9, 0x50, 0x2b, 0x74, 0x07, 0x6a, 0x84, 0x73, 0xe6, 0x3e, 0x1f, 0x73,
+	0xda, 0x2c, 0x6f, 0x18, 0x5b, 0xf5, 0xed, 0xa6, 0x16, 0x6e, 0x37, 0xd1, 0x1d, 0x12, 0xfe, 0x02,
+	0x67, 0xa6, 0xad, 0xdb, 0x50, 0x4d, 0xe2, 0xa3, 0x3a, 0x2c, 0x77, 0xba, 0xcf, 0x76, 0x1f, 0x75,
+	0xf6, 0xac, 0x6b, 0xa8, 0x06, 0x95, 0x7d, 0x8c, 0x1f, 0x63, 0xcb, 0x10, 0xf2, 0xa3, 0x5d, 0xdc,
+	0xed, 0x74, 0x1f, 0x58, 0x66, 0xeb, 0x9f, 0x06, 0xac, 0xe4, 0xbc, 0xa1, 0x77, 0xa0, 0x12, 0x71,
+	0x1a, 0x46, 0x4d, 0x63, 0xa3, 0xb4, 0x55, 0xdf, 0xfe, 0xda, 0xbc, 0xb0, 0xed, 0x1e, 0xa7, 0x21,
+	0x8e, 0x6d, 0xed, 0x5f, 0x1b, 0x50, 0x16, 0x6b, 0xb4, 0x09, 0xab, 0x69, 0x36, 0x7d, 0x9f, 0x78,
+	0x54, 0x82, 0x55, 0xfb, 0xe0, 0x1a, 0x5e, 0x49, 0xe5, 0x5d, 0xe2, 0x51, 0xd4, 0x06, 0x44, 0x47,
+	0xd4, 0xa3, 0x3e, 0xef, 0x9f, 0xd0, 0x49, 0x3f, 0xe2, 0xcc, 0xf5, 0x87, 0x31, 0x3c, 0x1f, 0x5c,
+	0xc3, 0x96, 0xd2, 0x3d, 0xa4, 0x93, 0x9e, 0xd4, 0xa0, 0x2d, 0x58, 0xd3, 0xed, 0x5d, 0x9f, 0x4b,
+	0xc8, 0x4a, 0xc2, 0x73, 0x66, 0xdc, 0xf1, 0xf9, 0x3d, 0x10, 0x9d, 0x1a, 0xd1, 0x01, 0x0f, 0x58,
+	0xeb, 0x3d, 0x68, 0xf4, 0x78, 0x10, 0x1e, 0xb2, 0xe0, 0xd4, 0x75, 0x28, 0xb3, 0x6b, 0xb0, 0x8c,
+	0xe9, 0xc7, 0x63, 0x1a, 0x71, 0x7b, 0x03, 0xaa, 0x98, 0x46, 0x61, 0xe0, 0x47, 0x14, 0xdd, 0x80,
+	0xca, 0x3e, 0x63, 0x01, 0x8b, 0x93, 0xc5, 0xf1, 0xa2, 0xf5, 0x1b, 0x03, 0xaa, 0x98, 0xbc, 0xec,
+	0x71, 0xc2, 0x69, 0x4a, 0x11, 0x23, 0xa3, 0x08, 0xda, 0x81, 0xe5, 0xe3, 0x11, 0xe1, 0x1e, 0x09,
+	0x9b, 0xa6, 0x04, 0x6b, 0x43, 0x03, 0x2b, 0xd9, 0xd9, 0xfe, 0x71, 0x6c, 0xb2, 0xef, 0x73, 0x36,
+	0xc1, 0xc9, 0x06, 0x7b, 0x07, 0x1a, 0xba, 0x02, 0x59, 0x50, 0x3a, 0xa1, 0x13, 0x95, 0x80, 0x78,
+	0x14, 0x49, 0x9d, 0x0a, 0xde, 0x2a, 0xce, 0xc4, 0x8b, 0x1d, 0xf3, 0x5d, 0xa3, 0xf5, 0x73, 0x80,
+	0xa5, 0xde, 0xe0, 0x05, 0xf5, 0x88, 0xa0, 0xd6, 0x29, 0x65, 0x91, 0xab, 0x32, 0x2b, 0xe1, 0x64,
+	0x89, 0x6e, 0x41, 0xe5, 0xf9, 0x28, 0x18, 0x9c, 0xc8, 0xed, 0xf5, 0xed, 0xaf, 0x68, 0xa9, 0xc5,
+	0x7b, 0xdb, 0xf7, 0x84, 0x1a, 0xc7, 0x56, 0xf6, 0x1f, 0x4c, 0xa8, 0x48, 0xc1, 0x19, 0x2e, 0xdf,
+	0x03, 0x48, 0x9b, 0x18, 0xa9, 0x92, 0x5f, 0x9f, 0xf5, 0x9b, 0xd2, 0x04, 0x6b, 0xe6, 0xe8, 0x7d,
+	0xa8, 0xcb, 0x48, 0x7d, 0x3e, 0x09, 0x69, 0xd4, 0x2c, 0xcd, 0xb0, 0x4b, 0xed, 0xee, 0xd2, 0x88,
+	0x53, 0x27, 0xce, 0x0d, 0xe4, 0x8e, 0x27, 0x62, 0x03, 0xda, 0x80, 0xba, 0x43, 0xa3, 0x01, 0x73,
+	0x43, 0x2e, 0x52, 0x2b, 0x4b, 0x50, 0x74, 0x11, 0xfa, 0x11, 0x58, 0xda, 0xb2, 0x7f, 0xe2, 0xfa,
+	0x4e, 0xb3, 0x22, 0x5f, 0xd5, 0xd7, 0xf4, 0x30, 0x92, 0x4f, 0x0f, 0x5d, 0xdf, 0xc1, 0x6b, 0x9a,
+	0xb9, 0x10, 0xa0, 0x37, 0x00, 0x1c, 0x1a, 0x32, 0x3a, 0x20, 0x9c, 0x3a, 0xcd, 0xa5, 0x0d, 0x63,
+	0xab, 0x8a, 0x35, 0x89, 0xfd, 0x2f, 0x13, 0x6a, 0x69, 0x75, 0x82, 0x12, 0x19, 0xc3, 0xb1, 0x7c,
+	0x16, 0x32, 0x51, 0x5f, 0x32, 0x49, 0xc4, 0x33, 0xfa, 0x21, 0xd4, 0x7d, 0x59, 0x94, 0x2c, 0xbd,
+	0x09, 0x33, 0xaf, 0xb3, 0xaa, 0xfc, 0xf1, 0xf3, 0x8f, 0xe8, 0x80, 0x63, 0x88, 0x8d, 0x45, 0xd5,
+	0xd3, 0x45, 0x97, 0x66, 0x8b, 0xb6, 0xa1, 0xca, 0xe8, 0xc7, 0x63, 0x97, 0x51, 0x47, 0x62, 0x52,
+	0xc5, 0xe9, 0x5a, 0xe8, 0x02, 0x69, 0x45, 0x46, 0x12, 0x88, 0x2a, 0x4e, 0xd7, 0x42, 0x37, 0x08,
+	0xbc, 0x70, 0x9c, 0x15, 0x9a, 0xae, 0xd1, 0x57, 0xa1, 0x16, 0x51, 0x3f, 0x72, 0xb9, 0x7b, 0x4a,
+	0x9b, 0xcb, 0x52, 0x99, 0x09, 0x0a, 0x61, 0xae, 0x5e, 0x01, 0xe6, 0xda, 0x0c, 0xcc, 0x7f, 0x32,
+	0xa1, 0xae, 0xd1, 0x00, 0xbd, 0x0e, 0x35, 0x81, 0x9c, 0x36, 0x4f, 0x70, 0x55, 0x08, 0xe4, 0x20,
+	0xb9, 0x18, 0xcf, 0xd1, 0x7d, 0x58, 0x16, 0xf8, 0x8a, 0x61, 0x53, 0x92, 0x49, 0x7f, 0xfb, 0x4c,
+	0x0a, 0xca, 0x67, 0xd7, 0x1f, 0x1e, 0x04, 0x0e, 0xc5, 0xc9, 0x4e, 0x91, 0x90, 0xe7, 0xfa, 0x7d,
+	0x97, 0x53, 0x2f, 0x92, 0xa8, 0x97, 0x70, 0xd5, 0x73, 0xfd, 0x8e, 0x58, 0x4b, 0x25, 0x79, 0xa5,
+	0x94, 0x15, 0xa5, 0x24, 0xaf, 0xa4, 0xb2, 0x75, 0x00, 0x75, 0xcd, 0x63, 0x7e, 0x46, 0x8b, 0xb7,
+	0xba, 0xd3, 0x7d, 0xf0, 0x68, 0xdf, 0x32, 0x50, 0x15, 0xca, 0x8f, 0x3a, 0xbd, 0x27, 0x96, 0x89,
+	0x96, 0xa1, 0xd4, 0xdb, 0x7f, 0x62, 0x95, 0xc4, 0xc3, 0xc1, 0xee, 0xa1, 0x55, 0x16, 0xb3, 0xfc,
+	0x01, 0x7e, 0xfc, 0xf4, 0xd0, 0xaa, 0xd8, 0x9f, 0x98, 0xb0, 0x14, 0xd3, 0x66, 0xea, 0xe5, 0x34,
+	0x2e, 0xfa, 0x72, 0x4e, 0xa1, 0xf2, 0xe6, 0x3c, 0x7a, 0xfe, 0xaf, 0x01, 0xb9, 0x77, 0x75, 0x40,
+	0x5a, 0xff, 0x28, 0xc3, 0xfa, 0x03, 0xca, 0x93, 0x29, 0x1f, 0xe7, 0xab, 0xcf, 0xfa, 0x3f, 0x97,
+	0xb5, 0x61, 0x7f, 0x0b, 0xaa, 0xa1, 0xb2, 0x94, 0x64, 0xaa, 0x6f, 0xaf, 0xcf, 0x14, 0x8b, 0x53,
+	0x13, 0x44, 0xc1, 0x62, 0x34, 0x0a, 0xc6, 0x6c, 0x40, 0xfb, 0x91, 0x54, 0x26, 0xa3, 0x6f, 0x47,
+	0xdb, 0x36, 0x13, 0xbe, 0x9d, 0xc4, 0x6b, 0x63, 0xb5, 0x3b, 0x96, 0x47, 0xf1, 0x77, 0x60, 0x8d,
+	0xe5, 0xa5, 0x68, 0x04, 0xd7, 0x1d, 0xc2, 0x49, 0x7f, 0x2a, 0x52, 0x3c, 0x26, 0xef, 0x2e, 0x16,
+	0x69, 0x8f, 0x70, 0xd2, 0x9b, 0x8d, 0xb5, 0xee, 0x4c, 0xcb, 0xd1, 0x0f, 0xa0, 0xee, 0xa4, 0x47,
+	0x16, 0xd1, 0x31, 0x11, 0xe5, 0xb5, 0xc2, 0x03, 0x0d, 0xd6, 0x2d, 0xd1, 0x1d, 0x58, 0x49, 0x90,
+	0xe9, 0x7b, 0x94, 0x93, 0x66, 0x65, 0x1e, 0x82, 0x8d, 0xc4, 0xee, 0x80, 0x72, 0x62, 0x3f, 0x85,
+	0x1b, 0x45, 0x38, 0x14, 0x7c, 0xf6, 0x36, 0xf5, 0xcf, 0x5e, 0xa1, 0xe7, 0xec, 0x4b, 0x68, 0x1f,
+	0xc1, 0xcd, 0xe2, 0xa2, 0xaf, 0xe8, 0xb8, 0xf5, 0x5b, 0x03, 0x6e, 0x3e, 0x23, 0x23, 0xd7, 0x21,
+	0x9c, 0x26, 0x70, 0xdf, 0x0f, 0xfc, 0x63, 0x77, 0x68, 0xef, 0xa4, 0xbc, 0x42, 0xb7, 0x61, 0x69,
+	0x20, 0x85, 0x4d, 0x63, 0x66, 0xf8, 0xe8, 0x47, 0x4f, 0xac, 0xcc, 0xec, 0xfb, 0x1a, 0x0f, 0xa7,
+	0x7a, 0x60, 0x2e, 0xda, 0x83, 0xd6, 0x2f, 0x4c, 0xb8, 0xf1, 0x34, 0x1c, 0x32, 0xe2, 0xd0, 0x14,
+	0x53, 0x4e, 0x38, 0xb5, 0x59, 0x96, 0xd9, 0x99, 0x23, 0x53, 0xfb, 0xc2, 0x9b, 0xf9, 0x2f, 0xfc,
+	0xf7, 0xa0, 0xc6, 0xc8, 0xcb, 0x7e, 0x24, 0xdc, 0xc9, 0x49, 0x50, 0xdf, 0xbe, 0x5e, 0x70, 0xa6,
+	0xc1, 0x55, 0xa6, 0x9e, 0xec, 0x4f, 0x0c, 0xad, 0xa4, 0xf7, 0x61, 0x75, 0x1c, 0x27, 0xe6, 0x28,
+	0x1f, 0xe7, 0xe0, 0xb2, 0x92, 0x98, 0xc7, 0x87, 0xac, 0x4b, 0x43, 0xf2, 0xa9, 0xd6, 0xae, 0x04,
+	0x13, 0xd5, 0xae, 0xa3, 0x05, 0x41, 0xc9, 0x7a, 0x69, 0x5e, 0xb9, 0x97, 0xc6, 0xc2, 0x89, 0xff,
+	0xc5, 0x00, 0x3b, 0x49, 0x5c, 0x30, 0xf9, 0x4b, 0x95, 0xfc, 0x67, 0x06, 0xac, 0xc7, 0x89, 0x8e,
+	0x59, 0xfa, 0x96, 0xd8, 0xc3, 0x2c, 0xe7, 0xef, 0xc0, 0x3a, 0xa7, 0x8c, 0x91, 0xe3, 0x80, 0x79,
+	0x7d, 0xfd, 0x50, 0x59, 0xc3, 0x56, 0xaa, 0x78, 0xa6, 0xb8, 0xf7, 0xff, 0xa9, 0xe1, 0x73, 0x13,
+	0x1a, 0x98, 0x12, 0x27, 0x01, 0xde, 0xfe, 0x9b, 0xb1, 0x20, 0xe6, 0x77, 0x61, 0x65, 0x30, 0x66,
+	0x4c, 0xdc, 0x48, 0x62, 0xae, 0x9f, 0x93, 0x76, 0x43, 0x59, 0xc7, 0x54, 0x6f, 0xc2, 0x72, 0xc8,
+	0xdc, 0xd3, 0xe4, 0x3d, 0x6b, 0xe0, 0x64, 0x29, 0xfc, 0xe6, 0x47, 0x6c, 0xf9, 0x1c, 0xbf, 0xb9,
+	0x41, 0xfb, 0x2b, 0xfd, 0x7d, 0xfc, 0x3e, 0xd4, 0x7c, 0xfa, 0x72, 0xb1, 0x57, 0xb1, 0xea, 0xd3,
+	0x97, 0x57, 0x7b, 0x0b, 0xe7, 0xd7, 0xd4, 0xfa, 0x4f, 0x19, 0xd0, 0xe1, 0x88, 0xf8, 0x29, 0xbd,
+	0x5f, 0x10, 0x7f, 0x48, 0xed, 0xbf, 0x9a, 0x0b, 0x62, 0xfd, 0x2e, 0xd4, 0x43, 0xe6, 0x06, 0x6c,
+	0x31, 0xa4, 0x41, 0xda, 0xc6, 0xc5, 0xec, 0x03, 0x0a, 0x59, 0x10, 0x06, 0x11, 0x75, 0xfa, 0x19,
+	0x16, 0xa5, 0xb3, 0x1d, 0x58, 0xc9, 0x96, 0x6e, 0x82, 0x49, 0x46, 0xce, 0xf2, 0x42, 0xe4, 0x44,
+	0xdf, 0x84, 0x95, 0x38, 0xe3, 0x04, 0x91, 0x8a, 0x44, 0xa4, 0x21, 0x85, 0x87, 0xf3, 0x5a, 0xbd,
+	0x74, 0x91, 0x56, 0xff, 0xde, 0xd4, 0x5a, 0x2d, 0x5c, 0x8d, 0x88, 0xef, 0x2f, 0x3a, 0x79, 0x1b,
+	0xca, 0x3a, 0x2e, 0xef, 0x3e, 0x58, 0xea, 0xd6, 0x10, 0xf5, 0x19, 0x0d, 0x47, 0x64, 0x40, 0x55,
+	0xdf, 0xe7, 0xff, 0xec, 0xb0, 0x96, 0xec, 0xc0, 0xf1, 0x06, 0xb4, 0x09, 0x6b, 0x49, 0x0a, 0x79,
+	0x1a, 0xac, 0x2a, 0x71, 0x52, 0xf6, 0xa5, 0x4f, 0x1f, 0xdf, 0x05, 0x34, 0xa2, 0x43, 0x32, 0x98,
+	0xc8, 0x9b, 0x54, 0x3f, 0x9a, 0x44, 0x9c, 0x7a, 0xea, 0x6a, 0x63, 0xc5, 0x1a, 0x71, 0x6d, 0xea,
+	0x49, 0x79, 0xeb, 0x97, 0x65, 0xb8, 0xbe, 0x1b, 0x86, 0xa3, 0xc9, 0x14, 0xeb, 0x3e, 0xfd, 0xe2,
+	0x59, 0x37, 0xd3, 0x8d, 0xd2, 0x45, 0xba, 0x71, 0x61, 0xb2, 0x15, 0x20, 0x5f, 0x29, 0x44, 0xfe,
+	0x6a, 0x84, 0xfb, 0xec, 0xea, 0xb3, 0x45, 0x1b, 0x11, 0x66, 0x7e, 0xec, 0x4d, 0x91, 0xa2, 0x74,
+	0x45, 0x52, 0x94, 0xe7, 0x90, 0xe2, 0xdf, 0x26, 0x5c, 0xef, 0x78, 0x61, 0xc0, 0x78, 0xfe, 0xec,
+	0x74, 0x67, 0x41, 0x4e, 0xac, 0x82, 0xe9, 0x3a, 0xea, 0x27, 0x19, 0xd3, 0x75, 0xec, 0x57, 0x60,
+	0xc5, 0xee, 0x68, 0xfa, 0x09, 0x39, 0xf7, 0xbe, 0xba, 0x10, 0x9d, 0x2a, 0xd1, 0x34, 0x60, 0xf9,
+	0x99, 0x6a, 0xff, 0x51, 0xef, 0xc6, 0x4f, 0x01, 0xb9, 0x2a, 0x8d, 0x7e, 0x72, 0xb5, 0x48, 0x3e,
+	0x83, 0xb7, 0xb5, 0x10, 0x05, 0xa5, 0xb7, 0xa7, 0xf3, 0xc7, 0xeb, 0xee, 0x94, 0x24, 0xba, 0xfc,
+	0xc9, 0xec, 0x77, 0x26, 0xac, 0x8a, 0xef, 0x6b, 0x76, 0x4c, 0x17, 0x3f, 0x16, 0x7e, 0x31, 0xa7,
+	0x9a, 0x59, 0x7a, 0x97, 0x2e, 0x42, 0x6f, 0x96, 0xbb, 0x24, 0x56, 0x16, 0x62, 0xb6, 0xea, 0xd2,
+	0x65, 0xe1, 0x79, 0xfb, 0x2d, 0x80, 0xec, 0x97, 0x12, 0x71, 0xb3, 0x3f, 0x7c, 0xb4, 0xdb, 0xe9,
+	0x5a, 0xd7, 0x50, 0x03, 0xaa, 0x07, 0xbb, 0xf8, 0xe1, 0xde, 0xe3, 0xa3, 0xae, 0x65, 0x6c, 0xff,
+	0xbd, 0x06, 0xd5, 0xe4, 0x80, 0x85, 0x3e, 0x2c, 0xb8, 0xed, 0xa2, 0x37, 0xcf, 0xb9, 0x22, 0xc6,
+	0x17, 0xe1, 0xb7, 0x16, 0xba, 0x48, 0xa2, 0x60, 0xde, 0xe5, 0x07, 0xe9, 0xbf, 0x96, 0x14, 0x9b,
+	0xa4, 0xb1, 0xde, 0x5e, 0xc4, 0x74, 0x36, 0x60, 0xfe, 0x04, 0x5c, 0x18, 0x30, 0x6f, 0x72, 0x66,
+	0xc0, 0x19, 0x53, 0x15, 0xf0, 0x67, 0x67, 0x1d, 0xbb, 0xd1, 0xad, 0x02, 0x4f, 0xb3, 0x66, 0x69,
+	0xe0, 0xf6, 0xa2, 0xe6, 0x2a, 0xb8, 0x5b, 0x7c, 0x7f, 0x43, 0x9b, 0x9a, 0x9f, 0x22, 0x83, 0x34,
+	0xe0, 0xd6, 0xf9, 0x86, 0x2a, 0xd4, 0x87, 0x05, 0x27, 0xf4, 0x1c, 0x57, 0x66, 0xb4, 0x85, 0x5c,
+	0x29, 0xb2, 0x52, 0x11, 0x7e, 0x92, 0x3f, 0x3f, 0xa3, 0xaf, 0x6b, 0xdb, 0x74, 0x45, 0xea, 0x77,
+	0x63, 0xbe, 0x81, 0x72, 0x39, 0x28, 0x3a, 0x2c, 0x22, 0x3d, 0x9f, 0x59, 0x75, 0xea, 0xfe, 0x5b,
+	0xe7, 0x99, 0xa9, 0x20, 0xc7, 0x85, 0x87, 0x03, 0xa4, 0x6f, 0x2f, 0xd0, 0xa7, 0x61, 0x36, 0xcf,
+	0xb5, 0xcb, 0xe2, 0x14, 0x0c, 0xdd, 0x5c, 0x9c, 0x02, 0x7d, 0x61, 0x9c, 0x62, 0x3b, 0x15, 0xe7,
+	0x68, 0x7a, 0xce, 0xa2, 0x6f, 0x4c, 0x01, 0x9d, 0xa9, 0x52, 0xef, 0xad, 0xb3, 0x4c, 0xb2, 0x06,
+	0xeb, 0xff, 0xa1, 0xe4, 0x1a, 0xac, 0x2b, 0x0a, 0x1b, 0x3c, 0x65, 0x10, 0xbb, 0x7c, 0xbe, 0x24,
+	0xff, 0xab, 0x7b, 0xe7, 0xbf, 0x03, 0x00, 0x9a, 0xd3, 0x19, 0xc7, 0xbe, 0x1b, 0x00, 0x00,
+}
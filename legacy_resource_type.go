@@ -34,12 +34,16 @@ func (rt legacyManagedResourceType) getSchema() (schema *tfschema.BlockType, ver
 	return
 }
 
+func (rt legacyManagedResourceType) deprecationMessage() string {
+	return rt.r.DeprecationMessage
+}
+
 func (rt legacyManagedResourceType) validate(obj cty.Value) Diagnostics {
 	// TODO: Implement
 	panic("not implemented")
 }
 
-func (rt legacyManagedResourceType) upgradeState(oldJSON []byte, oldVersion int) (cty.Value, Diagnostics) {
+func (rt legacyManagedResourceType) upgradeState(oldJSON []byte, oldFlatmap map[string]string, oldVersion int64) (cty.Value, Diagnostics) {
 	// TODO: Implement
 	panic("not implemented")
 }
@@ -59,7 +63,7 @@ func (rt legacyManagedResourceType) applyChange(ctx context.Context, client inte
 	panic("not implemented")
 }
 
-func (rt legacyManagedResourceType) importState(ctx context.Context, client interface{}, id string) (cty.Value, Diagnostics) {
+func (rt legacyManagedResourceType) importState(ctx context.Context, client interface{}, id string) ([]ImportedObject, Diagnostics) {
 	// TODO: Implement
 	panic("not implemented")
 }
@@ -72,6 +76,10 @@ func (rt legacyDataResourceType) getSchema() *tfschema.BlockType {
 	return prepareLegacySchema(rt.r.Schema, false)
 }
 
+func (rt legacyDataResourceType) deprecationMessage() string {
+	return rt.r.DeprecationMessage
+}
+
 func (rt legacyDataResourceType) validate(obj cty.Value) Diagnostics {
 	// TODO: Implement
 	panic("not implemented")
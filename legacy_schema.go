@@ -103,6 +103,7 @@ func prepareLegacySchemaAttribute(legacy *tflegacy.Schema, enableAsSingle bool)
 		Computed:    legacy.Computed,
 		Sensitive:   legacy.Sensitive,
 		Description: legacy.Description,
+		Deprecated:  legacy.Deprecated,
 	}
 }
 
@@ -184,6 +185,7 @@ func prepareLegacySchemaNestedBlockType(legacy *tflegacy.Schema, enableAsSingle
 
 	ret.MinItems = legacy.MinItems
 	ret.MaxItems = legacy.MaxItems
+	ret.Deprecated = legacy.Deprecated
 
 	if legacy.AsSingle && enableAsSingle {
 		// In AsSingle mode, we artifically force a TypeList or TypeSet
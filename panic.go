@@ -0,0 +1,31 @@
+package tfsdk
+
+import (
+	"fmt"
+	"log"
+	"runtime/debug"
+)
+
+// panicDiagnostic writes the stack trace of a panic that was just recovered
+// to the plugin log and returns an error diagnostic describing it, so that a
+// bug in one resource type doesn't crash the whole plugin process.
+//
+// This must be called from the deferred function that recovered the panic,
+// so that the stack trace will still include the panicking frames.
+//
+// The operation is the name of the RPC call that was being handled, and
+// typeName is the name of the resource type it was handled for, or an empty
+// string if the operation is not specific to a resource type.
+func panicDiagnostic(r interface{}, operation, typeName string) Diagnostic {
+	context := operation
+	if typeName != "" {
+		context = fmt.Sprintf("%s for %s", operation, typeName)
+	}
+	log.Printf("[ERROR] panic during %s: %v\n%s", context, r, debug.Stack())
+
+	return Diagnostic{
+		Severity: Error,
+		Summary:  "Plugin panicked",
+		Detail:   fmt.Sprintf("The plugin encountered an unexpected error during %s: %v.\n\nThis is a bug in the plugin; please report it in the plugin's issue tracker, including the stack trace from the plugin log.", context, r),
+	}
+}
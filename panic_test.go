@@ -0,0 +1,190 @@
+package tfsdk
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/apparentlymart/terraform-sdk/internal/tfplugin5"
+	"github.com/apparentlymart/terraform-sdk/internal/tfplugin6"
+	"github.com/apparentlymart/terraform-sdk/tfobj"
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+	"google.golang.org/grpc"
+)
+
+func TestTFPlugin5ServerPanic(t *testing.T) {
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"name": {Type: cty.String, Required: true},
+		},
+	}
+	p := &Provider{
+		ConfigSchema: &tfschema.BlockType{},
+		ManagedResourceTypes: map[string]ManagedResourceType{
+			"test_thing": NewManagedResourceType(&ResourceTypeDef{
+				ConfigSchema: schema,
+				PlanFn: func(ctx context.Context, client interface{}, plan tfobj.PlanBuilder) (cty.Value, cty.PathSet, Diagnostics) {
+					panic("oh no")
+				},
+			}),
+		},
+	}
+	server := p.tfplugin5Server()
+
+	config := cty.ObjectVal(map[string]cty.Value{
+		"name": cty.StringVal("a"),
+	})
+	resp, err := server.PlanResourceChange(context.Background(), &tfplugin5.PlanResourceChange_Request{
+		TypeName:         "test_thing",
+		PriorState:       encodeTFPlugin5DynamicValue(schema.Null(), schema),
+		Config:           encodeTFPlugin5DynamicValue(config, schema),
+		ProposedNewState: encodeTFPlugin5DynamicValue(config, schema),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp == nil {
+		t.Fatalf("no response")
+	}
+	if got, want := len(resp.Diagnostics), 1; got != want {
+		t.Fatalf("wrong number of diagnostics %d; want %d", got, want)
+	}
+	diag := resp.Diagnostics[0]
+	if got, want := diag.Severity, tfplugin5.Diagnostic_ERROR; got != want {
+		t.Errorf("wrong severity %s; want %s", got, want)
+	}
+	for _, want := range []string{"PlanResourceChange", "test_thing", "oh no"} {
+		if !strings.Contains(diag.Detail, want) {
+			t.Errorf("detail does not mention %q\n%s", want, diag.Detail)
+		}
+	}
+}
+
+func TestTFPlugin6ServerPanic(t *testing.T) {
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"name": {Type: cty.String, Required: true},
+		},
+	}
+	p := &Provider{
+		ConfigSchema: &tfschema.BlockType{},
+		ManagedResourceTypes: map[string]ManagedResourceType{
+			"test_thing": NewManagedResourceType(&ResourceTypeDef{
+				ConfigSchema: schema,
+				PlanFn: func(ctx context.Context, client interface{}, plan tfobj.PlanBuilder) (cty.Value, cty.PathSet, Diagnostics) {
+					panic("oh no")
+				},
+			}),
+		},
+	}
+	server := p.tfplugin6Server()
+
+	config := cty.ObjectVal(map[string]cty.Value{
+		"name": cty.StringVal("a"),
+	})
+	resp, err := server.PlanResourceChange(context.Background(), &tfplugin6.PlanResourceChange_Request{
+		TypeName:         "test_thing",
+		PriorState:       encodeTFPlugin6DynamicValue(schema.Null(), schema),
+		Config:           encodeTFPlugin6DynamicValue(config, schema),
+		ProposedNewState: encodeTFPlugin6DynamicValue(config, schema),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp == nil {
+		t.Fatalf("no response")
+	}
+	if got, want := len(resp.Diagnostics), 1; got != want {
+		t.Fatalf("wrong number of diagnostics %d; want %d", got, want)
+	}
+	diag := resp.Diagnostics[0]
+	if got, want := diag.Severity, tfplugin6.Diagnostic_ERROR; got != want {
+		t.Errorf("wrong severity %s; want %s", got, want)
+	}
+	for _, want := range []string{"PlanResourceChange", "test_thing", "oh no"} {
+		if !strings.Contains(diag.Detail, want) {
+			t.Errorf("detail does not mention %q\n%s", want, diag.Detail)
+		}
+	}
+}
+
+func TestTFPlugin5ProvisionerServerPanic(t *testing.T) {
+	p := NewProvisioner(&ProvisionerDef{
+		ConfigSchema: &tfschema.BlockType{
+			Attributes: map[string]*tfschema.Attribute{
+				"command": {Type: cty.String, Required: true},
+			},
+		},
+		ValidateFn: func(config tfobj.ObjectReader) Diagnostics {
+			panic("oh no")
+		},
+		ProvisionFn: func(ctx context.Context, config tfobj.ObjectReader, connection cty.Value, output io.Writer) Diagnostics {
+			panic("oh no")
+		},
+	})
+	server := p.tfplugin5Server()
+	config := encodeTFPlugin5DynamicValue(cty.ObjectVal(map[string]cty.Value{
+		"command": cty.StringVal("true"),
+	}), p.getSchema())
+
+	checkDiags := func(t *testing.T, diags []*tfplugin5.Diagnostic, operation string) {
+		t.Helper()
+		if got, want := len(diags), 1; got != want {
+			t.Fatalf("wrong number of diagnostics %d; want %d", got, want)
+		}
+		diag := diags[0]
+		if got, want := diag.Severity, tfplugin5.Diagnostic_ERROR; got != want {
+			t.Errorf("wrong severity %s; want %s", got, want)
+		}
+		for _, want := range []string{operation, "oh no"} {
+			if !strings.Contains(diag.Detail, want) {
+				t.Errorf("detail does not mention %q\n%s", want, diag.Detail)
+			}
+		}
+	}
+
+	t.Run("ValidateProvisionerConfig", func(t *testing.T) {
+		resp, err := server.ValidateProvisionerConfig(context.Background(), &tfplugin5.ValidateProvisionerConfig_Request{
+			Config: config,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if resp == nil {
+			t.Fatalf("no response")
+		}
+		checkDiags(t, resp.Diagnostics, "ValidateProvisionerConfig")
+	})
+	t.Run("ProvisionResource", func(t *testing.T) {
+		srv := &testProvisionResourceServer{ctx: context.Background()}
+		err := server.ProvisionResource(&tfplugin5.ProvisionResource_Request{
+			Config: config,
+		}, srv)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(srv.sent) == 0 {
+			t.Fatalf("no response messages")
+		}
+		checkDiags(t, srv.sent[len(srv.sent)-1].Diagnostics, "ProvisionResource")
+	})
+}
+
+// testProvisionResourceServer is a fake stream for testing ProvisionResource,
+// which records the messages sent to it.
+type testProvisionResourceServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*tfplugin5.ProvisionResource_Response
+}
+
+func (s *testProvisionResourceServer) Send(resp *tfplugin5.ProvisionResource_Response) error {
+	s.sent = append(s.sent, resp)
+	return nil
+}
+
+func (s *testProvisionResourceServer) Context() context.Context {
+	return s.ctx
+}
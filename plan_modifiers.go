@@ -0,0 +1,79 @@
+package tfsdk
+
+import (
+	"fmt"
+
+	"github.com/apparentlymart/terraform-sdk/internal/dynfunc"
+	"github.com/apparentlymart/terraform-sdk/tfobj"
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+)
+
+// applyPlanModifiers calls the PlanModifiers functions of each attribute in
+// the object represented by the given PlanBuilder, and in the objects of any
+// nested blocks within it, allowing them to adjust the plan.
+//
+// Attributes in nested blocks of tfschema.NestingSet mode are skipped, because
+// their elements cannot be correlated with the prior and config objects.
+func applyPlanModifiers(plan tfobj.PlanBuilder) Diagnostics {
+	var diags Diagnostics
+	if plan == nil || plan.Action() == tfobj.Delete {
+		return diags
+	}
+	schema := plan.Schema()
+
+	for name, attrS := range schema.Attributes {
+		if len(attrS.PlanModifiers) == 0 {
+			continue
+		}
+		attrPlan := plan.AttrPlanBuilder(name)
+		for i, modifier := range attrS.PlanModifiers {
+			fn, err := dynfunc.WrapSimpleFunction(modifier, attrPlan)
+			if err != nil {
+				diags = diags.Append(Diagnostic{
+					Severity: Error,
+					Summary:  "Invalid provider schema",
+					Detail:   fmt.Sprintf("Invalid PlanModifiers[%d]: %s.\nThis is a bug in the provider that should be reported in its own issue tracker.", i, err),
+					Path:     attrPlan.Path(),
+				})
+				continue
+			}
+			diags = diags.Append(fn().UnderPath(attrPlan.Path()))
+		}
+	}
+
+	for name, blockS := range schema.NestedBlockTypes {
+		if !blockHasPlanModifiers(&blockS.Content) {
+			continue
+		}
+		switch blockS.Nesting {
+		case tfschema.NestingSingle, tfschema.NestingGroup:
+			diags = diags.Append(applyPlanModifiers(plan.BlockPlanBuilderSingle(name)))
+		case tfschema.NestingList:
+			for _, blockPlan := range plan.BlockPlanBuilderList(name) {
+				diags = diags.Append(applyPlanModifiers(blockPlan))
+			}
+		case tfschema.NestingMap:
+			for _, blockPlan := range plan.BlockPlanBuilderMap(name) {
+				diags = diags.Append(applyPlanModifiers(blockPlan))
+			}
+		}
+	}
+
+	return diags
+}
+
+// blockHasPlanModifiers returns true if any attribute in the given block, or
+// in any block nested within it, has at least one plan modifier.
+func blockHasPlanModifiers(schema *tfschema.BlockType) bool {
+	for _, attrS := range schema.Attributes {
+		if len(attrS.PlanModifiers) != 0 {
+			return true
+		}
+	}
+	for _, blockS := range schema.NestedBlockTypes {
+		if blockHasPlanModifiers(&blockS.Content) {
+			return true
+		}
+	}
+	return false
+}
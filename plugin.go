@@ -3,29 +3,69 @@ package tfsdk
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"log"
 	"net"
 	"os"
+	"strings"
 
 	"github.com/apparentlymart/terraform-sdk/internal/tfplugin5"
+	"github.com/zclconf/go-cty/cty"
 	"go.rpcplugin.org/rpcplugin"
 	"go.rpcplugin.org/rpcplugin/plugintrace"
 	"google.golang.org/grpc"
-	grpcCodes "google.golang.org/grpc/codes"
 )
 
 // ServeProviderPlugin starts a plugin server for the given provider, which will
 // first deal with the plugin protocol handshake and then, once initialized,
 // serve RPC requests from the client (usually Terraform CLI).
 //
+// The server offers both plugin protocol versions 5 and 6, and the client
+// selects the newest version it supports. Schemas using nested attribute types
+// are fully supported only by clients that select protocol version 6.
+//
 // This should be called in the main function for the plugin program.
 // ServeProviderPlugin returns only once the plugin has been requested to exit
 // by its client.
+//
+// To run a provider under a debugger, use ServeProviderPluginDebug instead.
+//
+// ServeProviderPlugin first checks the provider's schemas using
+// Provider.InternalValidate. If there are any problems then it prints them to
+// stderr, where Terraform will show them to the user, and exits without
+// serving any requests.
 func ServeProviderPlugin(p *Provider) {
+	if err := checkProviderSchemas(p); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+	servePlugin("provider", map[int]rpcplugin.Server{
+		5: protocolVersion5{p},
+		6: protocolVersion6{p},
+	})
+}
+
+// ServeProvisionerPlugin starts a plugin server for the given provisioner,
+// which will first deal with the plugin protocol handshake and then, once
+// initialized, serve RPC requests from the client (usually Terraform CLI).
+//
+// This should be called in the main function for the plugin program.
+// ServeProvisionerPlugin returns only once the plugin has been requested to
+// exit by its client.
+func ServeProvisionerPlugin(p *Provisioner) {
+	servePlugin("provisioner", map[int]rpcplugin.Server{
+		5: provisionerProtocolVersion5{p},
+	})
+}
+
+// servePlugin is the common implementation of ServeProviderPlugin and
+// ServeProvisionerPlugin. Terraform uses the same handshake for both kinds
+// of plugin, distinguishing them only by the services they implement.
+func servePlugin(kind string, versions map[int]rpcplugin.Server) {
 	ctx := plugintrace.WithServerTracer(context.Background(), &plugintrace.ServerTracer{
 		Listening: func(addr net.Addr, tlsConfig *tls.Config, protoVersion int) {
-			log.Printf("[INFO] provider plugin server (protocol %d) listening on %s", protoVersion, addr)
+			log.Printf("[INFO] %s plugin server (protocol %d) listening on %s", kind, protoVersion, addr)
 		},
 	})
 
@@ -34,9 +74,7 @@ func ServeProviderPlugin(p *Provider) {
 			CookieKey:   "TF_PLUGIN_MAGIC_COOKIE",
 			CookieValue: "d602bf8f470bc67ca7faa0386276bbdd4330efaf76d1a219cb4d6991ca9872b2",
 		},
-		ProtoVersions: map[int]rpcplugin.Server{
-			5: protocolVersion5{p},
-		},
+		ProtoVersions: versions,
 	})
 
 	if err != nil {
@@ -45,27 +83,39 @@ func ServeProviderPlugin(p *Provider) {
 	}
 }
 
-func (p *Provider) tfplugin5Server() tfplugin5.ProviderServer {
-	// This single shared context will be passed (directly or indirectly) to
-	// each provider method that can make network requests and cancelled if
-	// the Terraform operation recieves an interrupt request.
-	ctx, cancel := context.WithCancel(context.Background())
-
-	return &tfplugin5Server{
-		p:    p,
-		ctx:  ctx,
-		stop: cancel,
+// checkProviderSchemas returns an error describing all of the problems that
+// Provider.InternalValidate finds in the given provider's schemas, or nil if
+// there are none.
+func checkProviderSchemas(p *Provider) error {
+	diags := p.InternalValidate()
+	if !diags.HasErrors() {
+		return nil
+	}
+	var buf strings.Builder
+	buf.WriteString("provider has invalid schemas:")
+	for _, diag := range diags {
+		// The first line of the detail describes the problem, while the
+		// rest is about reporting it, which we'll say only once.
+		problem := strings.SplitN(diag.Detail, "\n", 2)[0]
+		fmt.Fprintf(&buf, "\n  - %s", problem)
 	}
+	buf.WriteString("\n\nThis is a bug in the provider that should be reported in its own issue tracker.")
+	return errors.New(buf.String())
 }
 
+func (p *Provider) tfplugin5Server() tfplugin5.ProviderServer {
+	return &tfplugin5Server{p.newProviderServer()}
+}
+
+// tfplugin5Server is the implementation of the provider service for plugin
+// protocol version 5.
 type tfplugin5Server struct {
-	p    *Provider
-	ctx  context.Context
-	stop func()
+	providerServer
 }
 
-func (s *tfplugin5Server) GetSchema(context.Context, *tfplugin5.GetProviderSchema_Request) (*tfplugin5.GetProviderSchema_Response, error) {
-	resp := &tfplugin5.GetProviderSchema_Response{}
+func (s *tfplugin5Server) GetSchema(context.Context, *tfplugin5.GetProviderSchema_Request) (resp *tfplugin5.GetProviderSchema_Response, err error) {
+	resp = &tfplugin5.GetProviderSchema_Response{}
+	defer recoverTFPlugin5Panic("GetSchema", "", &resp.Diagnostics)
 
 	resp.Provider = &tfplugin5.Schema{
 		Block: convertSchemaBlockToTFPlugin5(s.p.ConfigSchema),
@@ -74,71 +124,30 @@ func (s *tfplugin5Server) GetSchema(context.Context, *tfplugin5.GetProviderSchem
 	resp.ResourceSchemas = make(map[string]*tfplugin5.Schema)
 	for name, rt := range s.p.ManagedResourceTypes {
 		schema, version := rt.getSchema()
+		block := convertSchemaBlockToTFPlugin5(schema)
+		block.Deprecated = rt.deprecationMessage() != ""
 		resp.ResourceSchemas[name] = &tfplugin5.Schema{
 			Version: version,
-			Block:   convertSchemaBlockToTFPlugin5(schema),
+			Block:   block,
 		}
 	}
 
 	resp.DataSourceSchemas = make(map[string]*tfplugin5.Schema)
 	for name, rt := range s.p.DataResourceTypes {
 		schema := rt.getSchema()
+		block := convertSchemaBlockToTFPlugin5(schema)
+		block.Deprecated = rt.deprecationMessage() != ""
 		resp.DataSourceSchemas[name] = &tfplugin5.Schema{
-			Block: convertSchemaBlockToTFPlugin5(schema),
+			Block: block,
 		}
 	}
 
 	return resp, nil
 }
 
-// requireManagedResourceType is a helper to conveniently retrieve a particular
-// managed resource type or produce an error message if it is invalid.
-//
-// The usage pattern for this method is:
-//
-//    var rt ManagedResourceType
-//    	if rt = s.requireManagedResourceType(req.TypeName, &resp.Diagnostics); rt == nil {
-//    	return resp, nil
-//    }
-func (s *tfplugin5Server) requireManagedResourceType(typeName string, diagsPtr *[]*tfplugin5.Diagnostic) ManagedResourceType {
-	rt := s.p.managedResourceType(typeName)
-	if rt == nil {
-		var diags Diagnostics
-		diags = diags.Append(Diagnostic{
-			Severity: Error,
-			Summary:  "Unsupported resource type",
-			Detail:   fmt.Sprintf("This provider does not support managed resource type %q", typeName),
-		})
-		*diagsPtr = encodeDiagnosticsToTFPlugin5(diags)
-	}
-	return rt
-}
-
-// requireDataResourceType is a helper to conveniently retrieve a particular
-// data resource type or produce an error message if it is invalid.
-//
-// The usage pattern for this method is:
-//
-//    var rt DataResourceType
-//    	if rt = s.requireDataResourceType(req.TypeName, &resp.Diagnostics); rt == nil {
-//    	return resp, nil
-//    }
-func (s *tfplugin5Server) requireDataResourceType(typeName string, diagsPtr *[]*tfplugin5.Diagnostic) DataResourceType {
-	rt := s.p.dataResourceType(typeName)
-	if rt == nil {
-		var diags Diagnostics
-		diags = diags.Append(Diagnostic{
-			Severity: Error,
-			Summary:  "Unsupported resource type",
-			Detail:   fmt.Sprintf("This provider does not support data resource type %q", typeName),
-		})
-		*diagsPtr = encodeDiagnosticsToTFPlugin5(diags)
-	}
-	return rt
-}
-
-func (s *tfplugin5Server) PrepareProviderConfig(ctx context.Context, req *tfplugin5.PrepareProviderConfig_Request) (*tfplugin5.PrepareProviderConfig_Response, error) {
-	resp := &tfplugin5.PrepareProviderConfig_Response{}
+func (s *tfplugin5Server) PrepareProviderConfig(ctx context.Context, req *tfplugin5.PrepareProviderConfig_Request) (resp *tfplugin5.PrepareProviderConfig_Response, err error) {
+	resp = &tfplugin5.PrepareProviderConfig_Response{}
+	defer recoverTFPlugin5Panic("PrepareProviderConfig", "", &resp.Diagnostics)
 
 	proposedVal, diags := decodeTFPlugin5DynamicValue(req.Config, s.p.ConfigSchema)
 	if diags.HasErrors() {
@@ -152,15 +161,17 @@ func (s *tfplugin5Server) PrepareProviderConfig(ctx context.Context, req *tfplug
 	return resp, nil
 }
 
-func (s *tfplugin5Server) ValidateResourceTypeConfig(ctx context.Context, req *tfplugin5.ValidateResourceTypeConfig_Request) (*tfplugin5.ValidateResourceTypeConfig_Response, error) {
-	resp := &tfplugin5.ValidateResourceTypeConfig_Response{}
+func (s *tfplugin5Server) ValidateResourceTypeConfig(ctx context.Context, req *tfplugin5.ValidateResourceTypeConfig_Request) (resp *tfplugin5.ValidateResourceTypeConfig_Response, err error) {
+	resp = &tfplugin5.ValidateResourceTypeConfig_Response{}
+	defer recoverTFPlugin5Panic("ValidateResourceTypeConfig", req.TypeName, &resp.Diagnostics)
 
-	var rt ManagedResourceType
-	if rt = s.requireManagedResourceType(req.TypeName, &resp.Diagnostics); rt == nil {
+	rt, diags := s.requireManagedResourceType(req.TypeName)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin5(diags)
 		return resp, nil
 	}
-
 	schema, _ := rt.getSchema()
+
 	configVal, diags := decodeTFPlugin5DynamicValue(req.Config, schema)
 	if diags.HasErrors() {
 		resp.Diagnostics = encodeDiagnosticsToTFPlugin5(diags)
@@ -172,15 +183,17 @@ func (s *tfplugin5Server) ValidateResourceTypeConfig(ctx context.Context, req *t
 	return resp, nil
 }
 
-func (s *tfplugin5Server) ValidateDataSourceConfig(ctx context.Context, req *tfplugin5.ValidateDataSourceConfig_Request) (*tfplugin5.ValidateDataSourceConfig_Response, error) {
-	resp := &tfplugin5.ValidateDataSourceConfig_Response{}
+func (s *tfplugin5Server) ValidateDataSourceConfig(ctx context.Context, req *tfplugin5.ValidateDataSourceConfig_Request) (resp *tfplugin5.ValidateDataSourceConfig_Response, err error) {
+	resp = &tfplugin5.ValidateDataSourceConfig_Response{}
+	defer recoverTFPlugin5Panic("ValidateDataSourceConfig", req.TypeName, &resp.Diagnostics)
 
-	var rt DataResourceType
-	if rt = s.requireDataResourceType(req.TypeName, &resp.Diagnostics); rt == nil {
+	rt, diags := s.requireDataResourceType(req.TypeName)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin5(diags)
 		return resp, nil
 	}
-
 	schema := rt.getSchema()
+
 	configVal, diags := decodeTFPlugin5DynamicValue(req.Config, schema)
 	if diags.HasErrors() {
 		resp.Diagnostics = encodeDiagnosticsToTFPlugin5(diags)
@@ -192,33 +205,32 @@ func (s *tfplugin5Server) ValidateDataSourceConfig(ctx context.Context, req *tfp
 	return resp, nil
 }
 
-func (s *tfplugin5Server) UpgradeResourceState(ctx context.Context, req *tfplugin5.UpgradeResourceState_Request) (*tfplugin5.UpgradeResourceState_Response, error) {
-	// TODO: Do some fixups we can do automatically, like transforming primitive
-	// types, and then give the provider code an opportunity to do its own
-	// fixups as needed.
-	// Might also need to deal with converting flatmap to JSON here, but maybe
-	// flatmap states will be rare enough that it's okay to just fail those?
-
-	resp := &tfplugin5.UpgradeResourceState_Response{}
+func (s *tfplugin5Server) UpgradeResourceState(ctx context.Context, req *tfplugin5.UpgradeResourceState_Request) (resp *tfplugin5.UpgradeResourceState_Response, err error) {
+	resp = &tfplugin5.UpgradeResourceState_Response{}
+	defer recoverTFPlugin5Panic("UpgradeResourceState", req.TypeName, &resp.Diagnostics)
 
-	var rt ManagedResourceType
-	if rt = s.requireManagedResourceType(req.TypeName, &resp.Diagnostics); rt == nil {
+	rt, diags := s.requireManagedResourceType(req.TypeName)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin5(diags)
 		return resp, nil
 	}
-
 	schema, _ := rt.getSchema()
-	stateVal, diags := decodeTFPlugin5RawState(req.RawState, schema)
+
+	stateJSON, stateFlatmap := decodeTFPlugin5RawState(req.RawState)
+	stateVal, diags := s.p.upgradeResourceState(rt, stateJSON, stateFlatmap, req.Version)
 	if diags.HasErrors() {
 		resp.Diagnostics = encodeDiagnosticsToTFPlugin5(diags)
 		return resp, nil
 	}
 
 	resp.UpgradedState = encodeTFPlugin5DynamicValue(stateVal, schema)
+	resp.Diagnostics = encodeDiagnosticsToTFPlugin5(diags)
 	return resp, nil
 }
 
-func (s *tfplugin5Server) Configure(ctx context.Context, req *tfplugin5.Configure_Request) (*tfplugin5.Configure_Response, error) {
-	resp := &tfplugin5.Configure_Response{}
+func (s *tfplugin5Server) Configure(ctx context.Context, req *tfplugin5.Configure_Request) (resp *tfplugin5.Configure_Response, err error) {
+	resp = &tfplugin5.Configure_Response{}
+	defer recoverTFPlugin5Panic("Configure", "", &resp.Diagnostics)
 
 	configVal, diags := decodeTFPlugin5DynamicValue(req.Config, s.p.ConfigSchema)
 	if diags.HasErrors() {
@@ -226,17 +238,18 @@ func (s *tfplugin5Server) Configure(ctx context.Context, req *tfplugin5.Configur
 		return resp, nil
 	}
 
-	stoppableCtx := s.stoppableContext(ctx)
-	diags = s.p.configure(stoppableCtx, configVal)
+	diags = s.configure(ctx, configVal)
 	resp.Diagnostics = encodeDiagnosticsToTFPlugin5(diags)
 	return resp, nil
 }
 
-func (s *tfplugin5Server) ReadResource(ctx context.Context, req *tfplugin5.ReadResource_Request) (*tfplugin5.ReadResource_Response, error) {
-	resp := &tfplugin5.ReadResource_Response{}
+func (s *tfplugin5Server) ReadResource(ctx context.Context, req *tfplugin5.ReadResource_Request) (resp *tfplugin5.ReadResource_Response, err error) {
+	resp = &tfplugin5.ReadResource_Response{}
+	defer recoverTFPlugin5Panic("ReadResource", req.TypeName, &resp.Diagnostics)
 
-	var rt ManagedResourceType
-	if rt = s.requireManagedResourceType(req.TypeName, &resp.Diagnostics); rt == nil {
+	rt, diags := s.requireManagedResourceType(req.TypeName)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin5(diags)
 		return resp, nil
 	}
 	schema, _ := rt.getSchema()
@@ -247,29 +260,20 @@ func (s *tfplugin5Server) ReadResource(ctx context.Context, req *tfplugin5.ReadR
 		return resp, nil
 	}
 
-	stoppableCtx := s.stoppableContext(ctx)
-	newVal, diags := s.p.readResource(stoppableCtx, rt, currentVal)
-
-	// Safety check
-	wantTy := schema.ImpliedCtyType()
-	for _, err := range newVal.Type().TestConformance(wantTy) {
-		diags = diags.Append(Diagnostic{
-			Severity: Error,
-			Summary:  "Invalid result from provider",
-			Detail:   fmt.Sprintf("Provider produced an invalid new object for %s: %s", req.TypeName, FormatError(err)),
-		})
-	}
-
+	newVal, private, diags := s.readResource(ctx, req.TypeName, rt, currentVal, req.Private)
 	resp.NewState = encodeTFPlugin5DynamicValue(newVal, schema)
+	resp.Private = private
 	resp.Diagnostics = encodeDiagnosticsToTFPlugin5(diags)
 	return resp, nil
 }
 
-func (s *tfplugin5Server) PlanResourceChange(ctx context.Context, req *tfplugin5.PlanResourceChange_Request) (*tfplugin5.PlanResourceChange_Response, error) {
-	resp := &tfplugin5.PlanResourceChange_Response{}
+func (s *tfplugin5Server) PlanResourceChange(ctx context.Context, req *tfplugin5.PlanResourceChange_Request) (resp *tfplugin5.PlanResourceChange_Response, err error) {
+	resp = &tfplugin5.PlanResourceChange_Response{}
+	defer recoverTFPlugin5Panic("PlanResourceChange", req.TypeName, &resp.Diagnostics)
 
-	var rt ManagedResourceType
-	if rt = s.requireManagedResourceType(req.TypeName, &resp.Diagnostics); rt == nil {
+	rt, diags := s.requireManagedResourceType(req.TypeName)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin5(diags)
 		return resp, nil
 	}
 	schema, _ := rt.getSchema()
@@ -290,30 +294,21 @@ func (s *tfplugin5Server) PlanResourceChange(ctx context.Context, req *tfplugin5
 		return resp, nil
 	}
 
-	stoppableCtx := s.stoppableContext(ctx)
-	plannedVal, requiresReplace, diags := s.p.planResourceChange(stoppableCtx, rt, priorVal, configVal, proposedVal)
-
-	// Safety check
-	wantTy := schema.ImpliedCtyType()
-	for _, err := range plannedVal.Type().TestConformance(wantTy) {
-		diags = diags.Append(Diagnostic{
-			Severity: Error,
-			Summary:  "Invalid result from provider",
-			Detail:   fmt.Sprintf("Provider produced an invalid planned new object for %s: %s", req.TypeName, FormatError(err)),
-		})
-	}
-
+	plannedVal, requiresReplace, private, diags := s.planResourceChange(ctx, req.TypeName, rt, priorVal, configVal, proposedVal, req.PriorPrivate)
 	resp.PlannedState = encodeTFPlugin5DynamicValue(plannedVal, schema)
 	resp.RequiresReplace = encodeAttrPathSetToTFPlugin5(requiresReplace)
+	resp.PlannedPrivate = private
 	resp.Diagnostics = encodeDiagnosticsToTFPlugin5(diags)
 	return resp, nil
 }
 
-func (s *tfplugin5Server) ApplyResourceChange(ctx context.Context, req *tfplugin5.ApplyResourceChange_Request) (*tfplugin5.ApplyResourceChange_Response, error) {
-	resp := &tfplugin5.ApplyResourceChange_Response{}
+func (s *tfplugin5Server) ApplyResourceChange(ctx context.Context, req *tfplugin5.ApplyResourceChange_Request) (resp *tfplugin5.ApplyResourceChange_Response, err error) {
+	resp = &tfplugin5.ApplyResourceChange_Response{}
+	defer recoverTFPlugin5Panic("ApplyResourceChange", req.TypeName, &resp.Diagnostics)
 
-	var rt ManagedResourceType
-	if rt = s.requireManagedResourceType(req.TypeName, &resp.Diagnostics); rt == nil {
+	rt, diags := s.requireManagedResourceType(req.TypeName)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin5(diags)
 		return resp, nil
 	}
 	schema, _ := rt.getSchema()
@@ -329,56 +324,63 @@ func (s *tfplugin5Server) ApplyResourceChange(ctx context.Context, req *tfplugin
 		return resp, nil
 	}
 
-	stoppableCtx := s.stoppableContext(ctx)
-	newVal, diags := s.p.applyResourceChange(stoppableCtx, rt, priorVal, plannedVal)
-
-	// Safety check
-	wantTy := schema.ImpliedCtyType()
-	for _, err := range newVal.Type().TestConformance(wantTy) {
-		diags = diags.Append(Diagnostic{
-			Severity: Error,
-			Summary:  "Invalid result from provider",
-			Detail:   fmt.Sprintf("Provider produced an invalid new object for %s: %s", req.TypeName, FormatError(err)),
-		})
-	}
-
+	newVal, private, diags := s.applyResourceChange(ctx, req.TypeName, rt, priorVal, plannedVal, req.PlannedPrivate)
 	resp.NewState = encodeTFPlugin5DynamicValue(newVal, schema)
+	resp.Private = private
 	resp.Diagnostics = encodeDiagnosticsToTFPlugin5(diags)
 	return resp, nil
 }
 
-func (s *tfplugin5Server) ImportResourceState(context.Context, *tfplugin5.ImportResourceState_Request) (*tfplugin5.ImportResourceState_Response, error) {
-	return nil, grpc.Errorf(grpcCodes.Unimplemented, "not implemented")
-}
-
-func (s *tfplugin5Server) ReadDataSource(ctx context.Context, req *tfplugin5.ReadDataSource_Request) (*tfplugin5.ReadDataSource_Response, error) {
-	resp := &tfplugin5.ReadDataSource_Response{}
+func (s *tfplugin5Server) ImportResourceState(ctx context.Context, req *tfplugin5.ImportResourceState_Request) (resp *tfplugin5.ImportResourceState_Response, err error) {
+	resp = &tfplugin5.ImportResourceState_Response{}
+	defer recoverTFPlugin5Panic("ImportResourceState", req.TypeName, &resp.Diagnostics)
 
-	var rt DataResourceType
-	if rt = s.requireDataResourceType(req.TypeName, &resp.Diagnostics); rt == nil {
+	rt, diags := s.requireManagedResourceType(req.TypeName)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin5(diags)
 		return resp, nil
 	}
-	schema := rt.getSchema()
 
-	currentVal, diags := decodeTFPlugin5DynamicValue(req.Config, schema)
+	objs, diags := s.importResourceState(ctx, req.TypeName, rt, req.Id)
 	if diags.HasErrors() {
 		resp.Diagnostics = encodeDiagnosticsToTFPlugin5(diags)
 		return resp, nil
 	}
 
-	stoppableCtx := s.stoppableContext(ctx)
-	newVal, diags := s.p.readDataSource(stoppableCtx, rt, currentVal)
-
-	// Safety check
-	wantTy := schema.ImpliedCtyType()
-	for _, err := range newVal.Type().TestConformance(wantTy) {
-		diags = diags.Append(Diagnostic{
-			Severity: Error,
-			Summary:  "Invalid result from provider",
-			Detail:   fmt.Sprintf("Provider produced an invalid new object for %s: %s", req.TypeName, FormatError(err)),
+	// Terraform Core will call ReadResource for each of the objects we
+	// return here before saving them, so the resource type's ReadFn gets an
+	// opportunity to fill in the rest of the object.
+	for _, obj := range objs {
+		objRT := s.p.managedResourceType(obj.TypeName)
+		schema, _ := objRT.getSchema()
+		resp.ImportedResources = append(resp.ImportedResources, &tfplugin5.ImportResourceState_ImportedResource{
+			TypeName: obj.TypeName,
+			State:    encodeTFPlugin5DynamicValue(obj.Object.(cty.Value), schema),
+			Private:  obj.Private.encode(),
 		})
 	}
+	resp.Diagnostics = encodeDiagnosticsToTFPlugin5(diags)
+	return resp, nil
+}
+
+func (s *tfplugin5Server) ReadDataSource(ctx context.Context, req *tfplugin5.ReadDataSource_Request) (resp *tfplugin5.ReadDataSource_Response, err error) {
+	resp = &tfplugin5.ReadDataSource_Response{}
+	defer recoverTFPlugin5Panic("ReadDataSource", req.TypeName, &resp.Diagnostics)
+
+	rt, diags := s.requireDataResourceType(req.TypeName)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin5(diags)
+		return resp, nil
+	}
+	schema := rt.getSchema()
 
+	configVal, diags := decodeTFPlugin5DynamicValue(req.Config, schema)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin5(diags)
+		return resp, nil
+	}
+
+	newVal, diags := s.readDataSource(ctx, req.TypeName, rt, configVal)
 	resp.State = encodeTFPlugin5DynamicValue(newVal, schema)
 	resp.Diagnostics = encodeDiagnosticsToTFPlugin5(diags)
 	return resp, nil
@@ -392,19 +394,21 @@ func (s *tfplugin5Server) Stop(context.Context, *tfplugin5.Stop_Request) (*tfplu
 	return &tfplugin5.Stop_Response{}, nil
 }
 
-// stoppableContext returns a new context that will get cancelled if either the
-// given context is cancelled or if the provider is asked to stop.
+// recoverTFPlugin5Panic recovers from a panic in an RPC handler, if any, and
+// appends an error diagnostic describing it to the response diagnostics at
+// the given pointer. It must be called directly using defer, as the first
+// action after the response object is constructed:
 //
-// This function starts a goroutine that exits only when the given context is
-// cancelled, so it's important that the given context be cancelled shortly
-// after the request it represents is completed.
-func (s *tfplugin5Server) stoppableContext(ctx context.Context) context.Context {
-	stoppable, cancel := context.WithCancel(s.ctx)
-	go func() {
-		<-ctx.Done()
-		cancel()
-	}()
-	return stoppable
+//	resp = &tfplugin5.PlanResourceChange_Response{}
+//	defer recoverTFPlugin5Panic("PlanResourceChange", req.TypeName, &resp.Diagnostics)
+//
+// The RPC handler must use named return values so that the response object
+// is still returned after a panic is recovered.
+func recoverTFPlugin5Panic(operation, typeName string, diagsPtr *[]*tfplugin5.Diagnostic) {
+	if r := recover(); r != nil {
+		diags := Diagnostics{panicDiagnostic(r, operation, typeName)}
+		*diagsPtr = append(*diagsPtr, encodeDiagnosticsToTFPlugin5(diags)...)
+	}
 }
 
 // protocolVersion5 is an implementation of rpcplugin.Server that implements
@@ -0,0 +1,332 @@
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/apparentlymart/terraform-sdk/internal/tfplugin6"
+	"github.com/zclconf/go-cty/cty"
+	"go.rpcplugin.org/rpcplugin"
+	"google.golang.org/grpc"
+)
+
+func (p *Provider) tfplugin6Server() tfplugin6.ProviderServer {
+	return &tfplugin6Server{p.newProviderServer()}
+}
+
+// tfplugin6Server is the implementation of the provider service for plugin
+// protocol version 6.
+type tfplugin6Server struct {
+	providerServer
+}
+
+func (s *tfplugin6Server) GetProviderSchema(context.Context, *tfplugin6.GetProviderSchema_Request) (resp *tfplugin6.GetProviderSchema_Response, err error) {
+	resp = &tfplugin6.GetProviderSchema_Response{}
+	defer recoverTFPlugin6Panic("GetProviderSchema", "", &resp.Diagnostics)
+
+	resp.Provider = &tfplugin6.Schema{
+		Block: convertSchemaBlockToTFPlugin6(s.p.ConfigSchema),
+	}
+
+	resp.ResourceSchemas = make(map[string]*tfplugin6.Schema)
+	for name, rt := range s.p.ManagedResourceTypes {
+		schema, version := rt.getSchema()
+		block := convertSchemaBlockToTFPlugin6(schema)
+		block.Deprecated = rt.deprecationMessage() != ""
+		resp.ResourceSchemas[name] = &tfplugin6.Schema{
+			Version: version,
+			Block:   block,
+		}
+	}
+
+	resp.DataSourceSchemas = make(map[string]*tfplugin6.Schema)
+	for name, rt := range s.p.DataResourceTypes {
+		schema := rt.getSchema()
+		block := convertSchemaBlockToTFPlugin6(schema)
+		block.Deprecated = rt.deprecationMessage() != ""
+		resp.DataSourceSchemas[name] = &tfplugin6.Schema{
+			Block: block,
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *tfplugin6Server) ValidateProviderConfig(ctx context.Context, req *tfplugin6.ValidateProviderConfig_Request) (resp *tfplugin6.ValidateProviderConfig_Response, err error) {
+	resp = &tfplugin6.ValidateProviderConfig_Response{}
+	defer recoverTFPlugin6Panic("ValidateProviderConfig", "", &resp.Diagnostics)
+
+	proposedVal, diags := decodeTFPlugin6DynamicValue(req.Config, s.p.ConfigSchema)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+		return resp, nil
+	}
+
+	// Protocol version 6 has no equivalent of the "prepared config" from
+	// protocol version 5, so we use only the diagnostics here.
+	_, diags = s.p.prepareConfig(proposedVal)
+	resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+	return resp, nil
+}
+
+func (s *tfplugin6Server) ValidateResourceConfig(ctx context.Context, req *tfplugin6.ValidateResourceConfig_Request) (resp *tfplugin6.ValidateResourceConfig_Response, err error) {
+	resp = &tfplugin6.ValidateResourceConfig_Response{}
+	defer recoverTFPlugin6Panic("ValidateResourceConfig", req.TypeName, &resp.Diagnostics)
+
+	rt, diags := s.requireManagedResourceType(req.TypeName)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+		return resp, nil
+	}
+	schema, _ := rt.getSchema()
+
+	configVal, diags := decodeTFPlugin6DynamicValue(req.Config, schema)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+		return resp, nil
+	}
+
+	diags = rt.validate(configVal)
+	resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+	return resp, nil
+}
+
+func (s *tfplugin6Server) ValidateDataResourceConfig(ctx context.Context, req *tfplugin6.ValidateDataResourceConfig_Request) (resp *tfplugin6.ValidateDataResourceConfig_Response, err error) {
+	resp = &tfplugin6.ValidateDataResourceConfig_Response{}
+	defer recoverTFPlugin6Panic("ValidateDataResourceConfig", req.TypeName, &resp.Diagnostics)
+
+	rt, diags := s.requireDataResourceType(req.TypeName)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+		return resp, nil
+	}
+	schema := rt.getSchema()
+
+	configVal, diags := decodeTFPlugin6DynamicValue(req.Config, schema)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+		return resp, nil
+	}
+
+	diags = rt.validate(configVal)
+	resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+	return resp, nil
+}
+
+func (s *tfplugin6Server) UpgradeResourceState(ctx context.Context, req *tfplugin6.UpgradeResourceState_Request) (resp *tfplugin6.UpgradeResourceState_Response, err error) {
+	resp = &tfplugin6.UpgradeResourceState_Response{}
+	defer recoverTFPlugin6Panic("UpgradeResourceState", req.TypeName, &resp.Diagnostics)
+
+	rt, diags := s.requireManagedResourceType(req.TypeName)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+		return resp, nil
+	}
+	schema, _ := rt.getSchema()
+
+	stateJSON, stateFlatmap := decodeTFPlugin6RawState(req.RawState)
+	stateVal, diags := s.p.upgradeResourceState(rt, stateJSON, stateFlatmap, req.Version)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+		return resp, nil
+	}
+
+	resp.UpgradedState = encodeTFPlugin6DynamicValue(stateVal, schema)
+	resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+	return resp, nil
+}
+
+func (s *tfplugin6Server) ConfigureProvider(ctx context.Context, req *tfplugin6.ConfigureProvider_Request) (resp *tfplugin6.ConfigureProvider_Response, err error) {
+	resp = &tfplugin6.ConfigureProvider_Response{}
+	defer recoverTFPlugin6Panic("ConfigureProvider", "", &resp.Diagnostics)
+
+	configVal, diags := decodeTFPlugin6DynamicValue(req.Config, s.p.ConfigSchema)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+		return resp, nil
+	}
+
+	diags = s.configure(ctx, configVal)
+	resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+	return resp, nil
+}
+
+func (s *tfplugin6Server) ReadResource(ctx context.Context, req *tfplugin6.ReadResource_Request) (resp *tfplugin6.ReadResource_Response, err error) {
+	resp = &tfplugin6.ReadResource_Response{}
+	defer recoverTFPlugin6Panic("ReadResource", req.TypeName, &resp.Diagnostics)
+
+	rt, diags := s.requireManagedResourceType(req.TypeName)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+		return resp, nil
+	}
+	schema, _ := rt.getSchema()
+
+	currentVal, diags := decodeTFPlugin6DynamicValue(req.CurrentState, schema)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+		return resp, nil
+	}
+
+	newVal, private, diags := s.readResource(ctx, req.TypeName, rt, currentVal, req.Private)
+	resp.NewState = encodeTFPlugin6DynamicValue(newVal, schema)
+	resp.Private = private
+	resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+	return resp, nil
+}
+
+func (s *tfplugin6Server) PlanResourceChange(ctx context.Context, req *tfplugin6.PlanResourceChange_Request) (resp *tfplugin6.PlanResourceChange_Response, err error) {
+	resp = &tfplugin6.PlanResourceChange_Response{}
+	defer recoverTFPlugin6Panic("PlanResourceChange", req.TypeName, &resp.Diagnostics)
+
+	rt, diags := s.requireManagedResourceType(req.TypeName)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+		return resp, nil
+	}
+	schema, _ := rt.getSchema()
+
+	priorVal, diags := decodeTFPlugin6DynamicValue(req.PriorState, schema)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+		return resp, nil
+	}
+	configVal, diags := decodeTFPlugin6DynamicValue(req.Config, schema)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+		return resp, nil
+	}
+	proposedVal, diags := decodeTFPlugin6DynamicValue(req.ProposedNewState, schema)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+		return resp, nil
+	}
+
+	plannedVal, requiresReplace, private, diags := s.planResourceChange(ctx, req.TypeName, rt, priorVal, configVal, proposedVal, req.PriorPrivate)
+	resp.PlannedState = encodeTFPlugin6DynamicValue(plannedVal, schema)
+	resp.RequiresReplace = encodeAttrPathSetToTFPlugin6(requiresReplace)
+	resp.PlannedPrivate = private
+	resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+	return resp, nil
+}
+
+func (s *tfplugin6Server) ApplyResourceChange(ctx context.Context, req *tfplugin6.ApplyResourceChange_Request) (resp *tfplugin6.ApplyResourceChange_Response, err error) {
+	resp = &tfplugin6.ApplyResourceChange_Response{}
+	defer recoverTFPlugin6Panic("ApplyResourceChange", req.TypeName, &resp.Diagnostics)
+
+	rt, diags := s.requireManagedResourceType(req.TypeName)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+		return resp, nil
+	}
+	schema, _ := rt.getSchema()
+
+	priorVal, diags := decodeTFPlugin6DynamicValue(req.PriorState, schema)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+		return resp, nil
+	}
+	plannedVal, diags := decodeTFPlugin6DynamicValue(req.PlannedState, schema)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+		return resp, nil
+	}
+
+	newVal, private, diags := s.applyResourceChange(ctx, req.TypeName, rt, priorVal, plannedVal, req.PlannedPrivate)
+	resp.NewState = encodeTFPlugin6DynamicValue(newVal, schema)
+	resp.Private = private
+	resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+	return resp, nil
+}
+
+func (s *tfplugin6Server) ImportResourceState(ctx context.Context, req *tfplugin6.ImportResourceState_Request) (resp *tfplugin6.ImportResourceState_Response, err error) {
+	resp = &tfplugin6.ImportResourceState_Response{}
+	defer recoverTFPlugin6Panic("ImportResourceState", req.TypeName, &resp.Diagnostics)
+
+	rt, diags := s.requireManagedResourceType(req.TypeName)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+		return resp, nil
+	}
+
+	objs, diags := s.importResourceState(ctx, req.TypeName, rt, req.Id)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+		return resp, nil
+	}
+
+	// Terraform Core will call ReadResource for each of the objects we
+	// return here before saving them, so the resource type's ReadFn gets an
+	// opportunity to fill in the rest of the object.
+	for _, obj := range objs {
+		objRT := s.p.managedResourceType(obj.TypeName)
+		schema, _ := objRT.getSchema()
+		resp.ImportedResources = append(resp.ImportedResources, &tfplugin6.ImportResourceState_ImportedResource{
+			TypeName: obj.TypeName,
+			State:    encodeTFPlugin6DynamicValue(obj.Object.(cty.Value), schema),
+			Private:  obj.Private.encode(),
+		})
+	}
+	resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+	return resp, nil
+}
+
+func (s *tfplugin6Server) ReadDataSource(ctx context.Context, req *tfplugin6.ReadDataSource_Request) (resp *tfplugin6.ReadDataSource_Response, err error) {
+	resp = &tfplugin6.ReadDataSource_Response{}
+	defer recoverTFPlugin6Panic("ReadDataSource", req.TypeName, &resp.Diagnostics)
+
+	rt, diags := s.requireDataResourceType(req.TypeName)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+		return resp, nil
+	}
+	schema := rt.getSchema()
+
+	configVal, diags := decodeTFPlugin6DynamicValue(req.Config, schema)
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+		return resp, nil
+	}
+
+	newVal, diags := s.readDataSource(ctx, req.TypeName, rt, configVal)
+	resp.State = encodeTFPlugin6DynamicValue(newVal, schema)
+	resp.Diagnostics = encodeDiagnosticsToTFPlugin6(diags)
+	return resp, nil
+}
+
+func (s *tfplugin6Server) StopProvider(context.Context, *tfplugin6.StopProvider_Request) (*tfplugin6.StopProvider_Response, error) {
+	// This cancels our server's root context, in the hope that the provider
+	// operations will respond to this by safely cancelling their in-flight
+	// actions and returning (possibly with an error) as quickly as possible.
+	s.stop()
+	return &tfplugin6.StopProvider_Response{}, nil
+}
+
+// recoverTFPlugin6Panic recovers from a panic in an RPC handler, if any, and
+// appends an error diagnostic describing it to the response diagnostics at
+// the given pointer. It must be called directly using defer, as the first
+// action after the response object is constructed:
+//
+//	resp = &tfplugin6.PlanResourceChange_Response{}
+//	defer recoverTFPlugin6Panic("PlanResourceChange", req.TypeName, &resp.Diagnostics)
+//
+// The RPC handler must use named return values so that the response object
+// is still returned after a panic is recovered.
+func recoverTFPlugin6Panic(operation, typeName string, diagsPtr *[]*tfplugin6.Diagnostic) {
+	if r := recover(); r != nil {
+		diags := Diagnostics{panicDiagnostic(r, operation, typeName)}
+		*diagsPtr = append(*diagsPtr, encodeDiagnosticsToTFPlugin6(diags)...)
+	}
+}
+
+// protocolVersion6 is an implementation of rpcplugin.Server that implements
+// protocol version 6.
+type protocolVersion6 struct {
+	p *Provider
+}
+
+var _ rpcplugin.Server = protocolVersion6{}
+
+func (p protocolVersion6) RegisterServer(server *grpc.Server) error {
+	tfplugin6.RegisterProviderServer(server, p.p.tfplugin6Server())
+	return nil
+}
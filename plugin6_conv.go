@@ -0,0 +1,202 @@
+package tfsdk
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/apparentlymart/terraform-sdk/internal/tfplugin6"
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func convertSchemaBlockToTFPlugin6(src *tfschema.BlockType) *tfplugin6.Schema_Block {
+	ret := &tfplugin6.Schema_Block{}
+	if src == nil {
+		// Weird, but we'll allow it.
+		return ret
+	}
+
+	ret.Attributes = convertSchemaAttributesToTFPlugin6(src.Attributes)
+
+	for name, blockS := range src.NestedBlockTypes {
+		nested := convertSchemaBlockToTFPlugin6(&blockS.Content)
+		nested.Deprecated = blockS.Deprecated != ""
+		var nesting tfplugin6.Schema_NestedBlock_NestingMode
+		switch blockS.Nesting {
+		case tfschema.NestingSingle:
+			nesting = tfplugin6.Schema_NestedBlock_SINGLE
+		case tfschema.NestingGroup:
+			nesting = tfplugin6.Schema_NestedBlock_GROUP
+		case tfschema.NestingList:
+			nesting = tfplugin6.Schema_NestedBlock_LIST
+		case tfschema.NestingMap:
+			nesting = tfplugin6.Schema_NestedBlock_MAP
+		case tfschema.NestingSet:
+			nesting = tfplugin6.Schema_NestedBlock_SET
+		default:
+			// Should never happen because the above is exhaustive.
+			panic(fmt.Sprintf("unsupported block nesting mode %#v", blockS.Nesting))
+		}
+		ret.BlockTypes = append(ret.BlockTypes, &tfplugin6.Schema_NestedBlock{
+			TypeName: name,
+			Nesting:  nesting,
+			Block:    nested,
+			MaxItems: int64(blockS.MaxItems),
+			MinItems: int64(blockS.MinItems),
+		})
+	}
+
+	return ret
+}
+
+func convertSchemaAttributesToTFPlugin6(src map[string]*tfschema.Attribute) []*tfplugin6.Schema_Attribute {
+	var ret []*tfplugin6.Schema_Attribute
+	for name, attrS := range src {
+		attr := &tfplugin6.Schema_Attribute{
+			Name:        name,
+			Description: attrS.Description,
+			Required:    attrS.Required,
+			Optional:    attrS.Optional,
+			Computed:    attrS.Computed || attrS.Default != nil || attrS.DefaultFn != nil,
+			Sensitive:   attrS.Sensitive,
+			Deprecated:  attrS.Deprecated != "",
+		}
+		if attrS.NestedType != nil {
+			attr.NestedType = convertSchemaNestedAttributeTypeToTFPlugin6(attrS.NestedType)
+		} else {
+			tyJSON, err := attrS.Type.MarshalJSON()
+			if err != nil {
+				// Should never happen, since types should always be valid
+				panic(fmt.Sprintf("failed to serialize %#v as JSON: %s", attrS.Type, err))
+			}
+			attr.Type = tyJSON
+		}
+		ret = append(ret, attr)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Name < ret[j].Name
+	})
+
+	return ret
+}
+
+func convertSchemaNestedAttributeTypeToTFPlugin6(src *tfschema.NestedAttributeType) *tfplugin6.Schema_Object {
+	var nesting tfplugin6.Schema_Object_NestingMode
+	switch src.Nesting {
+	case tfschema.NestingSingle:
+		nesting = tfplugin6.Schema_Object_SINGLE
+	case tfschema.NestingList:
+		nesting = tfplugin6.Schema_Object_LIST
+	case tfschema.NestingMap:
+		nesting = tfplugin6.Schema_Object_MAP
+	case tfschema.NestingSet:
+		nesting = tfplugin6.Schema_Object_SET
+	default:
+		// NestingGroup is not valid for nested attribute types, and there
+		// are no other nesting modes.
+		panic(fmt.Sprintf("unsupported nested attribute nesting mode %#v", src.Nesting))
+	}
+	return &tfplugin6.Schema_Object{
+		Attributes: convertSchemaAttributesToTFPlugin6(src.Attributes),
+		Nesting:    nesting,
+		MaxItems:   int64(src.MaxItems),
+		MinItems:   int64(src.MinItems),
+	}
+}
+
+func decodeTFPlugin6DynamicValue(src *tfplugin6.DynamicValue, schema *tfschema.BlockType) (cty.Value, Diagnostics) {
+	switch {
+	case len(src.Json) > 0:
+		return decodeJSONObject(src.Json, schema)
+	default:
+		return decodeMsgpackObject(src.Msgpack, schema)
+	}
+}
+
+func encodeTFPlugin6DynamicValue(src cty.Value, schema *tfschema.BlockType) *tfplugin6.DynamicValue {
+	msgpackSrc := encodeMsgpackObject(src, schema)
+	return &tfplugin6.DynamicValue{
+		Msgpack: msgpackSrc,
+	}
+}
+
+// decodeTFPlugin6RawState is the protocol version 6 equivalent of
+// decodeTFPlugin5RawState.
+func decodeTFPlugin6RawState(src *tfplugin6.RawState) ([]byte, map[string]string) {
+	if len(src.Json) > 0 {
+		return src.Json, nil
+	}
+	flatmap := src.Flatmap
+	if flatmap == nil {
+		flatmap = map[string]string{}
+	}
+	return nil, flatmap
+}
+
+func encodeDiagnosticsToTFPlugin6(src Diagnostics) []*tfplugin6.Diagnostic {
+	var ret []*tfplugin6.Diagnostic
+	for _, diag := range src {
+		var severity tfplugin6.Diagnostic_Severity
+		switch diag.Severity {
+		case Error:
+			severity = tfplugin6.Diagnostic_ERROR
+		case Warning:
+			severity = tfplugin6.Diagnostic_WARNING
+		}
+
+		ret = append(ret, &tfplugin6.Diagnostic{
+			Severity:  severity,
+			Summary:   diag.Summary,
+			Detail:    diag.Detail,
+			Attribute: encodeAttrPathToTFPlugin6(diag.Path),
+		})
+	}
+	return ret
+}
+
+func encodeAttrPathToTFPlugin6(path cty.Path) *tfplugin6.AttributePath {
+	ret := &tfplugin6.AttributePath{}
+	for _, rawStep := range path {
+		switch step := rawStep.(type) {
+		case cty.GetAttrStep:
+			ret.Steps = append(ret.Steps, &tfplugin6.AttributePath_Step{
+				Selector: &tfplugin6.AttributePath_Step_AttributeName{
+					AttributeName: step.Name,
+				},
+			})
+		case cty.IndexStep:
+			switch step.Key.Type() {
+			case cty.String:
+				ret.Steps = append(ret.Steps, &tfplugin6.AttributePath_Step{
+					Selector: &tfplugin6.AttributePath_Step_ElementKeyString{
+						ElementKeyString: step.Key.AsString(),
+					},
+				})
+			case cty.Number:
+				idx, _ := step.Key.AsBigFloat().Int64()
+				ret.Steps = append(ret.Steps, &tfplugin6.AttributePath_Step{
+					Selector: &tfplugin6.AttributePath_Step_ElementKeyInt{
+						ElementKeyInt: idx,
+					},
+				})
+			default:
+				// The protocol can't represent any other key types, such as
+				// the element values used in paths through sets, so we return
+				// the part of the path before this step, as Terraform Core
+				// itself does in this situation.
+				return ret
+			}
+		}
+	}
+	return ret
+}
+
+func encodeAttrPathSetToTFPlugin6(s cty.PathSet) []*tfplugin6.AttributePath {
+	l := s.List()
+	ret := make([]*tfplugin6.AttributePath, len(l))
+	for i, path := range l {
+		ret[i] = encodeAttrPathToTFPlugin6(path)
+	}
+	return ret
+}
@@ -0,0 +1,140 @@
+package tfsdk
+
+import (
+	"testing"
+
+	"github.com/apparentlymart/terraform-sdk/internal/tfplugin5"
+	"github.com/apparentlymart/terraform-sdk/internal/tfplugin6"
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/golang/protobuf/proto"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestConvertSchemaBlockToTFPlugin6(t *testing.T) {
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"name": {Type: cty.String, Required: true},
+			"rule": {
+				NestedType: &tfschema.NestedAttributeType{
+					Nesting: tfschema.NestingList,
+					Attributes: map[string]*tfschema.Attribute{
+						"port": {Type: cty.Number, Required: true},
+						"id":   {Type: cty.String, Computed: true},
+					},
+					MaxItems: 2,
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	got := convertSchemaBlockToTFPlugin6(schema)
+	if len(got.Attributes) != 2 {
+		t.Fatalf("wrong number of attributes %d; want 2", len(got.Attributes))
+	}
+	if got, want := string(got.Attributes[0].Type), `"string"`; got != want {
+		t.Errorf("wrong type for name %s; want %s", got, want)
+	}
+
+	rule := got.Attributes[1]
+	if rule.Type != nil {
+		t.Errorf("rule has type %s; want none because it has a nested type", rule.Type)
+	}
+	if rule.NestedType == nil {
+		t.Fatalf("rule has no nested type")
+	}
+	if got, want := rule.NestedType.Nesting, tfplugin6.Schema_Object_LIST; got != want {
+		t.Errorf("wrong nesting mode %s; want %s", got, want)
+	}
+	if got, want := rule.NestedType.MaxItems, int64(2); got != want {
+		t.Errorf("wrong MaxItems %d; want %d", got, want)
+	}
+	nested := rule.NestedType.Attributes
+	if len(nested) != 2 {
+		t.Fatalf("wrong number of nested attributes %d; want 2", len(nested))
+	}
+	if got, want := nested[0].Name, "id"; got != want || !nested[0].Computed {
+		t.Errorf("wrong first nested attribute %q (computed %t); want computed %q", got, nested[0].Computed, want)
+	}
+	if got, want := nested[1].Name, "port"; got != want || !nested[1].Required {
+		t.Errorf("wrong second nested attribute %q (required %t); want required %q", got, nested[1].Required, want)
+	}
+
+	// Protocol version 5 can't represent the nested type, so it gets the
+	// equivalent plain attribute type instead.
+	v5 := convertSchemaBlockToTFPlugin5(schema)
+	wantTy := cty.List(cty.Object(map[string]cty.Type{
+		"port": cty.Number,
+		"id":   cty.String,
+	}))
+	var gotTy cty.Type
+	if err := gotTy.UnmarshalJSON(v5.Attributes[1].Type); err != nil {
+		t.Fatal(err)
+	}
+	if !gotTy.Equals(wantTy) {
+		t.Errorf("wrong protocol 5 type\ngot:  %#v\nwant: %#v", gotTy, wantTy)
+	}
+}
+
+func TestConvertSchemaBlockToTFPlugin6Deprecated(t *testing.T) {
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"name": {Type: cty.String, Optional: true, Deprecated: "Use label instead."},
+		},
+		NestedBlockTypes: map[string]*tfschema.NestedBlockType{
+			"disk": {
+				Nesting:    tfschema.NestingList,
+				Deprecated: "Use volume blocks instead.",
+			},
+		},
+	}
+
+	got := convertSchemaBlockToTFPlugin6(schema)
+	if !got.Attributes[0].Deprecated {
+		t.Errorf("name is not marked as deprecated")
+	}
+	if !got.BlockTypes[0].Block.Deprecated {
+		t.Errorf("disk is not marked as deprecated")
+	}
+	if got.Deprecated {
+		t.Errorf("top-level block is marked as deprecated")
+	}
+}
+
+func TestConvertSchemaBlockToTFPlugin5Deprecated(t *testing.T) {
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"name": {Type: cty.String, Optional: true, Deprecated: "Use label instead."},
+		},
+		NestedBlockTypes: map[string]*tfschema.NestedBlockType{
+			"disk": {
+				Nesting:    tfschema.NestingList,
+				Deprecated: "Use volume blocks instead.",
+			},
+		},
+	}
+
+	got := convertSchemaBlockToTFPlugin5(schema)
+	if !got.Attributes[0].Deprecated {
+		t.Errorf("name is not marked as deprecated")
+	}
+	if !got.BlockTypes[0].Block.Deprecated {
+		t.Errorf("disk is not marked as deprecated")
+	}
+	if got.Deprecated {
+		t.Errorf("top-level block is marked as deprecated")
+	}
+
+	// The new fields must survive a round-trip through the wire format.
+	raw, err := proto.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded tfplugin5.Schema_Block
+	if err := proto.Unmarshal(raw, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Attributes[0].Deprecated || !decoded.BlockTypes[0].Block.Deprecated {
+		t.Errorf("deprecation flags were lost in encoding")
+	}
+}
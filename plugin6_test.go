@@ -0,0 +1,370 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/apparentlymart/terraform-sdk/internal/tfplugin6"
+	"github.com/apparentlymart/terraform-sdk/tfobj"
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func testTFPlugin6Provider() (*Provider, *tfschema.BlockType) {
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"id":   {Type: cty.String, Computed: true},
+			"name": {Type: cty.String, Required: true, RequiresReplace: true},
+			"rule": {
+				NestedType: &tfschema.NestedAttributeType{
+					Nesting: tfschema.NestingList,
+					Attributes: map[string]*tfschema.Attribute{
+						"port": {Type: cty.Number, Required: true},
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+	p := &Provider{
+		ConfigSchema: &tfschema.BlockType{},
+		ManagedResourceTypes: map[string]ManagedResourceType{
+			"test_thing": NewManagedResourceType(&ResourceTypeDef{
+				ConfigSchema: schema,
+				PlanFn: func(ctx context.Context, client interface{}, plan tfobj.PlanBuilder) (cty.Value, cty.PathSet, Diagnostics) {
+					ResourcePrivateData(ctx).Set("planned", true)
+					return plan.ObjectVal(), plan.RequiresReplace(), nil
+				},
+				CreateFn: func(ctx context.Context, client interface{}, planned tfobj.ObjectReader) (cty.Value, Diagnostics) {
+					var diags Diagnostics
+					var wasPlanned bool
+					if ok, _ := ResourcePrivateData(ctx).Get("planned", &wasPlanned); !ok || !wasPlanned {
+						diags = diags.Append(Diagnostic{
+							Severity: Error,
+							Summary:  "Missing private data",
+						})
+					}
+					return cty.ObjectVal(map[string]cty.Value{
+						"id":   cty.StringVal("thing-1"),
+						"name": planned.Attr("name"),
+						"rule": planned.Attr("rule"),
+					}), diags
+				},
+				ImportFn: func(ctx context.Context, client interface{}, id string) ([]ImportedObject, Diagnostics) {
+					return []ImportedObject{
+						{
+							Object: cty.ObjectVal(map[string]cty.Value{
+								"id":   cty.StringVal(id),
+								"name": cty.UnknownVal(cty.String),
+								"rule": cty.NullVal(schema.Attributes["rule"].ImpliedCtyType()),
+							}),
+						},
+					}, nil
+				},
+			}),
+		},
+	}
+	return p, schema
+}
+
+func TestTFPlugin6ServerPlanApply(t *testing.T) {
+	p, schema := testTFPlugin6Provider()
+	server := p.tfplugin6Server()
+	ctx := context.Background()
+
+	ruleTy := schema.Attributes["rule"].ImpliedCtyType()
+	config := cty.ObjectVal(map[string]cty.Value{
+		"id":   cty.NullVal(cty.String),
+		"name": cty.StringVal("a"),
+		"rule": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{"port": cty.NumberIntVal(80)}),
+		}),
+	})
+	proposed := cty.ObjectVal(map[string]cty.Value{
+		"id":   cty.UnknownVal(cty.String),
+		"name": config.GetAttr("name"),
+		"rule": config.GetAttr("rule"),
+	})
+	planResp, err := server.PlanResourceChange(ctx, &tfplugin6.PlanResourceChange_Request{
+		TypeName:         "test_thing",
+		PriorState:       encodeTFPlugin6DynamicValue(schema.Null(), schema),
+		Config:           encodeTFPlugin6DynamicValue(config, schema),
+		ProposedNewState: encodeTFPlugin6DynamicValue(proposed, schema),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error from plan: %s", err)
+	}
+	if len(planResp.Diagnostics) != 0 {
+		t.Fatalf("unexpected diagnostics from plan: %#v", planResp.Diagnostics)
+	}
+	planned, diags := decodeTFPlugin6DynamicValue(planResp.PlannedState, schema)
+	if diags.HasErrors() {
+		t.Fatalf("invalid planned state: %#v", diags)
+	}
+	if !planned.RawEquals(proposed) {
+		t.Errorf("wrong planned state\ngot:  %#v\nwant: %#v", planned, proposed)
+	}
+	if len(planResp.PlannedPrivate) == 0 {
+		t.Errorf("no planned private data")
+	}
+
+	applyResp, err := server.ApplyResourceChange(ctx, &tfplugin6.ApplyResourceChange_Request{
+		TypeName:       "test_thing",
+		PriorState:     encodeTFPlugin6DynamicValue(schema.Null(), schema),
+		Config:         encodeTFPlugin6DynamicValue(config, schema),
+		PlannedState:   planResp.PlannedState,
+		PlannedPrivate: planResp.PlannedPrivate,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error from apply: %s", err)
+	}
+	if len(applyResp.Diagnostics) != 0 {
+		t.Fatalf("unexpected diagnostics from apply: %#v", applyResp.Diagnostics)
+	}
+	got, diags := decodeTFPlugin6DynamicValue(applyResp.NewState, schema)
+	if diags.HasErrors() {
+		t.Fatalf("invalid new state: %#v", diags)
+	}
+	want := cty.ObjectVal(map[string]cty.Value{
+		"id":   cty.StringVal("thing-1"),
+		"name": cty.StringVal("a"),
+		"rule": config.GetAttr("rule"),
+	})
+	if !got.RawEquals(want) {
+		t.Errorf("wrong new state\ngot:  %#v\nwant: %#v", got, want)
+	}
+
+	// Changing the name must be planned as a replacement.
+	changed := cty.ObjectVal(map[string]cty.Value{
+		"id":   cty.StringVal("thing-1"),
+		"name": cty.StringVal("b"),
+		"rule": cty.NullVal(ruleTy),
+	})
+	planResp, err = server.PlanResourceChange(ctx, &tfplugin6.PlanResourceChange_Request{
+		TypeName:         "test_thing",
+		PriorState:       applyResp.NewState,
+		PriorPrivate:     applyResp.Private,
+		Config:           encodeTFPlugin6DynamicValue(changed, schema),
+		ProposedNewState: encodeTFPlugin6DynamicValue(changed, schema),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error from plan: %s", err)
+	}
+	if len(planResp.Diagnostics) != 0 {
+		t.Fatalf("unexpected diagnostics from plan: %#v", planResp.Diagnostics)
+	}
+	if got, want := len(planResp.RequiresReplace), 1; got != want {
+		t.Fatalf("wrong number of requires-replace paths %d; want %d", got, want)
+	}
+	step := planResp.RequiresReplace[0].Steps[0].Selector.(*tfplugin6.AttributePath_Step_AttributeName)
+	if got, want := step.AttributeName, "name"; got != want {
+		t.Errorf("wrong requires-replace path %q; want %q", got, want)
+	}
+}
+
+func TestTFPlugin6ServerImport(t *testing.T) {
+	p, schema := testTFPlugin6Provider()
+	server := p.tfplugin6Server()
+
+	resp, err := server.ImportResourceState(context.Background(), &tfplugin6.ImportResourceState_Request{
+		TypeName: "test_thing",
+		Id:       "thing-2",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(resp.Diagnostics) != 0 {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+	if got, want := len(resp.ImportedResources), 1; got != want {
+		t.Fatalf("wrong number of imported resources %d; want %d", got, want)
+	}
+	imported := resp.ImportedResources[0]
+	if got, want := imported.TypeName, "test_thing"; got != want {
+		t.Errorf("wrong type name %q; want %q", got, want)
+	}
+	got, diags := decodeTFPlugin6DynamicValue(imported.State, schema)
+	if diags.HasErrors() {
+		t.Fatalf("invalid imported state: %#v", diags)
+	}
+	want := cty.ObjectVal(map[string]cty.Value{
+		"id":   cty.StringVal("thing-2"),
+		"name": cty.NullVal(cty.String),
+		"rule": cty.NullVal(schema.Attributes["rule"].ImpliedCtyType()),
+	})
+	if !got.RawEquals(want) {
+		t.Errorf("wrong imported state\ngot:  %#v\nwant: %#v", got, want)
+	}
+
+	resp, err = server.ImportResourceState(context.Background(), &tfplugin6.ImportResourceState_Request{
+		TypeName: "test_nope",
+		Id:       "thing-2",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := len(resp.Diagnostics), 1; got != want {
+		t.Fatalf("wrong number of diagnostics %d; want %d", got, want)
+	}
+	if got, want := resp.Diagnostics[0].Summary, "Unsupported resource type"; got != want {
+		t.Errorf("wrong summary %q; want %q", got, want)
+	}
+}
+
+func TestTFPlugin6ServerNestedComputed(t *testing.T) {
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"rule": {
+				NestedType: &tfschema.NestedAttributeType{
+					Nesting: tfschema.NestingList,
+					Attributes: map[string]*tfschema.Attribute{
+						"port": {Type: cty.Number, Required: true},
+						"id":   {Type: cty.String, Computed: true},
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+	// setRuleIDs returns the given object with the id of each rule replaced
+	// with the result of the given function.
+	setRuleIDs := func(obj cty.Value, id func(i int) cty.Value) cty.Value {
+		var rules []cty.Value
+		for it := obj.GetAttr("rule").ElementIterator(); it.Next(); {
+			_, rule := it.Element()
+			rules = append(rules, cty.ObjectVal(map[string]cty.Value{
+				"port": rule.GetAttr("port"),
+				"id":   id(len(rules)),
+			}))
+		}
+		return cty.ObjectVal(map[string]cty.Value{
+			"rule": cty.ListVal(rules),
+		})
+	}
+	p := &Provider{
+		ConfigSchema: &tfschema.BlockType{},
+		ManagedResourceTypes: map[string]ManagedResourceType{
+			"test_thing": NewManagedResourceType(&ResourceTypeDef{
+				ConfigSchema: schema,
+				PlanFn: func(ctx context.Context, client interface{}, plan tfobj.PlanBuilder) (cty.Value, cty.PathSet, Diagnostics) {
+					planned := setRuleIDs(plan.ObjectVal(), func(i int) cty.Value {
+						return cty.UnknownVal(cty.String)
+					})
+					return planned, plan.RequiresReplace(), nil
+				},
+				CreateFn: func(ctx context.Context, client interface{}, planned tfobj.ObjectReader) (cty.Value, Diagnostics) {
+					return setRuleIDs(planned.ObjectVal(), func(i int) cty.Value {
+						return cty.StringVal(fmt.Sprintf("rule-%d", i))
+					}), nil
+				},
+			}),
+		},
+	}
+	server := p.tfplugin6Server()
+	ctx := context.Background()
+
+	config := cty.ObjectVal(map[string]cty.Value{
+		"rule": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"port": cty.NumberIntVal(80),
+				"id":   cty.NullVal(cty.String),
+			}),
+		}),
+	})
+	planResp, err := server.PlanResourceChange(ctx, &tfplugin6.PlanResourceChange_Request{
+		TypeName:         "test_thing",
+		PriorState:       encodeTFPlugin6DynamicValue(schema.Null(), schema),
+		Config:           encodeTFPlugin6DynamicValue(config, schema),
+		ProposedNewState: encodeTFPlugin6DynamicValue(config, schema),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error from plan: %s", err)
+	}
+	if len(planResp.Diagnostics) != 0 {
+		t.Fatalf("unexpected diagnostics from plan: %#v", planResp.Diagnostics)
+	}
+	planned, diags := decodeTFPlugin6DynamicValue(planResp.PlannedState, schema)
+	if diags.HasErrors() {
+		t.Fatalf("invalid planned state: %#v", diags)
+	}
+	if id := planned.GetAttr("rule").Index(cty.NumberIntVal(0)).GetAttr("id"); id.IsKnown() {
+		t.Errorf("rule id is known in plan: %#v", id)
+	}
+
+	applyResp, err := server.ApplyResourceChange(ctx, &tfplugin6.ApplyResourceChange_Request{
+		TypeName:       "test_thing",
+		PriorState:     encodeTFPlugin6DynamicValue(schema.Null(), schema),
+		Config:         encodeTFPlugin6DynamicValue(config, schema),
+		PlannedState:   planResp.PlannedState,
+		PlannedPrivate: planResp.PlannedPrivate,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error from apply: %s", err)
+	}
+	if len(applyResp.Diagnostics) != 0 {
+		t.Fatalf("unexpected diagnostics from apply: %#v", applyResp.Diagnostics)
+	}
+	got, diags := decodeTFPlugin6DynamicValue(applyResp.NewState, schema)
+	if diags.HasErrors() {
+		t.Fatalf("invalid new state: %#v", diags)
+	}
+	want := setRuleIDs(config, func(i int) cty.Value {
+		return cty.StringVal(fmt.Sprintf("rule-%d", i))
+	})
+	if !got.RawEquals(want) {
+		t.Errorf("wrong new state\ngot:  %#v\nwant: %#v", got, want)
+	}
+}
+
+func TestTFPlugin6ServerPlanDefaultFn(t *testing.T) {
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"name": {Type: cty.String, Required: true},
+			"region": {
+				Type:     cty.String,
+				Optional: true,
+				DefaultFn: func(obj cty.Value) (cty.Value, Diagnostics) {
+					return cty.StringVal("us-east-1"), nil
+				},
+			},
+		},
+	}
+	p := &Provider{
+		ConfigSchema: &tfschema.BlockType{},
+		ManagedResourceTypes: map[string]ManagedResourceType{
+			"test_thing": NewManagedResourceType(&ResourceTypeDef{
+				ConfigSchema: schema,
+			}),
+		},
+	}
+	server := p.tfplugin6Server()
+
+	config := cty.ObjectVal(map[string]cty.Value{
+		"name":   cty.StringVal("a"),
+		"region": cty.NullVal(cty.String),
+	})
+	resp, err := server.PlanResourceChange(context.Background(), &tfplugin6.PlanResourceChange_Request{
+		TypeName:         "test_thing",
+		PriorState:       encodeTFPlugin6DynamicValue(schema.Null(), schema),
+		Config:           encodeTFPlugin6DynamicValue(config, schema),
+		ProposedNewState: encodeTFPlugin6DynamicValue(config, schema),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(resp.Diagnostics) != 0 {
+		t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+	}
+	got, diags := decodeTFPlugin6DynamicValue(resp.PlannedState, schema)
+	if diags.HasErrors() {
+		t.Fatalf("invalid planned state: %#v", diags)
+	}
+	want := cty.ObjectVal(map[string]cty.Value{
+		"name":   cty.StringVal("a"),
+		"region": cty.StringVal("us-east-1"),
+	})
+	if !got.RawEquals(want) {
+		t.Errorf("wrong planned state\ngot:  %#v\nwant: %#v", got, want)
+	}
+}
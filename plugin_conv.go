@@ -19,10 +19,13 @@ func convertSchemaBlockToTFPlugin5(src *tfschema.BlockType) *tfplugin5.Schema_Bl
 	}
 
 	for name, attrS := range src.Attributes {
-		tyJSON, err := attrS.Type.MarshalJSON()
+		// Protocol version 5 has no representation of nested attribute types,
+		// so we present those as plain attributes of the equivalent type.
+		ty := attrS.ImpliedCtyType()
+		tyJSON, err := ty.MarshalJSON()
 		if err != nil {
 			// Should never happen, since types should always be valid
-			panic(fmt.Sprintf("failed to serialize %#v as JSON: %s", attrS.Type, err))
+			panic(fmt.Sprintf("failed to serialize %#v as JSON: %s", ty, err))
 		}
 		ret.Attributes = append(ret.Attributes, &tfplugin5.Schema_Attribute{
 			Name:        name,
@@ -30,13 +33,15 @@ func convertSchemaBlockToTFPlugin5(src *tfschema.BlockType) *tfplugin5.Schema_Bl
 			Description: attrS.Description,
 			Required:    attrS.Required,
 			Optional:    attrS.Optional,
-			Computed:    attrS.Computed || attrS.Default != nil,
+			Computed:    attrS.Computed || attrS.Default != nil || attrS.DefaultFn != nil,
 			Sensitive:   attrS.Sensitive,
+			Deprecated:  attrS.Deprecated != "",
 		})
 	}
 
 	for name, blockS := range src.NestedBlockTypes {
 		nested := convertSchemaBlockToTFPlugin5(&blockS.Content)
+		nested.Deprecated = blockS.Deprecated != ""
 		var nesting tfplugin5.Schema_NestedBlock_NestingMode
 		switch blockS.Nesting {
 		case tfschema.NestingSingle:
@@ -85,26 +90,46 @@ func encodeTFPlugin5DynamicValue(src cty.Value, schema *tfschema.BlockType) *tfp
 	}
 }
 
-func decodeTFPlugin5RawState(src *tfplugin5.RawState, schema *tfschema.BlockType) (cty.Value, Diagnostics) {
+// decodeTFPlugin5ConnectionInfo decodes the connection information that
+// Terraform Core sends to a provisioner, which is always a map of strings.
+func decodeTFPlugin5ConnectionInfo(src *tfplugin5.DynamicValue) (cty.Value, Diagnostics) {
+	wantTy := cty.Map(cty.String)
 	switch {
+	case src == nil:
+		return cty.NullVal(wantTy), nil
 	case len(src.Json) > 0:
-		return decodeJSONObject(src.Json, schema)
+		return decodeJSONValue(src.Json, wantTy)
 	default:
-		diags := Diagnostics{
-			{
-				Severity: Error,
-				Summary:  "Can't upgrade legacy state",
-				// FIXME: Terrible unactionable error message
-				Detail: "The state for this object is in a legacy format that is no longer supported. You must first apply a change to it with an older version of the provider.",
-			},
-		}
-		return cty.DynamicVal, diags
+		return decodeMsgpackValue(src.Msgpack, wantTy)
+	}
+}
+
+// decodeTFPlugin5RawState returns the raw representation of the given state,
+// which is either JSON or, for objects saved by providers written for
+// Terraform 0.11 and earlier, the legacy "flatmap" format. Exactly one of the
+// results is set.
+//
+// The result must be decoded using the schema for the version it was saved
+// with, which is not necessarily the current schema.
+func decodeTFPlugin5RawState(src *tfplugin5.RawState) ([]byte, map[string]string) {
+	if len(src.Json) > 0 {
+		return src.Json, nil
+	}
+	flatmap := src.Flatmap
+	if flatmap == nil {
+		// A legacy object with no attributes at all is unlikely, but we'll
+		// still treat it as a flatmap object to make our callers simpler.
+		flatmap = map[string]string{}
 	}
+	return nil, flatmap
 }
 
 func decodeJSONObject(src []byte, schema *tfschema.BlockType) (cty.Value, Diagnostics) {
+	return decodeJSONValue(src, schema.ImpliedCtyType())
+}
+
+func decodeJSONValue(src []byte, wantTy cty.Type) (cty.Value, Diagnostics) {
 	var diags Diagnostics
-	wantTy := schema.ImpliedCtyType()
 	ret, err := json.Unmarshal(src, wantTy)
 	if err != nil {
 		var path cty.Path
@@ -122,8 +147,11 @@ func decodeJSONObject(src []byte, schema *tfschema.BlockType) (cty.Value, Diagno
 }
 
 func decodeMsgpackObject(src []byte, schema *tfschema.BlockType) (cty.Value, Diagnostics) {
+	return decodeMsgpackValue(src, schema.ImpliedCtyType())
+}
+
+func decodeMsgpackValue(src []byte, wantTy cty.Type) (cty.Value, Diagnostics) {
 	var diags Diagnostics
-	wantTy := schema.ImpliedCtyType()
 	ret, err := msgpack.Unmarshal(src, wantTy)
 	if err != nil {
 		var path cty.Path
@@ -198,9 +226,11 @@ func encodeAttrPathToTFPlugin5(path cty.Path) *tfplugin5.AttributePath {
 					},
 				})
 			default:
-				// no other key types are valid, so we'll produce garbage in this case
-				// and have Terraform Core report it as such.
-				ret.Steps = append(ret.Steps, nil)
+				// The protocol can't represent any other key types, such as
+				// the element values used in paths through sets, so we return
+				// the part of the path before this step, as Terraform Core
+				// itself does in this situation.
+				return ret
 			}
 		}
 	}
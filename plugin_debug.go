@@ -0,0 +1,178 @@
+package tfsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+
+	"github.com/apparentlymart/terraform-sdk/internal/tfplugin5"
+	"github.com/apparentlymart/terraform-sdk/internal/tfplugin6"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// debugProtocolVersion is the plugin protocol version announced to Terraform
+// CLI in the reattach configuration produced by ServeProviderPluginDebug.
+//
+// The server also offers protocol version 5, but the reattach configuration
+// can name only one version and so we select the one that fully supports the
+// schema model of this SDK.
+const debugProtocolVersion = 6
+
+// ServeProviderPluginDebug starts a plugin server for the given provider in
+// "debug mode", where the provider process is started directly by the
+// developer (possibly under a debugger) rather than by Terraform CLI.
+//
+// The given provider address is the fully-qualified source address that
+// Terraform CLI will use to refer to the provider, such as
+// "registry.terraform.io/example/example".
+//
+// The plugin protocol handshake is skipped in this mode. Instead, the server
+// listens on a local socket and then prints to stdout a value for the
+// TF_REATTACH_PROVIDERS environment variable, which instructs Terraform CLI
+// to connect to this already-running process instead of launching its own.
+// Debug mode requires Terraform CLI v1.0 or later, because it announces
+// plugin protocol version 6.
+//
+// ServeProviderPluginDebug returns once the given context is cancelled or the
+// process receives an interrupt signal. It returns an error only if the server
+// could not be started, including if the provider's schemas are invalid as
+// described for ServeProviderPlugin.
+func ServeProviderPluginDebug(ctx context.Context, providerAddr string, p *Provider) error {
+	return serveProviderPluginDebug(ctx, providerAddr, p, os.Stdout)
+}
+
+// serveProviderPluginDebug is the implementation of ServeProviderPluginDebug,
+// which prints its instructions for Terraform CLI to the given writer.
+func serveProviderPluginDebug(ctx context.Context, providerAddr string, p *Provider, out io.Writer) error {
+	if err := checkProviderSchemas(p); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	listener, err := debugListener()
+	if err != nil {
+		return fmt.Errorf("failed to create listener: %s", err)
+	}
+	defer listener.Close()
+
+	server := grpc.NewServer()
+	tfplugin5.RegisterProviderServer(server, p.tfplugin5Server())
+	tfplugin6.RegisterProviderServer(server, p.tfplugin6Server())
+
+	// Terraform CLI's plugin client expects to be able to check the health
+	// of the "plugin" service, as it would for a normally-launched plugin.
+	healthCheck := health.NewServer()
+	healthCheck.SetServingStatus("plugin", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(server, healthCheck)
+
+	reattach, err := debugReattachJSON(providerAddr, listener.Addr())
+	if err != nil {
+		// Should never happen, since we control all of the types involved.
+		return fmt.Errorf("failed to serialize reattach configuration: %s", err)
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+	go func() {
+		select {
+		case <-interrupt:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.Serve(listener)
+	}()
+
+	log.Printf("[INFO] provider plugin server (debug mode) listening on %s", listener.Addr())
+	fmt.Fprintf(out, "Provider server started. To attach Terraform CLI, set the TF_REATTACH_PROVIDERS\nenvironment variable with the following:\n\n\tTF_REATTACH_PROVIDERS='%s'\n\n", reattach)
+
+	select {
+	case <-ctx.Done():
+		server.GracefulStop()
+		return nil
+	case err := <-serveErr:
+		return err
+	}
+}
+
+// debugListener creates a listener suitable for a provider running in debug
+// mode, using a unix domain socket where available and a loopback TCP port
+// otherwise.
+func debugListener() (net.Listener, error) {
+	if runtime.GOOS == "windows" {
+		return net.Listen("tcp", "127.0.0.1:0")
+	}
+
+	dir, err := ioutil.TempDir("", "tfsdk-debug")
+	if err != nil {
+		return nil, err
+	}
+	listener, err := net.Listen("unix", filepath.Join(dir, "plugin.sock"))
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	// Closing a unix listener removes the socket itself, but we must also
+	// clean up the temporary directory we created to contain it.
+	return debugUnixListener{listener, dir}, nil
+}
+
+type debugUnixListener struct {
+	net.Listener
+	dir string
+}
+
+func (l debugUnixListener) Close() error {
+	err := l.Listener.Close()
+	os.RemoveAll(l.dir)
+	return err
+}
+
+// debugReattachJSON returns the value for the TF_REATTACH_PROVIDERS
+// environment variable that instructs Terraform CLI to connect to the given
+// listener address for the provider with the given source address.
+func debugReattachJSON(providerAddr string, addr net.Addr) ([]byte, error) {
+	return json.Marshal(map[string]debugReattachConfig{
+		providerAddr: {
+			Protocol:        "grpc",
+			ProtocolVersion: debugProtocolVersion,
+			Pid:             os.Getpid(),
+			Test:            true,
+			Addr: debugReattachAddr{
+				Network: addr.Network(),
+				String:  addr.String(),
+			},
+		},
+	})
+}
+
+// debugReattachConfig is the JSON representation of a single provider in the
+// TF_REATTACH_PROVIDERS environment variable understood by Terraform CLI.
+type debugReattachConfig struct {
+	Protocol        string
+	ProtocolVersion int
+	Pid             int
+	Test            bool
+	Addr            debugReattachAddr
+}
+
+type debugReattachAddr struct {
+	Network string
+	String  string
+}
@@ -0,0 +1,121 @@
+package tfsdk
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+)
+
+func TestDebugReattachJSON(t *testing.T) {
+	addr := &net.UnixAddr{Net: "unix", Name: "/tmp/tfsdk-debug/plugin.sock"}
+	raw, err := debugReattachJSON("registry.terraform.io/example/example", addr)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got map[string]debugReattachConfig
+	if err := json.Unmarshal(raw, &got); err != nil {
+		t.Fatalf("invalid JSON: %s", err)
+	}
+	want := map[string]debugReattachConfig{
+		"registry.terraform.io/example/example": {
+			Protocol:        "grpc",
+			ProtocolVersion: 6,
+			Pid:             os.Getpid(),
+			Test:            true,
+			Addr: debugReattachAddr{
+				Network: "unix",
+				String:  "/tmp/tfsdk-debug/plugin.sock",
+			},
+		},
+	}
+	if len(got) != 1 || got["registry.terraform.io/example/example"] != want["registry.terraform.io/example/example"] {
+		t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, want)
+	}
+
+	// Terraform CLI expects these exact property names.
+	for _, name := range []string{`"Protocol"`, `"ProtocolVersion"`, `"Pid"`, `"Test"`, `"Addr"`, `"Network"`, `"String"`} {
+		if !strings.Contains(string(raw), name) {
+			t.Errorf("result does not contain %s\n%s", name, raw)
+		}
+	}
+}
+
+func TestServeProviderPluginDebug(t *testing.T) {
+	p := &Provider{ConfigSchema: &tfschema.BlockType{}}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r, w := io.Pipe()
+	result := make(chan error, 1)
+	go func() {
+		result <- serveProviderPluginDebug(ctx, "registry.terraform.io/example/example", p, w)
+		w.Close()
+	}()
+
+	var reattach string
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if strings.HasPrefix(line, "TF_REATTACH_PROVIDERS='") {
+			reattach = strings.TrimSuffix(strings.TrimPrefix(line, "TF_REATTACH_PROVIDERS='"), "'")
+			break
+		}
+	}
+	if reattach == "" {
+		t.Fatalf("no TF_REATTACH_PROVIDERS in output (server returned %v)", <-result)
+	}
+	var config map[string]debugReattachConfig
+	if err := json.Unmarshal([]byte(reattach), &config); err != nil {
+		t.Fatalf("invalid TF_REATTACH_PROVIDERS value: %s", err)
+	}
+	addr := config["registry.terraform.io/example/example"].Addr
+	conn, err := net.Dial(addr.Network, addr.String)
+	if err != nil {
+		t.Fatalf("cannot connect to server: %s", err)
+	}
+	conn.Close()
+
+	cancel()
+	go io.Copy(ioutil.Discard, r)
+	if err := <-result; err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestServeProviderPluginDebugListenerError(t *testing.T) {
+	listener, err := debugListener()
+	if err != nil {
+		t.Skipf("cannot create listeners in this environment: %s", err)
+	}
+	listener.Close()
+
+	// debugListener creates its socket in a new temporary directory, so an
+	// invalid temporary directory prevents the listener from being opened.
+	oldTmp, hadTmp := os.LookupEnv("TMPDIR")
+	os.Setenv("TMPDIR", "/nonexistent/tfsdk-debug-test")
+	defer func() {
+		if hadTmp {
+			os.Setenv("TMPDIR", oldTmp)
+		} else {
+			os.Unsetenv("TMPDIR")
+		}
+	}()
+
+	p := &Provider{ConfigSchema: &tfschema.BlockType{}}
+	err = serveProviderPluginDebug(context.Background(), "registry.terraform.io/example/example", p, ioutil.Discard)
+	if err == nil {
+		t.Fatalf("unexpected success")
+	}
+	if got, want := err.Error(), "failed to create listener"; !strings.Contains(got, want) {
+		t.Errorf("wrong error %q; want %q", got, want)
+	}
+}
@@ -0,0 +1,119 @@
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/apparentlymart/terraform-sdk/internal/tfplugin5"
+	"go.rpcplugin.org/rpcplugin"
+	"google.golang.org/grpc"
+)
+
+func (p *Provisioner) tfplugin5Server() tfplugin5.ProvisionerServer {
+	// As with providers, this single shared context is cancelled if the
+	// Terraform operation recieves an interrupt request.
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &tfplugin5ProvisionerServer{
+		p:    p,
+		ctx:  ctx,
+		stop: cancel,
+	}
+}
+
+type tfplugin5ProvisionerServer struct {
+	p    *Provisioner
+	ctx  context.Context
+	stop func()
+}
+
+func (s *tfplugin5ProvisionerServer) GetSchema(context.Context, *tfplugin5.GetProvisionerSchema_Request) (resp *tfplugin5.GetProvisionerSchema_Response, err error) {
+	resp = &tfplugin5.GetProvisionerSchema_Response{}
+	defer recoverTFPlugin5Panic("GetSchema", "", &resp.Diagnostics)
+
+	resp.Provisioner = &tfplugin5.Schema{
+		Block: convertSchemaBlockToTFPlugin5(s.p.getSchema()),
+	}
+
+	return resp, nil
+}
+
+func (s *tfplugin5ProvisionerServer) ValidateProvisionerConfig(ctx context.Context, req *tfplugin5.ValidateProvisionerConfig_Request) (resp *tfplugin5.ValidateProvisionerConfig_Response, err error) {
+	resp = &tfplugin5.ValidateProvisionerConfig_Response{}
+	defer recoverTFPlugin5Panic("ValidateProvisionerConfig", "", &resp.Diagnostics)
+
+	configVal, diags := decodeTFPlugin5DynamicValue(req.Config, s.p.getSchema())
+	if diags.HasErrors() {
+		resp.Diagnostics = encodeDiagnosticsToTFPlugin5(diags)
+		return resp, nil
+	}
+
+	diags = s.p.validate(configVal)
+	resp.Diagnostics = encodeDiagnosticsToTFPlugin5(diags)
+	return resp, nil
+}
+
+func (s *tfplugin5ProvisionerServer) ProvisionResource(req *tfplugin5.ProvisionResource_Request, srv tfplugin5.Provisioner_ProvisionResourceServer) (err error) {
+	defer func() {
+		// This is a streaming call, so we report a panic by sending one
+		// final message containing the diagnostic.
+		if r := recover(); r != nil {
+			diags := Diagnostics{panicDiagnostic(r, "ProvisionResource", "")}
+			err = srv.Send(&tfplugin5.ProvisionResource_Response{
+				Diagnostics: encodeDiagnosticsToTFPlugin5(diags),
+			})
+		}
+	}()
+
+	configVal, diags := decodeTFPlugin5DynamicValue(req.Config, s.p.getSchema())
+	if diags.HasErrors() {
+		return srv.Send(&tfplugin5.ProvisionResource_Response{
+			Diagnostics: encodeDiagnosticsToTFPlugin5(diags),
+		})
+	}
+	connVal, diags := decodeTFPlugin5ConnectionInfo(req.Connection)
+	if diags.HasErrors() {
+		return srv.Send(&tfplugin5.ProvisionResource_Response{
+			Diagnostics: encodeDiagnosticsToTFPlugin5(diags),
+		})
+	}
+
+	// Each line of output is sent as a separate response message, and then
+	// the final message carries any diagnostics.
+	output := &provisionerOutput{
+		send: func(line string) error {
+			return srv.Send(&tfplugin5.ProvisionResource_Response{
+				Output: line,
+			})
+		},
+	}
+
+	stoppableCtx := stoppableContext(s.ctx, srv.Context())
+	diags = s.p.provision(stoppableCtx, configVal, connVal, output)
+	if err := output.Close(); err != nil {
+		return err
+	}
+
+	return srv.Send(&tfplugin5.ProvisionResource_Response{
+		Diagnostics: encodeDiagnosticsToTFPlugin5(diags),
+	})
+}
+
+func (s *tfplugin5ProvisionerServer) Stop(context.Context, *tfplugin5.Stop_Request) (*tfplugin5.Stop_Response, error) {
+	// This cancels our server's root context, in the hope that an in-flight
+	// provisioning operation will respond by returning as quickly as possible.
+	s.stop()
+	return &tfplugin5.Stop_Response{}, nil
+}
+
+// provisionerProtocolVersion5 is an implementation of rpcplugin.Server that
+// implements protocol version 5 for provisioners.
+type provisionerProtocolVersion5 struct {
+	p *Provisioner
+}
+
+var _ rpcplugin.Server = provisionerProtocolVersion5{}
+
+func (p provisionerProtocolVersion5) RegisterServer(server *grpc.Server) error {
+	tfplugin5.RegisterProvisionerServer(server, p.p.tfplugin5Server())
+	return nil
+}
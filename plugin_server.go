@@ -0,0 +1,187 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// providerServer is the part of a provider plugin server that doesn't vary
+// between plugin protocol versions. The server for each protocol version
+// embeds it, decodes the values from each request, calls the corresponding
+// providerServer method to do the real work, and then encodes the results
+// into its response.
+type providerServer struct {
+	p    *Provider
+	ctx  context.Context
+	stop func()
+}
+
+func (p *Provider) newProviderServer() providerServer {
+	// This single shared context will be passed (directly or indirectly) to
+	// each provider method that can make network requests and cancelled if
+	// the Terraform operation recieves an interrupt request.
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return providerServer{
+		p:    p,
+		ctx:  ctx,
+		stop: cancel,
+	}
+}
+
+// requireManagedResourceType is a helper to conveniently retrieve a particular
+// managed resource type or produce an error message if it is invalid.
+//
+// The usage pattern for this method is:
+//
+//	rt, diags := s.requireManagedResourceType(req.TypeName)
+//	if diags.HasErrors() {
+//		resp.Diagnostics = encodeDiagnosticsToTFPlugin5(diags)
+//		return resp, nil
+//	}
+func (s *providerServer) requireManagedResourceType(typeName string) (ManagedResourceType, Diagnostics) {
+	var diags Diagnostics
+	rt := s.p.managedResourceType(typeName)
+	if rt == nil {
+		diags = diags.Append(Diagnostic{
+			Severity: Error,
+			Summary:  "Unsupported resource type",
+			Detail:   fmt.Sprintf("This provider does not support managed resource type %q", typeName),
+		})
+	}
+	return rt, diags
+}
+
+// requireDataResourceType is a helper to conveniently retrieve a particular
+// data resource type or produce an error message if it is invalid. It is used
+// in the same way as requireManagedResourceType.
+func (s *providerServer) requireDataResourceType(typeName string) (DataResourceType, Diagnostics) {
+	var diags Diagnostics
+	rt := s.p.dataResourceType(typeName)
+	if rt == nil {
+		diags = diags.Append(Diagnostic{
+			Severity: Error,
+			Summary:  "Unsupported resource type",
+			Detail:   fmt.Sprintf("This provider does not support data resource type %q", typeName),
+		})
+	}
+	return rt, diags
+}
+
+func (s *providerServer) configure(ctx context.Context, configVal cty.Value) Diagnostics {
+	return s.p.configure(s.stoppableContext(ctx), configVal)
+}
+
+// readResource refreshes the given object, returning the new object and the
+// encoded private data to return to Terraform Core alongside it.
+func (s *providerServer) readResource(ctx context.Context, typeName string, rt ManagedResourceType, currentVal cty.Value, rawPrivate []byte) (cty.Value, []byte, Diagnostics) {
+	schema, _ := rt.getSchema()
+	ctx, private := privateDataContext(s.stoppableContext(ctx), typeName, rawPrivate)
+	newVal, diags := s.p.readResource(ctx, rt, currentVal)
+	diags = diags.Append(checkResultConformance(typeName, schema, newVal, "new object"))
+
+	if newVal.IsNull() {
+		// Private data is meaningless once the object has been removed.
+		return newVal, nil, diags
+	}
+	return newVal, private.encode(), diags
+}
+
+// planResourceChange plans a change to the given object, returning the
+// planned object, the paths that require replacement, and the encoded private
+// data to return to Terraform Core alongside them.
+func (s *providerServer) planResourceChange(ctx context.Context, typeName string, rt ManagedResourceType, priorVal, configVal, proposedVal cty.Value, rawPrivate []byte) (cty.Value, cty.PathSet, []byte, Diagnostics) {
+	schema, _ := rt.getSchema()
+	ctx, private := privateDataContext(s.stoppableContext(ctx), typeName, rawPrivate)
+	plannedVal, requiresReplace, diags := s.p.planResourceChange(ctx, rt, priorVal, configVal, proposedVal)
+	diags = diags.Append(checkResultConformance(typeName, schema, plannedVal, "planned new object"))
+	if !diags.HasErrors() {
+		diags = diags.Append(s.p.checkPlannedObject(typeName, schema, priorVal, configVal, plannedVal))
+	}
+	return plannedVal, requiresReplace, private.encode(), diags
+}
+
+// applyResourceChange applies a planned change to the given object, returning
+// the new object and the encoded private data to return to Terraform Core
+// alongside it.
+func (s *providerServer) applyResourceChange(ctx context.Context, typeName string, rt ManagedResourceType, priorVal, plannedVal cty.Value, rawPrivate []byte) (cty.Value, []byte, Diagnostics) {
+	schema, _ := rt.getSchema()
+	ctx, private := privateDataContext(s.stoppableContext(ctx), typeName, rawPrivate)
+	newVal, diags := s.p.applyResourceChange(ctx, rt, priorVal, plannedVal)
+	diags = diags.Append(checkResultConformance(typeName, schema, newVal, "new object"))
+	if !diags.HasErrors() {
+		diags = diags.Append(s.p.checkAppliedObject(typeName, schema, plannedVal, newVal))
+	}
+
+	if newVal.IsNull() {
+		// Private data is meaningless once the object has been deleted.
+		return newVal, nil, diags
+	}
+	return newVal, private.encode(), diags
+}
+
+// importResourceState imports the objects with the given id. Each of the
+// resulting objects has a cty.Value as its Object and a non-empty TypeName.
+func (s *providerServer) importResourceState(ctx context.Context, typeName string, rt ManagedResourceType, id string) ([]ImportedObject, Diagnostics) {
+	return s.p.importResourceState(s.stoppableContext(ctx), typeName, rt, id)
+}
+
+func (s *providerServer) readDataSource(ctx context.Context, typeName string, rt DataResourceType, configVal cty.Value) (cty.Value, Diagnostics) {
+	newVal, diags := s.p.readDataSource(s.stoppableContext(ctx), rt, configVal)
+	diags = diags.Append(checkResultConformance(typeName, rt.getSchema(), newVal, "new object"))
+	return newVal, diags
+}
+
+// stoppableContext returns a new context that will get cancelled if either the
+// given context is cancelled or if the provider is asked to stop.
+//
+// This function starts a goroutine that exits only when the given context is
+// cancelled, so it's important that the given context be cancelled shortly
+// after the request it represents is completed.
+func (s *providerServer) stoppableContext(ctx context.Context) context.Context {
+	return stoppableContext(s.ctx, ctx)
+}
+
+// checkResultConformance is a safety check that the given object produced by
+// the provider for an instance of the given resource type conforms to the
+// type implied by the resource type's schema, which is required in order to
+// encode it in a response.
+func checkResultConformance(typeName string, schema *tfschema.BlockType, val cty.Value, what string) Diagnostics {
+	var diags Diagnostics
+	for _, err := range val.Type().TestConformance(schema.ImpliedCtyType()) {
+		diags = diags.Append(Diagnostic{
+			Severity: Error,
+			Summary:  "Invalid result from provider",
+			Detail:   fmt.Sprintf("Provider produced an invalid %s for %s: %s", what, typeName, FormatError(err)),
+		})
+	}
+	return diags
+}
+
+// privateDataContext decodes the given raw private data for an instance of
+// the given resource type and returns a new context that makes it available
+// via ResourcePrivateData, along with the decoded private data itself so that
+// the caller can encode any changes into its response.
+func privateDataContext(ctx context.Context, typeName string, raw []byte) (context.Context, *PrivateData) {
+	private, err := decodePrivateData(raw)
+	if err != nil {
+		log.Printf("[WARN] discarding unsupported private data for an instance of %s: %s", typeName, err)
+	}
+	return withResourcePrivateData(ctx, private), private
+}
+
+// stoppableContext returns a new context that will get cancelled if either of
+// the given contexts is cancelled. The root context is the one belonging to
+// the server, which is cancelled when the plugin is asked to stop.
+func stoppableContext(root, ctx context.Context) context.Context {
+	stoppable, cancel := context.WithCancel(root)
+	go func() {
+		<-ctx.Done()
+		cancel()
+	}()
+	return stoppable
+}
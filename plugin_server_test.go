@@ -0,0 +1,51 @@
+package tfsdk
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func testInvalidSchemaProvider() *Provider {
+	return &Provider{
+		ConfigSchema: &tfschema.BlockType{},
+		ManagedResourceTypes: map[string]ManagedResourceType{
+			"test_thing": NewManagedResourceType(&ResourceTypeDef{
+				ConfigSchema: &tfschema.BlockType{
+					Attributes: map[string]*tfschema.Attribute{
+						"name": {Type: cty.String, Required: true, Computed: true},
+					},
+				},
+			}),
+		},
+	}
+}
+
+func TestCheckProviderSchemas(t *testing.T) {
+	if err := checkProviderSchemas(&Provider{ConfigSchema: &tfschema.BlockType{}}); err != nil {
+		t.Errorf("unexpected error for valid provider: %s", err)
+	}
+
+	err := checkProviderSchemas(testInvalidSchemaProvider())
+	if err == nil {
+		t.Fatalf("unexpected success for invalid provider")
+	}
+	want := "provider has invalid schemas:\n  - Invalid schema for managed resource type test_thing: name: Required and Computed are mutually exclusive.\n\n"
+	if got := err.Error(); !strings.HasPrefix(got, want) {
+		t.Errorf("wrong error\ngot:  %s\nwant: %s...", got, want)
+	}
+}
+
+func TestServeProviderPluginDebugInvalidSchema(t *testing.T) {
+	err := serveProviderPluginDebug(context.Background(), "registry.terraform.io/example/example", testInvalidSchemaProvider(), ioutil.Discard)
+	if err == nil {
+		t.Fatalf("unexpected success")
+	}
+	if got, want := err.Error(), "provider has invalid schemas"; !strings.Contains(got, want) {
+		t.Errorf("wrong error %q; want %q", got, want)
+	}
+}
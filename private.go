@@ -0,0 +1,138 @@
+package tfsdk
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+)
+
+// PrivateData is a set of key/value pairs that the SDK stores alongside each
+// instance of a managed resource type, but which are not visible to the user
+// as part of the resource instance's attributes.
+//
+// Terraform saves the private data in the state along with the object itself
+// and passes it back to the provider in subsequent operations, so it is a
+// good place to retain details that are meaningful only to the provider,
+// such as an ETag or an API version marker.
+//
+// Each value is serialized as JSON using package encoding/json, so values
+// must be of types that can round-trip through that package.
+//
+// The resource type functions for managed resource types can access the
+// private data for the instance being operated on by calling
+// ResourcePrivateData with the context they were given.
+type PrivateData struct {
+	values map[string]json.RawMessage
+}
+
+// NewPrivateData returns a new, empty PrivateData.
+func NewPrivateData() *PrivateData {
+	return &PrivateData{
+		values: make(map[string]json.RawMessage),
+	}
+}
+
+// Get decodes the value stored for the given key into the value that the
+// given target pointer refers to, using the same rules as json.Unmarshal.
+//
+// The boolean result is false if there is no value stored for the given key,
+// in which case the target is left unmodified. An error is returned if the
+// stored value cannot be decoded into the target.
+func (d *PrivateData) Get(key string, target interface{}) (bool, error) {
+	raw, exists := d.values[key]
+	if !exists {
+		return false, nil
+	}
+	return true, json.Unmarshal(raw, target)
+}
+
+// Set stores the given value under the given key, replacing any value that
+// was previously stored there. An error is returned if the given value
+// cannot be serialized as JSON.
+func (d *PrivateData) Set(key string, val interface{}) error {
+	raw, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	d.values[key] = raw
+	return nil
+}
+
+// Remove deletes the value stored for the given key, if any.
+func (d *PrivateData) Remove(key string) {
+	delete(d.values, key)
+}
+
+// Keys returns the keys that currently have values stored, in lexical order.
+func (d *PrivateData) Keys() []string {
+	ret := make([]string, 0, len(d.values))
+	for k := range d.values {
+		ret = append(ret, k)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// decodePrivateData decodes the given raw private data as received from
+// Terraform Core.
+//
+// Private data saved by this SDK is always a JSON object, but Terraform may
+// send private data saved by other SDKs (or, for objects that have never been
+// saved, no data at all). Anything that isn't a JSON object is discarded and
+// replaced with empty private data, with the error returned for logging.
+func decodePrivateData(raw []byte) (*PrivateData, error) {
+	ret := NewPrivateData()
+	if len(raw) == 0 {
+		return ret, nil
+	}
+	if err := json.Unmarshal(raw, &ret.values); err != nil {
+		return NewPrivateData(), err
+	}
+	if ret.values == nil {
+		// The raw data was the JSON null literal.
+		ret.values = make(map[string]json.RawMessage)
+	}
+	return ret, nil
+}
+
+// encode serializes the private data for transmission to Terraform Core.
+//
+// The result is nil if there are no values stored, so that Terraform will
+// not record any private data at all in that case.
+func (d *PrivateData) encode() []byte {
+	if d == nil || len(d.values) == 0 {
+		return nil
+	}
+	ret, err := json.Marshal(d.values)
+	if err != nil {
+		// Should never happen, because all of the values were either
+		// produced by json.Marshal or already validated by json.Unmarshal.
+		panic("failed to serialize private data: " + err.Error())
+	}
+	return ret
+}
+
+type privateDataContextKey struct{}
+
+// ResourcePrivateData returns the private data for the managed resource
+// instance that the given context belongs to. Changes made to the result
+// during the plan, apply, and read operations are saved by Terraform and
+// returned in the next operation on the same instance.
+//
+// The private data produced by PlanFn is the private data seen by CreateFn or
+// UpdateFn during the subsequent apply. The private data is discarded when an
+// instance is deleted.
+//
+// If the context does not belong to an operation on a managed resource
+// instance then the result is an empty PrivateData, and any changes made to
+// it are discarded.
+func ResourcePrivateData(ctx context.Context) *PrivateData {
+	if d, ok := ctx.Value(privateDataContextKey{}).(*PrivateData); ok {
+		return d
+	}
+	return NewPrivateData()
+}
+
+func withResourcePrivateData(ctx context.Context, d *PrivateData) context.Context {
+	return context.WithValue(ctx, privateDataContextKey{}, d)
+}
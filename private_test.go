@@ -0,0 +1,205 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apparentlymart/terraform-sdk/internal/tfplugin5"
+	"github.com/apparentlymart/terraform-sdk/tfobj"
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestPrivateData(t *testing.T) {
+	d := NewPrivateData()
+	if got := d.encode(); got != nil {
+		t.Fatalf("empty private data encoded as %q; want nil", got)
+	}
+
+	if err := d.Set("etag", "abc123"); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Set("api_version", 2); err != nil {
+		t.Fatal(err)
+	}
+	d.Remove("nonexistent")
+
+	raw := d.encode()
+	if got, want := string(raw), `{"api_version":2,"etag":"abc123"}`; got != want {
+		t.Fatalf("wrong encoding\ngot:  %s\nwant: %s", got, want)
+	}
+
+	d, err := decodePrivateData(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var etag string
+	if ok, err := d.Get("etag", &etag); !ok || err != nil {
+		t.Fatalf("failed to get etag: ok=%t, err=%v", ok, err)
+	}
+	if etag != "abc123" {
+		t.Errorf("wrong etag %q; want %q", etag, "abc123")
+	}
+	var version int
+	if ok, err := d.Get("api_version", &version); !ok || err != nil {
+		t.Fatalf("failed to get api_version: ok=%t, err=%v", ok, err)
+	}
+	if version != 2 {
+		t.Errorf("wrong api_version %d; want 2", version)
+	}
+
+	d.Remove("etag")
+	if ok, _ := d.Get("etag", &etag); ok {
+		t.Errorf("etag still present after removal")
+	}
+	if got, want := d.Keys(), []string{"api_version"}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("wrong keys %#v; want %#v", got, want)
+	}
+}
+
+func TestDecodePrivateDataInvalid(t *testing.T) {
+	// Private data saved by some other SDK might not be JSON at all.
+	d, err := decodePrivateData([]byte("\x00\x01"))
+	if err == nil {
+		t.Fatal("unexpected success")
+	}
+	if got := d.Keys(); len(got) != 0 {
+		t.Errorf("unexpected keys %#v", got)
+	}
+}
+
+func TestResourcePrivateData(t *testing.T) {
+	d := NewPrivateData()
+	ctx := withResourcePrivateData(context.Background(), d)
+	if got := ResourcePrivateData(ctx); got != d {
+		t.Errorf("wrong private data %#v; want %#v", got, d)
+	}
+
+	if got := ResourcePrivateData(context.Background()); got == nil {
+		t.Errorf("no private data for context without any")
+	}
+}
+
+func TestTFPlugin5ServerPrivateData(t *testing.T) {
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"id":   {Type: cty.String, Computed: true},
+			"name": {Type: cty.String, Required: true},
+		},
+	}
+	gone := false
+	wantPrivate := func(ctx context.Context, keys ...string) Diagnostics {
+		var diags Diagnostics
+		private := ResourcePrivateData(ctx)
+		for _, key := range keys {
+			var got string
+			if ok, err := private.Get(key, &got); !ok || err != nil || got != key {
+				diags = diags.Append(Diagnostic{
+					Severity: Error,
+					Summary:  "Missing private data",
+					Detail:   "No private data for " + key + ".",
+				})
+			}
+		}
+		return diags
+	}
+	p := &Provider{
+		ConfigSchema: &tfschema.BlockType{},
+		ManagedResourceTypes: map[string]ManagedResourceType{
+			"test_thing": NewManagedResourceType(&ResourceTypeDef{
+				ConfigSchema: schema,
+				PlanFn: func(ctx context.Context, client interface{}, plan tfobj.PlanBuilder) (cty.Value, cty.PathSet, Diagnostics) {
+					ResourcePrivateData(ctx).Set("plan", "plan")
+					return plan.ObjectVal(), plan.RequiresReplace(), nil
+				},
+				CreateFn: func(ctx context.Context, client interface{}, planned tfobj.ObjectReader) (cty.Value, Diagnostics) {
+					diags := wantPrivate(ctx, "plan")
+					ResourcePrivateData(ctx).Set("create", "create")
+					return cty.ObjectVal(map[string]cty.Value{
+						"id":   cty.StringVal("thing-1"),
+						"name": planned.Attr("name"),
+					}), diags
+				},
+				ReadFn: func(ctx context.Context, client interface{}, current tfobj.ObjectReader) (cty.Value, Diagnostics) {
+					diags := wantPrivate(ctx, "plan", "create")
+					if gone {
+						return schema.Null(), diags
+					}
+					return current.ObjectVal(), diags
+				},
+			}),
+		},
+	}
+	server := p.tfplugin5Server()
+	ctx := context.Background()
+
+	config := cty.ObjectVal(map[string]cty.Value{
+		"id":   cty.NullVal(cty.String),
+		"name": cty.StringVal("a"),
+	})
+	proposed := cty.ObjectVal(map[string]cty.Value{
+		"id":   cty.UnknownVal(cty.String),
+		"name": cty.StringVal("a"),
+	})
+	planResp, err := server.PlanResourceChange(ctx, &tfplugin5.PlanResourceChange_Request{
+		TypeName:         "test_thing",
+		PriorState:       encodeTFPlugin5DynamicValue(schema.Null(), schema),
+		Config:           encodeTFPlugin5DynamicValue(config, schema),
+		ProposedNewState: encodeTFPlugin5DynamicValue(proposed, schema),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error from plan: %s", err)
+	}
+	if len(planResp.Diagnostics) != 0 {
+		t.Fatalf("unexpected diagnostics from plan: %#v", planResp.Diagnostics)
+	}
+
+	applyResp, err := server.ApplyResourceChange(ctx, &tfplugin5.ApplyResourceChange_Request{
+		TypeName:       "test_thing",
+		PriorState:     encodeTFPlugin5DynamicValue(schema.Null(), schema),
+		Config:         encodeTFPlugin5DynamicValue(config, schema),
+		PlannedState:   planResp.PlannedState,
+		PlannedPrivate: planResp.PlannedPrivate,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error from apply: %s", err)
+	}
+	if len(applyResp.Diagnostics) != 0 {
+		t.Fatalf("unexpected diagnostics from apply: %#v", applyResp.Diagnostics)
+	}
+
+	readResp, err := server.ReadResource(ctx, &tfplugin5.ReadResource_Request{
+		TypeName:     "test_thing",
+		CurrentState: applyResp.NewState,
+		Private:      applyResp.Private,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error from read: %s", err)
+	}
+	if len(readResp.Diagnostics) != 0 {
+		t.Fatalf("unexpected diagnostics from read: %#v", readResp.Diagnostics)
+	}
+	private, err := decodePrivateData(readResp.Private)
+	if err != nil {
+		t.Fatalf("invalid private data from read: %s", err)
+	}
+	if got, want := len(private.Keys()), 2; got != want {
+		t.Errorf("wrong number of private data keys %d; want %d", got, want)
+	}
+
+	gone = true
+	readResp, err = server.ReadResource(ctx, &tfplugin5.ReadResource_Request{
+		TypeName:     "test_thing",
+		CurrentState: applyResp.NewState,
+		Private:      applyResp.Private,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error from read: %s", err)
+	}
+	if len(readResp.Diagnostics) != 0 {
+		t.Fatalf("unexpected diagnostics from read: %#v", readResp.Diagnostics)
+	}
+	if readResp.Private != nil {
+		t.Errorf("private data returned for removed object: %q", readResp.Private)
+	}
+}
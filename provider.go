@@ -3,10 +3,13 @@ package tfsdk
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/apparentlymart/terraform-sdk/internal/dynfunc"
 	"github.com/apparentlymart/terraform-sdk/tfschema"
 	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/gocty"
 )
 
 // Provider is the main type for describing a Terraform provider
@@ -20,6 +23,15 @@ type Provider struct {
 
 	ConfigureFn interface{}
 
+	// LenientConsistencyChecks, if set, causes the SDK to only log warnings
+	// when a resource type produces a planned or applied object that
+	// Terraform Core would reject, rather than returning errors.
+	//
+	// This is intended only as a temporary measure while migrating existing
+	// resource type implementations, since Terraform Core will still reject
+	// such objects itself, albeit with a less helpful error message.
+	LenientConsistencyChecks bool
+
 	client interface{}
 }
 
@@ -31,12 +43,13 @@ type Provider struct {
 // *ResourceType value and pass it to NewManagedResourceType.
 type ManagedResourceType interface {
 	getSchema() (schema *tfschema.BlockType, version int64)
+	deprecationMessage() string
 	validate(obj cty.Value) Diagnostics
-	upgradeState(oldJSON []byte, oldVersion int) (cty.Value, Diagnostics)
+	upgradeState(oldJSON []byte, oldFlatmap map[string]string, oldVersion int64) (cty.Value, Diagnostics)
 	refresh(ctx context.Context, client interface{}, old cty.Value) (cty.Value, Diagnostics)
 	planChange(ctx context.Context, client interface{}, prior, config, proposed cty.Value) (planned cty.Value, requiresReplace cty.PathSet, diags Diagnostics)
 	applyChange(ctx context.Context, client interface{}, prior, planned cty.Value) (cty.Value, Diagnostics)
-	importState(ctx context.Context, client interface{}, id string) (cty.Value, Diagnostics)
+	importState(ctx context.Context, client interface{}, id string) ([]ImportedObject, Diagnostics)
 }
 
 // DataResourceType is an interface implemented by data resource type
@@ -47,6 +60,7 @@ type ManagedResourceType interface {
 // *ResourceType value and pass it to NewDataResourceType.
 type DataResourceType interface {
 	getSchema() *tfschema.BlockType
+	deprecationMessage() string
 	validate(obj cty.Value) Diagnostics
 	read(ctx context.Context, client interface{}, config cty.Value) (cty.Value, Diagnostics)
 }
@@ -55,16 +69,30 @@ type DataResourceType interface {
 // (whose type must conform to the schema) and validates it, possibly also
 // altering some of the values within to produce a final configuration for
 // Terraform Core to use when interacting with this provider instance.
+//
+// The defaults from the schema are applied before validation, so that
+// attributes set from defaults can satisfy any validation rules.
 func (p *Provider) prepareConfig(proposedVal cty.Value) (cty.Value, Diagnostics) {
-	diags := ValidateBlockObject(p.ConfigSchema, proposedVal)
-	return proposedVal, diags
+	preparedVal, diags := applyDefaults(p.ConfigSchema, proposedVal)
+	if diags.HasErrors() {
+		return proposedVal, diags
+	}
+	diags = diags.Append(ValidateBlockObject(p.ConfigSchema, preparedVal))
+	return preparedVal, diags
 }
 
 // configure recieves the finalized configuration for the provider and passes
 // it to the provider's configuration function to produce the client object
 // that will be recieved by the various resource operations.
 func (p *Provider) configure(ctx context.Context, config cty.Value) Diagnostics {
-	var diags Diagnostics
+	// Protocol version 6 has no equivalent of the prepared configuration
+	// returned from prepareConfig, so we must apply the defaults again here.
+	// This has no effect for a configuration that was already prepared.
+	config, diags := applyDefaults(p.ConfigSchema, config)
+	if diags.HasErrors() {
+		return diags
+	}
+
 	var client interface{}
 	fn, err := dynfunc.WrapFunctionWithReturnValue(p.ConfigureFn, &client, ctx, config)
 	if err != nil {
@@ -84,6 +112,51 @@ func (p *Provider) configure(ctx context.Context, config cty.Value) Diagnostics
 	return diags
 }
 
+// InternalValidate checks the provider's configuration schema and the schemas
+// of all of its managed and data resource types for mistakes, returning an
+// error diagnostic for each problem found.
+//
+// Providers should call InternalValidate from their tests and fail if it
+// returns any diagnostics. ServeProviderPlugin, ServeProviderPluginDebug, and
+// tfsdktest.NewProvider also call it once, before doing anything else, and
+// refuse to continue if it finds any problems.
+func (p *Provider) InternalValidate() Diagnostics {
+	var diags Diagnostics
+	schemaDiags := func(what string, schema *tfschema.BlockType) {
+		for _, err := range schema.InternalValidate() {
+			diags = diags.Append(Diagnostic{
+				Severity: Error,
+				Summary:  "Invalid provider schema",
+				Detail:   fmt.Sprintf("Invalid schema for %s: %s.\n\nThis is a bug in the provider; please report it in the provider's issue tracker.", what, strings.TrimPrefix(FormatError(err), ".")),
+			})
+		}
+	}
+
+	// We visit the resource types in a predictable order so that the
+	// diagnostics will be consistent between runs.
+	managedNames := make([]string, 0, len(p.ManagedResourceTypes))
+	for name := range p.ManagedResourceTypes {
+		managedNames = append(managedNames, name)
+	}
+	sort.Strings(managedNames)
+	dataNames := make([]string, 0, len(p.DataResourceTypes))
+	for name := range p.DataResourceTypes {
+		dataNames = append(dataNames, name)
+	}
+	sort.Strings(dataNames)
+
+	schemaDiags("the provider configuration", p.ConfigSchema)
+	for _, name := range managedNames {
+		schema, _ := p.ManagedResourceTypes[name].getSchema()
+		schemaDiags(fmt.Sprintf("managed resource type %s", name), schema)
+	}
+	for _, name := range dataNames {
+		schema := p.DataResourceTypes[name].getSchema()
+		schemaDiags(fmt.Sprintf("data resource type %s", name), schema)
+	}
+	return diags
+}
+
 func (p *Provider) managedResourceType(typeName string) ManagedResourceType {
 	return p.ManagedResourceTypes[typeName]
 }
@@ -92,6 +165,10 @@ func (p *Provider) dataResourceType(typeName string) DataResourceType {
 	return p.DataResourceTypes[typeName]
 }
 
+func (p *Provider) upgradeResourceState(rt ManagedResourceType, oldJSON []byte, oldFlatmap map[string]string, oldVersion int64) (cty.Value, Diagnostics) {
+	return rt.upgradeState(oldJSON, oldFlatmap, oldVersion)
+}
+
 func (p *Provider) readResource(ctx context.Context, rt ManagedResourceType, currentVal cty.Value) (cty.Value, Diagnostics) {
 	return rt.refresh(ctx, p.client, currentVal)
 }
@@ -107,3 +184,80 @@ func (p *Provider) planResourceChange(ctx context.Context, rt ManagedResourceTyp
 func (p *Provider) applyResourceChange(ctx context.Context, rt ManagedResourceType, priorVal, plannedVal cty.Value) (cty.Value, Diagnostics) {
 	return rt.applyChange(ctx, p.client, priorVal, plannedVal)
 }
+
+// importResourceState calls the import function for the given resource type
+// and then normalizes its results so that each object has an explicit type
+// name and a cty.Value object conforming to that resource type's schema.
+func (p *Provider) importResourceState(ctx context.Context, typeName string, rt ManagedResourceType, id string) ([]ImportedObject, Diagnostics) {
+	objs, diags := rt.importState(ctx, p.client, id)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	ret := make([]ImportedObject, 0, len(objs))
+	for _, obj := range objs {
+		objTypeName := obj.TypeName
+		if objTypeName == "" {
+			objTypeName = typeName
+		}
+		objRT := p.managedResourceType(objTypeName)
+		if objRT == nil {
+			diags = diags.Append(Diagnostic{
+				Severity: Error,
+				Summary:  "Invalid result from provider",
+				Detail:   fmt.Sprintf("Provider tried to import an object of unsupported resource type %q while importing %s.\n\nThis is a bug in the provider; please report it in the provider's issue tracker.", objTypeName, typeName),
+			})
+			continue
+		}
+		schema, _ := objRT.getSchema()
+		wantTy := schema.ImpliedCtyType()
+
+		var val cty.Value
+		switch raw := obj.Object.(type) {
+		case cty.Value:
+			val = raw
+		default:
+			var err error
+			val, err = gocty.ToCtyValue(raw, wantTy)
+			if err != nil {
+				diags = diags.Append(Diagnostic{
+					Severity: Error,
+					Summary:  "Invalid result from provider",
+					Detail:   fmt.Sprintf("Provider produced an invalid imported object for %s: %s.\n\nThis is a bug in the provider; please report it in the provider's issue tracker.", objTypeName, FormatError(err)),
+				})
+				continue
+			}
+		}
+
+		// Imported objects are saved directly to the state (after refreshing),
+		// so they must be wholly known.
+		val = cty.UnknownAsNull(val)
+
+		if val.IsNull() {
+			diags = diags.Append(Diagnostic{
+				Severity: Error,
+				Summary:  "Invalid result from provider",
+				Detail:   fmt.Sprintf("Provider produced a null imported object for %s.\n\nThis is a bug in the provider; please report it in the provider's issue tracker.", objTypeName),
+			})
+			continue
+		}
+		if errs := val.Type().TestConformance(wantTy); len(errs) > 0 {
+			for _, err := range errs {
+				diags = diags.Append(Diagnostic{
+					Severity: Error,
+					Summary:  "Invalid result from provider",
+					Detail:   fmt.Sprintf("Provider produced an invalid imported object for %s: %s.\n\nThis is a bug in the provider; please report it in the provider's issue tracker.", objTypeName, FormatError(err)),
+				})
+			}
+			continue
+		}
+
+		ret = append(ret, ImportedObject{
+			TypeName: objTypeName,
+			Object:   val,
+			Private:  obj.Private,
+		})
+	}
+
+	return ret, diags
+}
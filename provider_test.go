@@ -0,0 +1,309 @@
+package tfsdk
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/apparentlymart/terraform-sdk/tfobj"
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestProviderInternalValidate(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		p := &Provider{
+			ConfigSchema: &tfschema.BlockType{
+				Attributes: map[string]*tfschema.Attribute{
+					"region": {Type: cty.String, Optional: true},
+				},
+			},
+			ManagedResourceTypes: map[string]ManagedResourceType{
+				"test_instance": NewManagedResourceType(&ResourceTypeDef{
+					ConfigSchema: &tfschema.BlockType{
+						Attributes: map[string]*tfschema.Attribute{
+							"id": {Type: cty.String, Computed: true},
+						},
+					},
+				}),
+			},
+		}
+		if diags := p.InternalValidate(); len(diags) != 0 {
+			t.Errorf("unexpected diagnostics: %#v", diags)
+		}
+	})
+	t.Run("invalid", func(t *testing.T) {
+		invalid := &tfschema.BlockType{
+			Attributes: map[string]*tfschema.Attribute{
+				"name": {Type: cty.String, Required: true, Computed: true},
+			},
+		}
+		p := &Provider{
+			ConfigSchema: invalid,
+			ManagedResourceTypes: map[string]ManagedResourceType{
+				"test_b": NewManagedResourceType(&ResourceTypeDef{ConfigSchema: invalid}),
+				"test_a": NewManagedResourceType(&ResourceTypeDef{ConfigSchema: invalid}),
+			},
+			DataResourceTypes: map[string]DataResourceType{
+				"test_c": NewDataResourceType(&ResourceTypeDef{ConfigSchema: invalid}),
+			},
+		}
+		diags := p.InternalValidate()
+		want := []string{
+			"Invalid schema for the provider configuration: name: Required and Computed are mutually exclusive.",
+			"Invalid schema for managed resource type test_a: name: Required and Computed are mutually exclusive.",
+			"Invalid schema for managed resource type test_b: name: Required and Computed are mutually exclusive.",
+			"Invalid schema for data resource type test_c: name: Required and Computed are mutually exclusive.",
+		}
+		if len(diags) != len(want) {
+			t.Fatalf("wrong number of diagnostics %d; want %d\n%#v", len(diags), len(want), diags)
+		}
+		for i, diag := range diags {
+			if got, want := diag.Severity, Error; got != want {
+				t.Errorf("wrong severity for diagnostic %d", i)
+			}
+			if got, want := diag.Detail, want[i]; !strings.HasPrefix(got, want) {
+				t.Errorf("wrong detail for diagnostic %d\ngot:  %s\nwant: %s", i, got, want)
+			}
+		}
+	})
+}
+
+func TestProviderPrepareConfigDefaultFn(t *testing.T) {
+	os.Setenv("TFSDK_TEST_PORT", "8080")
+	defer os.Unsetenv("TFSDK_TEST_PORT")
+
+	type hostArg struct {
+		Host *string `cty:"host"`
+		Port *int    `cty:"port"`
+		URL  *string `cty:"url"`
+	}
+	p := &Provider{
+		ConfigSchema: &tfschema.BlockType{
+			Attributes: map[string]*tfschema.Attribute{
+				"host": {Type: cty.String, Optional: true},
+				"port": {
+					Type:      cty.Number,
+					Optional:  true,
+					DefaultFn: tfschema.EnvDefaultFn("TFSDK_TEST_PORT", cty.NullVal(cty.Number)),
+				},
+				"url": {
+					Type:     cty.String,
+					Optional: true,
+					// Arguments and results are converted in the same way as
+					// for other functions in the schema.
+					DefaultFn: func(obj hostArg) (string, Diagnostics) {
+						if obj.Host == nil {
+							return "", nil
+						}
+						return "https://" + *obj.Host + "/", nil
+					},
+				},
+			},
+		},
+	}
+
+	got, diags := p.prepareConfig(cty.ObjectVal(map[string]cty.Value{
+		"host": cty.StringVal("example.com"),
+		"port": cty.NullVal(cty.Number),
+		"url":  cty.NullVal(cty.String),
+	}))
+	if len(diags) != 0 {
+		t.Fatalf("unexpected diagnostics: %#v", diags)
+	}
+	want := cty.ObjectVal(map[string]cty.Value{
+		"host": cty.StringVal("example.com"),
+		"port": cty.NumberIntVal(8080),
+		"url":  cty.StringVal("https://example.com/"),
+	})
+	if !got.RawEquals(want) {
+		t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, want)
+	}
+
+	tests := map[string]struct {
+		defaultFn   interface{}
+		wantSummary string
+	}{
+		"unsuitable argument": {
+			func(obj tfobj.ObjectReader) (cty.Value, Diagnostics) {
+				return cty.StringVal("https"), nil
+			},
+			"Unsuitable argument value",
+		},
+		"unsuitable result": {
+			func(obj cty.Value) (cty.Value, Diagnostics) {
+				return cty.ListValEmpty(cty.String), nil
+			},
+			"Invalid default value",
+		},
+		"invalid signature": {
+			func(obj cty.Value) cty.Value {
+				return cty.StringVal("https")
+			},
+			"Invalid provider schema",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := &Provider{
+				ConfigSchema: &tfschema.BlockType{
+					Attributes: map[string]*tfschema.Attribute{
+						"scheme": {Type: cty.String, Optional: true, DefaultFn: test.defaultFn},
+					},
+				},
+			}
+			_, diags := p.prepareConfig(cty.ObjectVal(map[string]cty.Value{
+				"scheme": cty.NullVal(cty.String),
+			}))
+			if len(diags) != 1 {
+				t.Fatalf("wrong number of diagnostics %d; want 1\n%#v", len(diags), diags)
+			}
+			if got, want := diags[0].Summary, test.wantSummary; got != want {
+				t.Errorf("wrong summary %q; want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestProviderImportResourceState(t *testing.T) {
+	instanceSchema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"id":   {Type: cty.String, Computed: true},
+			"name": {Type: cty.String, Optional: true},
+		},
+	}
+	volumeSchema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"id":   {Type: cty.String, Computed: true},
+			"size": {Type: cty.Number, Optional: true},
+		},
+	}
+	type instance struct {
+		ID   string  `cty:"id"`
+		Name *string `cty:"name"`
+	}
+
+	provider := func(importFn interface{}) *Provider {
+		return &Provider{
+			ManagedResourceTypes: map[string]ManagedResourceType{
+				"test_instance": NewManagedResourceType(&ResourceTypeDef{
+					ConfigSchema: instanceSchema,
+					ImportFn:     importFn,
+				}),
+				"test_volume": NewManagedResourceType(&ResourceTypeDef{
+					ConfigSchema: volumeSchema,
+				}),
+			},
+		}
+	}
+	importObjs := func(objs ...ImportedObject) interface{} {
+		return func(ctx context.Context, client interface{}, id string) ([]ImportedObject, Diagnostics) {
+			return objs, nil
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		private := NewPrivateData()
+		if err := private.Set("token", "abc"); err != nil {
+			t.Fatal(err)
+		}
+		p := provider(importObjs(
+			ImportedObject{
+				Object:  &instance{ID: "i-abc123"},
+				Private: private,
+			},
+			ImportedObject{
+				TypeName: "test_volume",
+				Object: cty.ObjectVal(map[string]cty.Value{
+					"id":   cty.StringVal("vol-abc123"),
+					"size": cty.UnknownVal(cty.Number),
+				}),
+			},
+		))
+		got, diags := p.importResourceState(context.Background(), "test_instance", p.managedResourceType("test_instance"), "i-abc123")
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors: %#v", diags)
+		}
+		if len(got) != 2 {
+			t.Fatalf("wrong number of objects %d; want 2", len(got))
+		}
+
+		if got, want := got[0].TypeName, "test_instance"; got != want {
+			t.Errorf("wrong type name for first object %q; want %q", got, want)
+		}
+		wantInstance := cty.ObjectVal(map[string]cty.Value{
+			"id":   cty.StringVal("i-abc123"),
+			"name": cty.NullVal(cty.String),
+		})
+		if got := got[0].Object.(cty.Value); !wantInstance.RawEquals(got) {
+			t.Errorf("wrong first object\ngot:  %#v\nwant: %#v", got, wantInstance)
+		}
+		if got[0].Private != private {
+			t.Errorf("private data was not preserved")
+		}
+
+		if got, want := got[1].TypeName, "test_volume"; got != want {
+			t.Errorf("wrong type name for second object %q; want %q", got, want)
+		}
+		wantVolume := cty.ObjectVal(map[string]cty.Value{
+			"id":   cty.StringVal("vol-abc123"),
+			"size": cty.NullVal(cty.Number),
+		})
+		if got := got[1].Object.(cty.Value); !wantVolume.RawEquals(got) {
+			t.Errorf("wrong second object\ngot:  %#v\nwant: %#v", got, wantVolume)
+		}
+	})
+
+	tests := map[string]struct {
+		importFn   interface{}
+		wantDetail string
+	}{
+		"unknown resource type": {
+			importObjs(ImportedObject{
+				TypeName: "test_nope",
+				Object:   instanceSchema.Null(),
+			}),
+			`unsupported resource type "test_nope"`,
+		},
+		"null result": {
+			importObjs(ImportedObject{
+				Object: instanceSchema.Null(),
+			}),
+			"Provider produced a null imported object for test_instance.",
+		},
+		"unconvertible Go value": {
+			importObjs(ImportedObject{
+				Object: "i-abc123",
+			}),
+			"Provider produced an invalid imported object for test_instance",
+		},
+		"nonconforming value": {
+			importObjs(ImportedObject{
+				Object: cty.ObjectVal(map[string]cty.Value{
+					"id": cty.StringVal("i-abc123"),
+				}),
+			}),
+			`Provider produced an invalid imported object for test_instance: missing required attribute "name".`,
+		},
+		"not importable": {
+			nil,
+			"This resource type does not support importing existing remote objects.",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := provider(test.importFn)
+			got, diags := p.importResourceState(context.Background(), "test_instance", p.managedResourceType("test_instance"), "i-abc123")
+			if !diags.HasErrors() {
+				t.Fatalf("unexpected success")
+			}
+			if len(got) != 0 {
+				t.Errorf("unexpected objects: %#v", got)
+			}
+			if got := diags[0].Detail; !strings.Contains(got, test.wantDetail) {
+				t.Errorf("wrong error\ngot:  %s\nwant: %s", got, test.wantDetail)
+			}
+		})
+	}
+}
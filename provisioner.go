@@ -0,0 +1,157 @@
+package tfsdk
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/apparentlymart/terraform-sdk/internal/dynfunc"
+	"github.com/apparentlymart/terraform-sdk/tfobj"
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ProvisionerDef is the type that provisioner packages should instantiate to
+// describe the implementation of a provisioner.
+//
+// As with ResourceTypeDef, a ProvisionerDef is not itself a provisioner. Pass
+// a pointer to an instance of this type to NewProvisioner to obtain a
+// provisioner implementation that can be served with ServeProvisionerPlugin.
+type ProvisionerDef struct {
+	ConfigSchema *tfschema.BlockType
+
+	// ValidateFn can optionally be set to perform additional validation of
+	// the provisioner configuration beyond what is implied by ConfigSchema.
+	// It must be a function compatible with the following signature:
+	//
+	//     func (config tfobj.ObjectReader) tfsdk.Diagnostics
+	//
+	// The configuration may contain unknown values during validation, so
+	// the function must tolerate those.
+	ValidateFn interface{}
+
+	// ProvisionFn is the function called to run the provisioner against a
+	// newly-created resource instance. It must be a function compatible with
+	// the following signature:
+	//
+	//     func (ctx context.Context, config tfobj.ObjectReader, connection cty.Value, output io.Writer) tfsdk.Diagnostics
+	//
+	// The connection value is a map of strings describing how to connect to
+	// the remote object, as given in the "connection" block in the
+	// configuration. It may instead be decoded into a map[string]string using
+	// package gocty.
+	//
+	// Anything written to output is sent to Terraform to be displayed in the
+	// UI, one line at a time.
+	ProvisionFn interface{}
+}
+
+// Provisioner is a provisioner implementation, as returned by NewProvisioner.
+type Provisioner struct {
+	configSchema *tfschema.BlockType
+	validateFn   interface{}
+	provisionFn  interface{}
+}
+
+// NewProvisioner prepares a Provisioner implementation using the definition
+// from the given ProvisionerDef instance.
+//
+// This function is intended to be called during startup with a valid
+// ProvisionerDef, so it will panic if the given ProvisionerDef is not valid.
+func NewProvisioner(def *ProvisionerDef) *Provisioner {
+	if def == nil {
+		panic("NewProvisioner called with nil definition")
+	}
+	if def.ProvisionFn == nil {
+		panic("NewProvisioner requires def.ProvisionFn")
+	}
+
+	schema := def.ConfigSchema
+	if schema == nil {
+		schema = &tfschema.BlockType{}
+	}
+
+	return &Provisioner{
+		configSchema: schema,
+		validateFn:   def.ValidateFn,
+		provisionFn:  def.ProvisionFn,
+	}
+}
+
+func (p *Provisioner) getSchema() *tfschema.BlockType {
+	return p.configSchema
+}
+
+func (p *Provisioner) validate(config cty.Value) Diagnostics {
+	diags := ValidateBlockObject(p.configSchema, config)
+	if diags.HasErrors() {
+		return diags
+	}
+
+	configReader := tfobj.NewObjectReader(p.configSchema, config)
+	fn, err := dynfunc.WrapSimpleFunction(p.validateFn, configReader)
+	if err != nil {
+		diags = diags.Append(Diagnostic{
+			Severity: Error,
+			Summary:  "Invalid provisioner implementation",
+			Detail:   fmt.Sprintf("Invalid ValidateFn: %s.\nThis is a bug in the provisioner that should be reported in its own issue tracker.", err),
+		})
+		return diags
+	}
+	diags = diags.Append(fn())
+	return diags
+}
+
+func (p *Provisioner) provision(ctx context.Context, config, connection cty.Value, output io.Writer) Diagnostics {
+	var diags Diagnostics
+
+	configReader := tfobj.NewObjectReader(p.configSchema, config)
+	fn, err := dynfunc.WrapSimpleFunction(p.provisionFn, ctx, configReader, connection, output)
+	if err != nil {
+		diags = diags.Append(Diagnostic{
+			Severity: Error,
+			Summary:  "Invalid provisioner implementation",
+			Detail:   fmt.Sprintf("Invalid ProvisionFn: %s.\nThis is a bug in the provisioner that should be reported in its own issue tracker.", err),
+		})
+		return diags
+	}
+	diags = diags.Append(fn())
+	return diags
+}
+
+// provisionerOutput is an io.Writer that passes each complete line written to
+// it to a callback function, which typically sends the line to Terraform Core.
+//
+// Call Close once writing is complete in order to send any final incomplete
+// line.
+type provisionerOutput struct {
+	buf  bytes.Buffer
+	send func(line string) error
+}
+
+var _ io.WriteCloser = (*provisionerOutput)(nil)
+
+func (w *provisionerOutput) Write(p []byte) (int, error) {
+	w.buf.Write(p)
+	for {
+		idx := bytes.IndexByte(w.buf.Bytes(), '\n')
+		if idx < 0 {
+			break
+		}
+		line := string(w.buf.Next(idx + 1))
+		if err := w.send(line[:idx]); err != nil {
+			return len(p), err
+		}
+	}
+	return len(p), nil
+}
+
+func (w *provisionerOutput) Close() error {
+	if w.buf.Len() == 0 {
+		return nil
+	}
+	line := w.buf.String()
+	w.buf.Reset()
+	return w.send(line)
+}
@@ -0,0 +1,75 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/apparentlymart/terraform-sdk/tfobj"
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestProvisionerProvision(t *testing.T) {
+	p := NewProvisioner(&ProvisionerDef{
+		ConfigSchema: &tfschema.BlockType{
+			Attributes: map[string]*tfschema.Attribute{
+				"command": {Type: cty.String, Required: true},
+			},
+		},
+		ValidateFn: func(config tfobj.ObjectReader) Diagnostics {
+			var diags Diagnostics
+			if v := config.Attr("command"); v.IsKnown() && v.AsString() == "" {
+				diags = diags.Append(Diagnostic{
+					Severity: Error,
+					Summary:  "Empty command",
+				})
+			}
+			return diags
+		},
+		ProvisionFn: func(ctx context.Context, config tfobj.ObjectReader, connection map[string]string, output io.Writer) Diagnostics {
+			fmt.Fprintf(output, "connecting to %s\n", connection["host"])
+			fmt.Fprintf(output, "running %s", config.Attr("command").AsString())
+			return nil
+		},
+	})
+
+	diags := p.validate(cty.ObjectVal(map[string]cty.Value{
+		"command": cty.StringVal(""),
+	}))
+	if got, want := len(diags), 1; got != want {
+		t.Errorf("wrong number of validation diagnostics %d; want %d", got, want)
+	}
+
+	var lines []string
+	output := &provisionerOutput{
+		send: func(line string) error {
+			lines = append(lines, line)
+			return nil
+		},
+	}
+	diags = p.provision(
+		context.Background(),
+		cty.ObjectVal(map[string]cty.Value{
+			"command": cty.StringVal("uptime"),
+		}),
+		cty.MapVal(map[string]cty.Value{
+			"host": cty.StringVal("example.com"),
+		}),
+		output,
+	)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %#v", diags)
+	}
+	if got, want := lines, []string{"connecting to example.com"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("wrong lines before close\ngot:  %#v\nwant: %#v", got, want)
+	}
+	if err := output.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := lines, []string{"connecting to example.com", "running uptime"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("wrong lines after close\ngot:  %#v\nwant: %#v", got, want)
+	}
+}
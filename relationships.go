@@ -0,0 +1,181 @@
+package tfsdk
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// validateRelationships checks the given known object value against the
+// ConflictsWith, ExactlyOneOf, RequiredWith, and AtLeastOneOf constraints of
+// the given block type.
+//
+// The returned diagnostics have paths relative to the given object.
+func validateRelationships(schema *tfschema.BlockType, val cty.Value) Diagnostics {
+	var diags Diagnostics
+
+	for _, group := range schema.ConflictsWith {
+		set, _ := relatedAttrsSet(val, group)
+		if len(set) < 2 {
+			continue
+		}
+		for _, path := range set {
+			diags = diags.Append(Diagnostic{
+				Severity: Error,
+				Summary:  "Conflicting configuration arguments",
+				Detail:   fmt.Sprintf("The argument %s cannot be used together with %s.", relativePathString(path), relativePathsString(otherPaths(set, path), "or")),
+				Path:     path,
+			})
+		}
+	}
+
+	for _, group := range schema.ExactlyOneOf {
+		set, unknown := relatedAttrsSet(val, group)
+		switch {
+		case len(set) > 1:
+			for _, path := range set {
+				diags = diags.Append(Diagnostic{
+					Severity: Error,
+					Summary:  "Conflicting configuration arguments",
+					Detail:   fmt.Sprintf("Only one of %s may be set.", relativePathsString(group, "or")),
+					Path:     path,
+				})
+			}
+		case len(set) == 0 && !unknown:
+			diags = diags.Append(Diagnostic{
+				Severity: Error,
+				Summary:  "Missing required argument",
+				Detail:   fmt.Sprintf("Exactly one of %s must be set.", relativePathsString(group, "or")),
+			})
+		}
+	}
+
+	for _, group := range schema.RequiredWith {
+		set, _ := relatedAttrsSet(val, group)
+		if len(set) == 0 {
+			continue
+		}
+		for _, path := range group {
+			if v := relativePathValue(val, path); !v.IsKnown() || !v.IsNull() {
+				continue
+			}
+			diags = diags.Append(Diagnostic{
+				Severity: Error,
+				Summary:  "Missing required argument",
+				Detail:   fmt.Sprintf("The argument %s must be set when %s is set.", relativePathString(path), relativePathsString(set, "or")),
+				Path:     path,
+			})
+		}
+	}
+
+	for _, group := range schema.AtLeastOneOf {
+		set, unknown := relatedAttrsSet(val, group)
+		if len(set) == 0 && !unknown {
+			diags = diags.Append(Diagnostic{
+				Severity: Error,
+				Summary:  "Missing required argument",
+				Detail:   fmt.Sprintf("At least one of %s must be set.", relativePathsString(group, "or")),
+			})
+		}
+	}
+
+	return diags
+}
+
+// relatedAttrsSet returns the paths from the given group whose values in the
+// given object are known and not null, along with a flag that is true if any
+// of the values in the group are unknown.
+func relatedAttrsSet(val cty.Value, group []cty.Path) (set []cty.Path, unknown bool) {
+	for _, path := range group {
+		v := relativePathValue(val, path)
+		switch {
+		case !v.IsKnown():
+			unknown = true
+		case !v.IsNull():
+			set = append(set, path)
+		}
+	}
+	return set, unknown
+}
+
+// relativePathValue returns the value at the given path relative to the given
+// object value.
+//
+// Unlike cty.Path.Apply, it returns a null value if the path traverses through
+// a null value or a collection element that does not exist, and an unknown
+// value if the path traverses through an unknown value or a set, since the
+// presence of an element in a set cannot be determined from its path.
+func relativePathValue(val cty.Value, path cty.Path) cty.Value {
+	for _, rawStep := range path {
+		switch {
+		case !val.IsKnown():
+			return cty.DynamicVal
+		case val.IsNull():
+			return cty.NullVal(cty.DynamicPseudoType)
+		}
+
+		switch step := rawStep.(type) {
+		case cty.GetAttrStep:
+			ty := val.Type()
+			if !ty.IsObjectType() || !ty.HasAttribute(step.Name) {
+				return cty.NullVal(cty.DynamicPseudoType)
+			}
+			val = val.GetAttr(step.Name)
+		case cty.IndexStep:
+			ty := val.Type()
+			if ty.IsSetType() {
+				return cty.DynamicVal
+			}
+			if !(ty.IsListType() || ty.IsMapType() || ty.IsTupleType()) {
+				return cty.NullVal(cty.DynamicPseudoType)
+			}
+			has := val.HasIndex(step.Key)
+			if !has.IsKnown() {
+				return cty.DynamicVal
+			}
+			if has.False() {
+				return cty.NullVal(cty.DynamicPseudoType)
+			}
+			val = val.Index(step.Key)
+		}
+	}
+	return val
+}
+
+// otherPaths returns the paths from the given slice other than the given one.
+func otherPaths(paths []cty.Path, exclude cty.Path) []cty.Path {
+	ret := make([]cty.Path, 0, len(paths)-1)
+	for _, path := range paths {
+		if !path.Equals(exclude) {
+			ret = append(ret, path)
+		}
+	}
+	return ret
+}
+
+// relativePathString returns a quoted representation of the given relative
+// path for use in diagnostic messages.
+func relativePathString(path cty.Path) string {
+	return fmt.Sprintf("%q", strings.TrimPrefix(FormatPath(path), "."))
+}
+
+// relativePathsString returns a list of the given relative paths for use in
+// diagnostic messages, with the last two separated by the given conjunction.
+func relativePathsString(paths []cty.Path, conj string) string {
+	strs := make([]string, len(paths))
+	for i, path := range paths {
+		strs[i] = relativePathString(path)
+	}
+	switch len(strs) {
+	case 0:
+		return ""
+	case 1:
+		return strs[0]
+	case 2:
+		return strs[0] + " " + conj + " " + strs[1]
+	default:
+		return strings.Join(strs[:len(strs)-1], ", ") + ", " + conj + " " + strs[len(strs)-1]
+	}
+}
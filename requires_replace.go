@@ -0,0 +1,317 @@
+package tfsdk
+
+import (
+	"fmt"
+
+	"github.com/apparentlymart/terraform-sdk/internal/dynfunc"
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// addRequiresReplacePaths compares the given prior and planned objects,
+// which must both conform to the given schema, and adds to the given path
+// set the path of each changed attribute whose schema calls for replacement
+// via either RequiresReplace or RequiresReplaceIf.
+//
+// Attributes inside nested blocks are checked too. Elements of list blocks
+// are correlated by index and elements of map blocks by key. Elements of set
+// blocks are correlated as described for addSetRequiresReplacePaths. If a
+// block collection is unknown then its elements cannot be correlated at all,
+// so a change to it is reported against the block as a whole if its content
+// includes an attribute that might require replacement.
+func addRequiresReplacePaths(schema *tfschema.BlockType, prior, planned cty.Value, path cty.Path, paths cty.PathSet) Diagnostics {
+	var diags Diagnostics
+	if prior.RawEquals(planned) {
+		return diags
+	}
+	// A nested block that is being added or removed as a whole is treated
+	// as if all of its attributes were null.
+	if !prior.IsKnown() {
+		prior = schema.Null()
+	}
+	if !planned.IsKnown() {
+		planned = schema.Null()
+	}
+
+	for name, attrS := range schema.Attributes {
+		path := path.GetAttr(name)
+		priorV := cty.NullVal(attrS.ImpliedCtyType())
+		plannedV := cty.NullVal(attrS.ImpliedCtyType())
+		if !prior.IsNull() {
+			priorV = prior.GetAttr(name)
+		}
+		if !planned.IsNull() {
+			plannedV = planned.GetAttr(name)
+		}
+		if priorV.RawEquals(plannedV) {
+			continue
+		}
+
+		replace, moreDiags := attrRequiresReplace(attrS, priorV, plannedV)
+		diags = diags.Append(moreDiags.UnderPath(path))
+		if replace {
+			paths.Add(path)
+		}
+	}
+
+	for name, blockS := range schema.NestedBlockTypes {
+		path := path.GetAttr(name)
+		ty := blockS.Content.ImpliedCtyType()
+		var priorV, plannedV cty.Value
+		if prior.IsNull() {
+			priorV = cty.NullVal(schema.ImpliedCtyType().AttributeType(name))
+		} else {
+			priorV = prior.GetAttr(name)
+		}
+		if planned.IsNull() {
+			plannedV = cty.NullVal(schema.ImpliedCtyType().AttributeType(name))
+		} else {
+			plannedV = planned.GetAttr(name)
+		}
+		if priorV.RawEquals(plannedV) {
+			continue
+		}
+
+		switch blockS.Nesting {
+		case tfschema.NestingSingle, tfschema.NestingGroup:
+			diags = diags.Append(addRequiresReplacePaths(&blockS.Content, priorV, plannedV, path, paths))
+
+		case tfschema.NestingList, tfschema.NestingMap:
+			if !priorV.IsKnown() || !plannedV.IsKnown() {
+				if blockMightRequireReplace(&blockS.Content) {
+					paths.Add(path)
+				}
+				continue
+			}
+			// If the block content has any dynamically-typed attributes then
+			// the collection is a tuple or object rather than a list or map,
+			// but the elements can still be correlated in the same way.
+			for _, k := range collectionKeys(priorV, plannedV) {
+				priorEV := collectionElement(priorV, k, ty)
+				plannedEV := collectionElement(plannedV, k, ty)
+				path := path.Index(k)
+				diags = diags.Append(addRequiresReplacePaths(&blockS.Content, priorEV, plannedEV, path, paths))
+			}
+
+		case tfschema.NestingSet:
+			if !priorV.IsKnown() || !plannedV.IsKnown() {
+				if blockMightRequireReplace(&blockS.Content) {
+					paths.Add(path)
+				}
+				continue
+			}
+			diags = diags.Append(addSetRequiresReplacePaths(&blockS.Content, priorV, plannedV, path, paths))
+		}
+	}
+
+	return diags
+}
+
+// addSetRequiresReplacePaths is the part of addRequiresReplacePaths that deals
+// with the elements of the given known set block values.
+//
+// Set elements have no key, so elements that are present in both sets are
+// ignored and then each remaining element is correlated with the element of
+// the other set that has the same values for all of the attributes that are
+// marked as RequiresReplace, which therefore act as the identity of the
+// element. Correlated elements are compared in the same way as the elements
+// of a list block, so RequiresReplaceIf can decide whether a change to other
+// attributes requires replacement. An element that cannot be correlated,
+// either because there is no such element in the other set or because there
+// is more than one, is treated as having been added or removed.
+//
+// The plugin protocol cannot represent a path through a set element, so if
+// any element requires replacement then the path of the block as a whole is
+// added to the given path set. Diagnostics still identify the element, using
+// an index step whose key is the element value itself as Terraform Core does
+// for paths through sets, which is the planned element unless the element is
+// being removed. Such a path is truncated to the set itself when it is sent
+// to Terraform.
+func addSetRequiresReplacePaths(schema *tfschema.BlockType, prior, planned cty.Value, path cty.Path, paths cty.PathSet) Diagnostics {
+	var diags Diagnostics
+	elemPaths := cty.NewPathSet()
+
+	var priorEVs, plannedEVs []cty.Value
+	if !prior.IsNull() {
+		for it := prior.ElementIterator(); it.Next(); {
+			_, ev := it.Element()
+			priorEVs = append(priorEVs, ev)
+		}
+	}
+	if !planned.IsNull() {
+		for it := planned.ElementIterator(); it.Next(); {
+			_, ev := it.Element()
+			if !ev.IsKnown() {
+				// An unknown element can't be correlated with anything, and
+				// so it might be replacing any of the prior elements.
+				if blockMightRequireReplace(schema) {
+					elemPaths.Add(path)
+				}
+				continue
+			}
+			plannedEVs = append(plannedEVs, ev)
+		}
+	}
+	priorEVs, plannedEVs = withoutCommonValues(priorEVs, plannedEVs), withoutCommonValues(plannedEVs, priorEVs)
+
+	// Group the changed elements by their identity, so that we can find the
+	// groups that contain exactly one element from each set.
+	type setElems struct {
+		prior, planned []cty.Value
+	}
+	groups := make(map[string]*setElems)
+	var keys []string
+	group := func(ev cty.Value) *setElems {
+		// GoString gives a unique representation of the identity value.
+		k := setElementIdentity(schema, ev).GoString()
+		if _, exists := groups[k]; !exists {
+			groups[k] = &setElems{}
+			keys = append(keys, k)
+		}
+		return groups[k]
+	}
+	for _, ev := range priorEVs {
+		g := group(ev)
+		g.prior = append(g.prior, ev)
+	}
+	for _, ev := range plannedEVs {
+		g := group(ev)
+		g.planned = append(g.planned, ev)
+	}
+
+	for _, k := range keys {
+		g := groups[k]
+		if len(g.prior) == 1 && len(g.planned) == 1 {
+			diags = diags.Append(addRequiresReplacePaths(schema, g.prior[0], g.planned[0], path.Index(g.planned[0]), elemPaths))
+			continue
+		}
+		for _, ev := range g.prior {
+			diags = diags.Append(addRequiresReplacePaths(schema, ev, schema.Null(), path.Index(ev), elemPaths))
+		}
+		for _, ev := range g.planned {
+			diags = diags.Append(addRequiresReplacePaths(schema, schema.Null(), ev, path.Index(ev), elemPaths))
+		}
+	}
+
+	if !elemPaths.Empty() {
+		paths.Add(path)
+	}
+	return diags
+}
+
+// setElementIdentity returns an object containing the values of the
+// attributes of the given known set element that are marked as
+// RequiresReplace, which addSetRequiresReplacePaths uses to correlate
+// elements between the prior and planned sets.
+func setElementIdentity(schema *tfschema.BlockType, ev cty.Value) cty.Value {
+	attrs := make(map[string]cty.Value)
+	for name, attrS := range schema.Attributes {
+		if attrS.RequiresReplace {
+			attrs[name] = ev.GetAttr(name)
+		}
+	}
+	return cty.ObjectVal(attrs)
+}
+
+// attrRequiresReplace returns true if the given change to a value of the given
+// attribute requires replacement of the object it belongs to. The caller must
+// already have determined that the two values differ.
+func attrRequiresReplace(schema *tfschema.Attribute, prior, planned cty.Value) (bool, Diagnostics) {
+	var diags Diagnostics
+	if schema.RequiresReplace {
+		return true, diags
+	}
+	if schema.RequiresReplaceIf == nil {
+		return false, diags
+	}
+
+	var replace bool
+	fn, err := dynfunc.WrapFunctionWithReturnValue(schema.RequiresReplaceIf, &replace, prior, planned)
+	if err != nil {
+		diags = diags.Append(Diagnostic{
+			Severity: Error,
+			Summary:  "Invalid provider schema",
+			Detail:   fmt.Sprintf("Invalid RequiresReplaceIf: %s.\nThis is a bug in the provider that should be reported in its own issue tracker.", err),
+		})
+		return false, diags
+	}
+	diags = diags.Append(fn())
+	return replace, diags
+}
+
+// collectionKeys returns the union of the keys of the given known list or map
+// values, either of which may be null.
+func collectionKeys(a, b cty.Value) []cty.Value {
+	var ret []cty.Value
+	seen := make(map[string]struct{})
+	for _, v := range []cty.Value{a, b} {
+		if v.IsNull() {
+			continue
+		}
+		for it := v.ElementIterator(); it.Next(); {
+			k, _ := it.Element()
+			// GoString gives a unique representation of both the number
+			// keys of a list and the string keys of a map.
+			if _, exists := seen[k.GoString()]; exists {
+				continue
+			}
+			seen[k.GoString()] = struct{}{}
+			ret = append(ret, k)
+		}
+	}
+	return ret
+}
+
+// withoutCommonValues returns the values from a that are not also present in
+// b. Values are compared with RawEquals, so this is safe to use with values
+// that are only partially known.
+func withoutCommonValues(a, b []cty.Value) []cty.Value {
+	var ret []cty.Value
+Values:
+	for _, av := range a {
+		for _, bv := range b {
+			if av.RawEquals(bv) {
+				continue Values
+			}
+		}
+		ret = append(ret, av)
+	}
+	return ret
+}
+
+// collectionElement returns the element of the given known collection with
+// the given key, or a null value of the given element type if the collection
+// is null or has no such element. The collection may be a tuple or object, in
+// which case the key is as returned by ElementIterator.
+func collectionElement(v, k cty.Value, ty cty.Type) cty.Value {
+	if v.IsNull() {
+		return cty.NullVal(ty)
+	}
+	if v.Type().IsObjectType() {
+		name := k.AsString()
+		if !v.Type().HasAttribute(name) {
+			return cty.NullVal(ty)
+		}
+		return v.GetAttr(name)
+	}
+	if !v.HasIndex(k).True() {
+		return cty.NullVal(ty)
+	}
+	return v.Index(k)
+}
+
+// blockMightRequireReplace returns true if any attribute in the given block,
+// or in any block nested within it, might require replacement when changed.
+func blockMightRequireReplace(schema *tfschema.BlockType) bool {
+	for _, attrS := range schema.Attributes {
+		if attrS.RequiresReplace || attrS.RequiresReplaceIf != nil {
+			return true
+		}
+	}
+	for _, blockS := range schema.NestedBlockTypes {
+		if blockMightRequireReplace(&blockS.Content) {
+			return true
+		}
+	}
+	return false
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/apparentlymart/terraform-sdk/tfobj"
 	"github.com/apparentlymart/terraform-sdk/tfschema"
 	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
 )
 
 // ResourceTypeDef is the type that provider packages should instantiate to
@@ -20,10 +22,37 @@ import (
 // specific resource type kind has its own constraints on what can and must
 // be set in a ResourceTypeDef for that kind; see the resource type constructor
 // functions' documentation for more information.
+//
+// The functions for a managed resource type can pass the context they are
+// given to ResourcePrivateData in order to access the private data that
+// Terraform stores alongside the instance being operated on.
 type ResourceTypeDef struct {
 	ConfigSchema  *tfschema.BlockType
 	SchemaVersion int64 // Only used for managed resource types; leave as zero otherwise
 
+	// StateUpgraders can be set for managed resource types whose SchemaVersion
+	// is greater than zero, in order to describe how to upgrade objects that
+	// were stored in the Terraform state using earlier versions of the schema.
+	//
+	// Each map key is the schema version that the corresponding upgrader
+	// accepts, and the upgrader must produce an object conforming to the next
+	// schema version. The SDK calls the upgraders in sequence, starting at the
+	// version recorded in the state, until it reaches the current
+	// SchemaVersion. A stored object whose version has no upgrader, or which
+	// is newer than SchemaVersion, cannot be used with this provider version.
+	//
+	// Objects saved by providers written for Terraform 0.11 and earlier use a
+	// legacy "flatmap" format that can be decoded only with reference to a
+	// schema. To upgrade such objects, the upgrader for the stored version
+	// must have its Schema field set.
+	StateUpgraders map[int64]StateUpgrader
+
+	// DeprecationMessage, if non-empty, marks the resource type as deprecated.
+	// The resource type still works, but a warning including this message is
+	// returned whenever a configuration uses it. The message should explain
+	// what the user should do instead.
+	DeprecationMessage string
+
 	// CreateFn is a function called when creating an instance of your resource
 	// type for the first time. It must be a function compatible with the
 	// following signature:
@@ -79,6 +108,67 @@ type ResourceTypeDef struct {
 	// change and return errors or warnings early, rather than waiting until
 	// the apply step.
 	PlanFn interface{}
+
+	// ImportFn can be set for managed resource types in order to support
+	// importing existing remote objects into Terraform using an ID string
+	// provided by the user. It must be a function compatible with the
+	// following signature:
+	//
+	//     func (ctx context.Context, client interface{}, id string) (objs []tfsdk.ImportedObject, diags tfsdk.Diagnostics)
+	//
+	// The returned objects need only be populated enough for ReadFn to locate
+	// the corresponding remote objects, because Terraform will refresh each of
+	// them before saving them in the state. A single import may produce
+	// objects of several different resource types, as described in the
+	// documentation for ImportedObject.
+	//
+	// If ImportFn is not set, the resource type does not support import.
+	ImportFn interface{}
+}
+
+// ImportedObject is the type used to describe each of the objects produced by
+// a managed resource type's ImportFn.
+type ImportedObject struct {
+	// TypeName is the name of the managed resource type that the object
+	// belongs to. Leave this empty to indicate the resource type that the
+	// import was requested for. Any other name must be a managed resource type
+	// belonging to the same provider.
+	TypeName string
+
+	// Object is the imported object itself, which may be either a cty.Value
+	// or a Go value that can be converted to the resource type's schema using
+	// package gocty.
+	Object interface{}
+
+	// Private is optional private data to store alongside the imported
+	// object. It is visible to ReadFn when Terraform refreshes the object
+	// after import. See ResourcePrivateData for more information.
+	Private *PrivateData
+}
+
+// StateUpgrader describes how to upgrade a stored object from one schema
+// version to the next. See ResourceTypeDef.StateUpgraders.
+type StateUpgrader struct {
+	// Schema, if set, is the schema that objects had at the version this
+	// upgrader accepts. When it is set, the SDK decodes the stored object
+	// using this schema before passing it to UpgradeFn, which must then be a
+	// function compatible with the following signature:
+	//
+	//     func (old tfobj.ObjectReader) (new cty.Value, diags tfsdk.Diagnostics)
+	//
+	// If Schema is not set then UpgradeFn works directly with the JSON
+	// serialization of the stored object, and must be compatible with the
+	// following signature instead:
+	//
+	//     func (oldJSON []byte) (newJSON []byte, diags tfsdk.Diagnostics)
+	//
+	// As with other resource type functions, the old object may instead be
+	// decoded into a Go struct type using package gocty. The new object may
+	// be returned as a Go value too, as long as the schema of the next
+	// version is known, either from the next upgrader's Schema or from the
+	// resource type's own ConfigSchema if this is the final upgrade step.
+	Schema    *tfschema.BlockType
+	UpgradeFn interface{}
 }
 
 // NewManagedResourceType prepares a ManagedResourceType implementation using
@@ -101,17 +191,27 @@ func NewManagedResourceType(def *ResourceTypeDef) ManagedResourceType {
 		readFn = defaultReadFn
 	}
 
+	for version := range def.StateUpgraders {
+		if version < 0 || version >= def.SchemaVersion {
+			panic(fmt.Sprintf("NewManagedResourceType has state upgrader for version %d, but SchemaVersion is %d", version, def.SchemaVersion))
+		}
+	}
+
 	// TODO: Check thoroughly to make sure def is correctly populated for a
 	// managed resource type, so we can panic early.
 
 	return managedResourceType{
-		configSchema: schema,
+		configSchema:   schema,
+		schemaVersion:  def.SchemaVersion,
+		stateUpgraders: def.StateUpgraders,
+		deprecation:    def.DeprecationMessage,
 
 		createFn: def.CreateFn,
 		readFn:   readFn,
 		updateFn: def.UpdateFn,
 		deleteFn: def.DeleteFn,
 		planFn:   def.PlanFn,
+		importFn: def.ImportFn,
 	}
 }
 
@@ -143,28 +243,246 @@ func NewDataResourceType(def *ResourceTypeDef) DataResourceType {
 
 	return dataResourceType{
 		configSchema: schema,
+		deprecation:  def.DeprecationMessage,
 		readFn:       readFn,
 	}
 }
 
 type managedResourceType struct {
-	configSchema  *tfschema.BlockType
-	schemaVersion int64
+	configSchema   *tfschema.BlockType
+	schemaVersion  int64
+	stateUpgraders map[int64]StateUpgrader
+	deprecation    string
 
 	createFn, readFn, updateFn, deleteFn interface{}
-	planFn                               interface{}
+	planFn, importFn                     interface{}
 }
 
 func (rt managedResourceType) getSchema() (schema *tfschema.BlockType, version int64) {
 	return rt.configSchema, rt.schemaVersion
 }
 
+func (rt managedResourceType) deprecationMessage() string {
+	return rt.deprecation
+}
+
 func (rt managedResourceType) validate(obj cty.Value) Diagnostics {
-	return ValidateBlockObject(rt.configSchema, obj)
+	var diags Diagnostics
+	diags = diags.Append(resourceTypeDeprecationWarning(rt.deprecation))
+	diags = diags.Append(ValidateBlockObject(rt.configSchema, obj))
+	return diags
 }
 
-func (rt managedResourceType) upgradeState(oldJSON []byte, oldVersion int) (cty.Value, Diagnostics) {
-	return cty.NilVal, nil
+func (rt managedResourceType) upgradeState(oldJSON []byte, oldFlatmap map[string]string, oldVersion int64) (cty.Value, Diagnostics) {
+	var diags Diagnostics
+	wantTy := rt.configSchema.ImpliedCtyType()
+
+	if oldVersion > rt.schemaVersion {
+		diags = diags.Append(Diagnostic{
+			Severity: Error,
+			Summary:  "Unsupported resource instance state",
+			Detail:   fmt.Sprintf("This object was saved by a newer version of the provider, using schema version %d. This version of the provider supports schema versions only up to %d. Upgrade the provider to work with this object.", oldVersion, rt.schemaVersion),
+		})
+		return rt.configSchema.Null(), diags
+	}
+
+	// As we work through the chain of upgraders, the object is represented
+	// either as raw JSON or as a cty.Value, depending on what the most
+	// recent upgrader produced. Exactly one of these is set at a time.
+	// Legacy flatmap states are always decoded into a cty.Value first.
+	currentJSON := oldJSON
+	currentVal := cty.NilVal
+
+	if oldFlatmap != nil {
+		// The legacy flatmap format can only be decoded with reference to a
+		// schema, so we need to know the schema for the stored version.
+		var oldSchema *tfschema.BlockType
+		if oldVersion == rt.schemaVersion {
+			oldSchema = rt.configSchema
+		} else if upgrader, ok := rt.stateUpgraders[oldVersion]; ok {
+			oldSchema = upgrader.Schema
+		}
+		if oldSchema == nil {
+			diags = diags.Append(Diagnostic{
+				Severity: Error,
+				Summary:  "Unsupported resource instance state",
+				Detail:   fmt.Sprintf("This object was saved in a legacy format by an older version of the provider, using schema version %d. This version of the provider cannot upgrade objects in that format from that schema version.", oldVersion),
+			})
+			return rt.configSchema.Null(), diags
+		}
+
+		var err error
+		currentVal, err = decodeFlatmapObject(oldFlatmap, oldSchema)
+		if err != nil {
+			var path cty.Path
+			if pErr, ok := err.(cty.PathError); ok {
+				path = pErr.Path
+			}
+			diags = diags.Append(Diagnostic{
+				Severity: Error,
+				Summary:  "Invalid resource instance state",
+				Detail:   fmt.Sprintf("The stored object is not valid for schema version %d: %s.", oldVersion, FormatError(err)),
+				Path:     path,
+			})
+			return rt.configSchema.Null(), diags
+		}
+		currentJSON = nil
+	}
+
+	for version := oldVersion; version < rt.schemaVersion; version++ {
+		upgrader, ok := rt.stateUpgraders[version]
+		if !ok {
+			diags = diags.Append(Diagnostic{
+				Severity: Error,
+				Summary:  "Unsupported resource instance state",
+				Detail:   fmt.Sprintf("This object was saved by an older version of the provider, using schema version %d. This version of the provider cannot upgrade objects from that schema version.", version),
+			})
+			return rt.configSchema.Null(), diags
+		}
+		nextTy := rt.upgradeResultType(version)
+
+		if upgrader.Schema != nil {
+			var moreDiags Diagnostics
+			oldTy := upgrader.Schema.ImpliedCtyType()
+			if currentVal == cty.NilVal {
+				currentVal, moreDiags = decodeUpgradeStateJSON(currentJSON, oldTy, version)
+			} else {
+				currentVal, moreDiags = convertUpgradeStateValue(currentVal, oldTy, version)
+			}
+			diags = diags.Append(moreDiags)
+			if diags.HasErrors() {
+				return rt.configSchema.Null(), diags
+			}
+
+			oldReader := tfobj.NewObjectReader(upgrader.Schema, currentVal)
+			fn, err := dynfunc.WrapFunctionWithReturnValueCty(upgrader.UpgradeFn, nextTy, oldReader)
+			if err != nil {
+				diags = diags.Append(Diagnostic{
+					Severity: Error,
+					Summary:  "Invalid provider implementation",
+					Detail:   fmt.Sprintf("Invalid UpgradeFn for schema version %d: %s.\nThis is a bug in the provider that should be reported in its own issue tracker.", version, err),
+				})
+				return rt.configSchema.Null(), diags
+			}
+			currentVal, moreDiags = fn()
+			currentJSON = nil
+			diags = diags.Append(moreDiags)
+		} else {
+			if currentVal != cty.NilVal {
+				var err error
+				currentJSON, err = ctyjson.Marshal(currentVal, currentVal.Type())
+				if err != nil {
+					diags = diags.Append(Diagnostic{
+						Severity: Error,
+						Summary:  "Invalid result from provider",
+						Detail:   fmt.Sprintf("The object for schema version %d could not be serialized as JSON: %s.\n\nThis is a bug in the provider; please report it in the provider's issue tracker.", version, FormatError(err)),
+					})
+					return rt.configSchema.Null(), diags
+				}
+				currentVal = cty.NilVal
+			}
+
+			var newJSON []byte
+			fn, err := dynfunc.WrapFunctionWithReturnValue(upgrader.UpgradeFn, &newJSON, currentJSON)
+			if err != nil {
+				diags = diags.Append(Diagnostic{
+					Severity: Error,
+					Summary:  "Invalid provider implementation",
+					Detail:   fmt.Sprintf("Invalid UpgradeFn for schema version %d: %s.\nThis is a bug in the provider that should be reported in its own issue tracker.", version, err),
+				})
+				return rt.configSchema.Null(), diags
+			}
+			moreDiags := fn()
+			currentJSON = newJSON
+			diags = diags.Append(moreDiags)
+		}
+
+		if diags.HasErrors() {
+			return rt.configSchema.Null(), diags
+		}
+	}
+
+	var moreDiags Diagnostics
+	var newVal cty.Value
+	if currentVal == cty.NilVal {
+		newVal, moreDiags = decodeUpgradeStateJSON(currentJSON, wantTy, rt.schemaVersion)
+	} else {
+		newVal, moreDiags = convertUpgradeStateValue(currentVal, wantTy, rt.schemaVersion)
+	}
+	diags = diags.Append(moreDiags)
+	if diags.HasErrors() {
+		return rt.configSchema.Null(), diags
+	}
+	return newVal, diags
+}
+
+// upgradeResultType returns the type that the upgrader for the given version
+// is expected to produce, which is the implied type of the schema for the
+// following version if that is known, or cty.DynamicPseudoType otherwise.
+func (rt managedResourceType) upgradeResultType(version int64) cty.Type {
+	next := version + 1
+	if next == rt.schemaVersion {
+		return rt.configSchema.ImpliedCtyType()
+	}
+	if upgrader, ok := rt.stateUpgraders[next]; ok && upgrader.Schema != nil {
+		return upgrader.Schema.ImpliedCtyType()
+	}
+	return cty.DynamicPseudoType
+}
+
+// decodeUpgradeStateJSON decodes a JSON representation of an object that is
+// expected to conform to the given type, which is the type of the given
+// schema version.
+//
+// If the given version is not the oldest in the upgrade chain then the JSON
+// was produced by the previous upgrader, so errors here are reported as
+// provider bugs.
+func decodeUpgradeStateJSON(src []byte, wantTy cty.Type, version int64) (cty.Value, Diagnostics) {
+	var diags Diagnostics
+	ret, err := ctyjson.Unmarshal(src, wantTy)
+	if err != nil {
+		var path cty.Path
+		if pErr, ok := err.(cty.PathError); ok {
+			path = pErr.Path
+		}
+		diags = diags.Append(Diagnostic{
+			Severity: Error,
+			Summary:  "Invalid resource instance state",
+			Detail:   fmt.Sprintf("The stored object is not valid for schema version %d: %s.\n\nIf the object was just upgraded from an earlier schema version, this is a bug in the provider; please report it in the provider's issue tracker.", version, FormatError(err)),
+			Path:     path,
+		})
+		return cty.NullVal(wantTy), diags
+	}
+	return ret, diags
+}
+
+// convertUpgradeStateValue converts a value produced by a state upgrader
+// to the type of the given schema version.
+func convertUpgradeStateValue(val cty.Value, wantTy cty.Type, version int64) (cty.Value, Diagnostics) {
+	var diags Diagnostics
+	if val.IsNull() || !val.IsWhollyKnown() {
+		diags = diags.Append(Diagnostic{
+			Severity: Error,
+			Summary:  "Invalid result from provider",
+			Detail:   fmt.Sprintf("The provider produced a null or unknown object while upgrading to schema version %d.\n\nThis is a bug in the provider; please report it in the provider's issue tracker.", version),
+		})
+		return cty.NullVal(wantTy), diags
+	}
+	ret, err := convert.Convert(val, wantTy)
+	if err != nil {
+		var path cty.Path
+		if pErr, ok := err.(cty.PathError); ok {
+			path = pErr.Path
+		}
+		diags = diags.Append(Diagnostic{
+			Severity: Error,
+			Summary:  "Invalid result from provider",
+			Detail:   fmt.Sprintf("The provider produced an invalid object while upgrading to schema version %d: %s.\n\nThis is a bug in the provider; please report it in the provider's issue tracker.", version, FormatError(err)),
+			Path:     path,
+		})
+		return cty.NullVal(wantTy), diags
+	}
+	return ret, diags
 }
 
 func (rt managedResourceType) refresh(ctx context.Context, client interface{}, current cty.Value) (cty.Value, Diagnostics) {
@@ -195,25 +513,61 @@ func (rt managedResourceType) refresh(ctx context.Context, client interface{}, c
 		newVal = cty.UnknownVal(wantTy)
 	}
 
+	// If the remote object has only changed in ways that the schema
+	// considers to be semantically insignificant then we'll preserve the
+	// current values to avoid reporting spurious drift.
+	if !diags.HasErrors() && len(newVal.Type().TestConformance(wantTy)) == 0 {
+		newVal, moreDiags = keepSemanticallyEqual(rt.configSchema, current, newVal, nil)
+		diags = diags.Append(moreDiags)
+	}
+
 	return newVal, diags
 }
 
 func (rt managedResourceType) planChange(ctx context.Context, client interface{}, prior, config, proposed cty.Value) (cty.Value, cty.PathSet, Diagnostics) {
-	var diags Diagnostics
 	requiresReplace := cty.NewPathSet()
 	wantTy := rt.configSchema.ImpliedCtyType()
 
 	// Terraform Core has already done a lot of the work in merging prior with
 	// config to produce "proposed". Our main job here is inserting any additional
 	// default values called for in the provider schema.
-	planned := rt.configSchema.ApplyDefaults(proposed)
+	planned, diags := applyDefaults(rt.configSchema, proposed)
+	if diags.HasErrors() {
+		return rt.configSchema.Null(), requiresReplace, diags
+	}
+
+	// If any arguments have changed only in ways that the schema considers
+	// semantically insignificant then we'll keep their prior values, so that
+	// no change is planned for them.
+	planned, moreDiags := keepSemanticallyEqual(rt.configSchema, prior, planned, nil)
+	diags = diags.Append(moreDiags)
+	if diags.HasErrors() {
+		return rt.configSchema.Null(), requiresReplace, diags
+	}
+
+	// The attributes' own plan modifiers get the first opportunity to adjust
+	// the plan. The PlanFn, if any, then continues with the same PlanBuilder
+	// so that it can see any replacement paths the modifiers recorded.
+	var planBuilder tfobj.PlanBuilder
+	if !planned.IsNull() && blockHasPlanModifiers(rt.configSchema) {
+		planBuilder = tfobj.NewPlanBuilder(rt.configSchema, prior, config, planned)
+		diags = diags.Append(applyPlanModifiers(planBuilder))
+		if diags.HasErrors() {
+			return rt.configSchema.Null(), requiresReplace, diags
+		}
+		planned = planBuilder.ObjectVal()
+		requiresReplace = planBuilder.RequiresReplace()
+	}
 
-	if !planned.RawEquals(prior) {
+	if rt.planFn != nil && !planned.RawEquals(prior) {
 		// If there are already changes planned then the provider code gets
 		// an opportunity to refine the changeset in case there are any
 		// side-effects of the configuration change that could affect any
-		// pre-existing computed attribute values.
-		planBuilder := tfobj.NewPlanBuilder(rt.configSchema, prior, config, planned)
+		// pre-existing computed attribute values. If there's no PlanFn at
+		// all then the proposed object (with defaults) is our plan.
+		if planBuilder == nil {
+			planBuilder = tfobj.NewPlanBuilder(rt.configSchema, prior, config, planned)
+		}
 		fn, err := dynfunc.WrapFunctionWithReturnValueCtyAndPathSet(rt.planFn, wantTy, ctx, client, planBuilder)
 		if err != nil {
 			diags = diags.Append(Diagnostic{
@@ -224,7 +578,6 @@ func (rt managedResourceType) planChange(ctx context.Context, client interface{}
 			return rt.configSchema.Null(), requiresReplace, diags
 		}
 
-		var moreDiags Diagnostics
 		planned, requiresReplace, moreDiags = fn()
 		diags = diags.Append(moreDiags)
 
@@ -239,6 +592,19 @@ func (rt managedResourceType) planChange(ctx context.Context, client interface{}
 		}
 	}
 
+	// Replacement only makes sense for an update, and we can only compare
+	// the attributes if the PlanFn returned a valid object. If it didn't
+	// then the caller will report that.
+	isUpdate := !prior.IsNull() && !planned.IsNull() && planned.IsKnown()
+	if isUpdate && !diags.HasErrors() && len(planned.Type().TestConformance(wantTy)) == 0 {
+		if requiresReplace.Empty() {
+			// The PlanFn might have returned a zero-value PathSet, which
+			// isn't ready to use.
+			requiresReplace = cty.NewPathSet()
+		}
+		diags = diags.Append(addRequiresReplacePaths(rt.configSchema, prior, planned, nil, requiresReplace))
+	}
+
 	return planned, requiresReplace, diags
 }
 
@@ -247,24 +613,23 @@ func (rt managedResourceType) applyChange(ctx context.Context, client interface{
 	wantTy := rt.configSchema.ImpliedCtyType()
 
 	// The planned object will contain unknown values for anything that is to
-	// be determined during the apply step, but we'll replace these with nulls
-	// before calling the provider's operation implementation functions so that
-	// they can easily use gocty to work with the whole object and not get
-	// tripped up with dealing with those unknown values.
+	// be determined during the apply step. We replace these with nulls in the
+	// ObjectReader and PlanReader we pass to the provider's operation
+	// implementation functions, so that they can easily work with the whole
+	// object without getting tripped up by those unknown values.
 	//
-	// FIXME: This is a bit unfortunate because it means that the apply functions
-	// can't easily tell the difference between something that was returned as
-	// explicitly null in the plan vs. being unknown, but we're accepting that
-	// for now because it seems unlikely that such a distinction would ever
-	// matter in practice: the plan logic should just be consistent about whether
-	// a particular attribute becomes unknown when it's unset. We might need to
-	// do something better here if real-world experience indicates otherwise.
+	// Functions that instead decode the object into their own types still
+	// receive the unknown values, and so can distinguish null from unknown by
+	// using the unknown-aware types from package tfobj, such as tfobj.String.
+	// Other Go types cannot represent unknown values, so these are decoded
+	// as if they were null.
 	//
-	// This will also cause set values that differ only by being unknown to
-	// be conflated together, but we're ignoring that here because we want to
-	// phase out the idea of set-backed blocks with unknown attributes inside:
-	// they cause too much ambiguity in our diffing logic.
-	planned = cty.UnknownAsNull(planned)
+	// Replacing unknown values with nulls will also cause set values that
+	// differ only by being unknown to be conflated together, but we're
+	// ignoring that here because we want to phase out the idea of set-backed
+	// blocks with unknown attributes inside: they cause too much ambiguity in
+	// our diffing logic.
+	plannedKnown := cty.UnknownAsNull(planned)
 
 	// We could actually be doing either a Create, an Update, or a Delete here
 	// depending on the null-ness of the values we've been given. At least one
@@ -274,8 +639,9 @@ func (rt managedResourceType) applyChange(ctx context.Context, client interface{
 	var errMsg string
 	switch {
 	case prior.IsNull():
-		plannedReader := tfobj.NewObjectReader(rt.configSchema, planned)
-		fn, err = dynfunc.WrapFunctionWithReturnValueCty(rt.createFn, wantTy, ctx, client, plannedReader)
+		plannedReader := tfobj.NewObjectReader(rt.configSchema, plannedKnown)
+		plannedArg := dynfunc.ObjectArg{Reader: plannedReader, Value: planned}
+		fn, err = dynfunc.WrapFunctionWithReturnValueCty(rt.createFn, wantTy, ctx, client, plannedArg)
 		if err != nil {
 			errMsg = fmt.Sprintf("Invalid CreateFn: %s.\nThis is a bug in the provider that should be reported in its own issue tracker.", err)
 		}
@@ -287,8 +653,9 @@ func (rt managedResourceType) applyChange(ctx context.Context, client interface{
 		}
 	default:
 		priorReader := tfobj.NewObjectReader(rt.configSchema, prior)
-		plannedReader := tfobj.NewPlanReader(rt.configSchema, prior, planned)
-		fn, err = dynfunc.WrapFunctionWithReturnValueCty(rt.updateFn, wantTy, ctx, client, priorReader, plannedReader)
+		plannedReader := tfobj.NewPlanReader(rt.configSchema, prior, plannedKnown)
+		plannedArg := dynfunc.ObjectArg{Reader: plannedReader, Value: planned}
+		fn, err = dynfunc.WrapFunctionWithReturnValueCty(rt.updateFn, wantTy, ctx, client, priorReader, plannedArg)
 		if err != nil {
 			errMsg = fmt.Sprintf("Invalid UpdateFn: %s.\nThis is a bug in the provider that should be reported in its own issue tracker.", err)
 		}
@@ -315,15 +682,47 @@ func (rt managedResourceType) applyChange(ctx context.Context, client interface{
 		newVal = cty.UnknownVal(wantTy)
 	}
 
+	// Terraform Core requires the new object to match any known values in
+	// the plan, so we'll preserve the planned values for any attributes
+	// that the provider returned in a semantically-equivalent form.
+	if !diags.HasErrors() && len(newVal.Type().TestConformance(wantTy)) == 0 {
+		newVal, moreDiags = keepSemanticallyEqual(rt.configSchema, planned, newVal, nil)
+		diags = diags.Append(moreDiags)
+	}
+
 	return newVal, diags
 }
 
-func (rt managedResourceType) importState(ctx context.Context, client interface{}, id string) (cty.Value, Diagnostics) {
-	return cty.NilVal, nil
+func (rt managedResourceType) importState(ctx context.Context, client interface{}, id string) ([]ImportedObject, Diagnostics) {
+	var diags Diagnostics
+	if rt.importFn == nil {
+		diags = diags.Append(Diagnostic{
+			Severity: Error,
+			Summary:  "Resource type not importable",
+			Detail:   "This resource type does not support importing existing remote objects.",
+		})
+		return nil, diags
+	}
+
+	var objs []ImportedObject
+	fn, err := dynfunc.WrapFunctionWithReturnValue(rt.importFn, &objs, ctx, client, id)
+	if err != nil {
+		diags = diags.Append(Diagnostic{
+			Severity: Error,
+			Summary:  "Invalid provider implementation",
+			Detail:   fmt.Sprintf("Invalid ImportFn: %s.\nThis is a bug in the provider that should be reported in its own issue tracker.", err),
+		})
+		return nil, diags
+	}
+
+	moreDiags := fn()
+	diags = diags.Append(moreDiags)
+	return objs, diags
 }
 
 type dataResourceType struct {
 	configSchema *tfschema.BlockType
+	deprecation  string
 
 	readFn interface{}
 }
@@ -332,8 +731,29 @@ func (rt dataResourceType) getSchema() *tfschema.BlockType {
 	return rt.configSchema
 }
 
+func (rt dataResourceType) deprecationMessage() string {
+	return rt.deprecation
+}
+
 func (rt dataResourceType) validate(obj cty.Value) Diagnostics {
-	return ValidateBlockObject(rt.configSchema, obj)
+	var diags Diagnostics
+	diags = diags.Append(resourceTypeDeprecationWarning(rt.deprecation))
+	diags = diags.Append(ValidateBlockObject(rt.configSchema, obj))
+	return diags
+}
+
+// resourceTypeDeprecationWarning returns a warning diagnostic with the given
+// deprecation message, or no diagnostics at all if the message is empty.
+func resourceTypeDeprecationWarning(msg string) Diagnostics {
+	var diags Diagnostics
+	if msg != "" {
+		diags = diags.Append(Diagnostic{
+			Severity: Warning,
+			Summary:  "Deprecated resource type",
+			Detail:   msg,
+		})
+	}
+	return diags
 }
 
 func (rt dataResourceType) read(ctx context.Context, client interface{}, config cty.Value) (cty.Value, Diagnostics) {
@@ -0,0 +1,693 @@
+package tfsdk
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/apparentlymart/terraform-sdk/tfobj"
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestManagedResourceTypeUpgradeState(t *testing.T) {
+	rt := NewManagedResourceType(&ResourceTypeDef{
+		ConfigSchema: &tfschema.BlockType{
+			Attributes: map[string]*tfschema.Attribute{
+				"name":  {Type: cty.String, Required: true},
+				"count": {Type: cty.Number, Optional: true},
+			},
+		},
+		SchemaVersion: 2,
+		StateUpgraders: map[int64]StateUpgrader{
+			// Version 0 called the "name" attribute "title" instead.
+			0: {
+				UpgradeFn: func(oldJSON []byte) ([]byte, Diagnostics) {
+					return []byte(strings.Replace(string(oldJSON), `"title"`, `"name"`, 1)), nil
+				},
+			},
+			// Version 1 stored "count" as a string.
+			1: {
+				Schema: &tfschema.BlockType{
+					Attributes: map[string]*tfschema.Attribute{
+						"name":  {Type: cty.String, Required: true},
+						"count": {Type: cty.String, Optional: true},
+					},
+				},
+				UpgradeFn: func(old tfobj.ObjectReader) (cty.Value, Diagnostics) {
+					return cty.ObjectVal(map[string]cty.Value{
+						"name":  old.Attr("name"),
+						"count": cty.NumberIntVal(int64(len(old.Attr("count").AsString()))),
+					}), nil
+				},
+			},
+		},
+	})
+
+	t.Run("from version 0", func(t *testing.T) {
+		got, diags := rt.upgradeState([]byte(`{"title":"foo","count":"abc"}`), nil, 0)
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors: %#v", diags)
+		}
+		want := cty.ObjectVal(map[string]cty.Value{
+			"name":  cty.StringVal("foo"),
+			"count": cty.NumberIntVal(3),
+		})
+		if !want.RawEquals(got) {
+			t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, want)
+		}
+	})
+	t.Run("from version 1", func(t *testing.T) {
+		got, diags := rt.upgradeState([]byte(`{"name":"foo","count":"ab"}`), nil, 1)
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors: %#v", diags)
+		}
+		want := cty.ObjectVal(map[string]cty.Value{
+			"name":  cty.StringVal("foo"),
+			"count": cty.NumberIntVal(2),
+		})
+		if !want.RawEquals(got) {
+			t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, want)
+		}
+	})
+	t.Run("current version", func(t *testing.T) {
+		got, diags := rt.upgradeState([]byte(`{"name":"foo","count":5}`), nil, 2)
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors: %#v", diags)
+		}
+		want := cty.ObjectVal(map[string]cty.Value{
+			"name":  cty.StringVal("foo"),
+			"count": cty.NumberIntVal(5),
+		})
+		if !want.RawEquals(got) {
+			t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, want)
+		}
+	})
+	t.Run("from version 1 legacy flatmap", func(t *testing.T) {
+		got, diags := rt.upgradeState(nil, map[string]string{
+			"id":    "ignored",
+			"name":  "foo",
+			"count": "abcd",
+		}, 1)
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors: %#v", diags)
+		}
+		want := cty.ObjectVal(map[string]cty.Value{
+			"name":  cty.StringVal("foo"),
+			"count": cty.NumberIntVal(4),
+		})
+		if !want.RawEquals(got) {
+			t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, want)
+		}
+	})
+	t.Run("from version 0 legacy flatmap", func(t *testing.T) {
+		// Version 0 has no schema, so we can't decode flatmap for it.
+		_, diags := rt.upgradeState(nil, map[string]string{
+			"title": "foo",
+		}, 0)
+		if !diags.HasErrors() {
+			t.Fatalf("unexpected success")
+		}
+	})
+	t.Run("newer version", func(t *testing.T) {
+		_, diags := rt.upgradeState([]byte(`{"name":"foo","count":5}`), nil, 3)
+		if !diags.HasErrors() {
+			t.Fatalf("unexpected success")
+		}
+	})
+}
+
+func TestManagedResourceTypePlanChangeRequiresReplace(t *testing.T) {
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"name": {Type: cty.String, Required: true, RequiresReplace: true},
+			"size": {
+				Type:     cty.Number,
+				Optional: true,
+				// Growing is possible in-place, but shrinking is not.
+				RequiresReplaceIf: func(prior, planned cty.Value) (bool, Diagnostics) {
+					if prior.IsNull() || planned.IsNull() || !planned.IsKnown() {
+						return true, nil
+					}
+					return planned.LessThan(prior).True(), nil
+				},
+			},
+		},
+		NestedBlockTypes: map[string]*tfschema.NestedBlockType{
+			"disk": {
+				Nesting: tfschema.NestingList,
+				Content: tfschema.BlockType{
+					Attributes: map[string]*tfschema.Attribute{
+						"label": {Type: cty.String, Required: true, RequiresReplace: true},
+						"notes": {Type: cty.String, Optional: true},
+					},
+				},
+			},
+		},
+	}
+	rt := NewManagedResourceType(&ResourceTypeDef{
+		ConfigSchema: schema,
+	})
+	disk := func(label, notes string) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"label": cty.StringVal(label),
+			"notes": cty.StringVal(notes),
+		})
+	}
+	obj := func(name string, size int64, disks ...cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"name": cty.StringVal(name),
+			"size": cty.NumberIntVal(size),
+			"disk": cty.ListVal(disks),
+		})
+	}
+	prior := obj("a", 2, disk("root", ""))
+
+	tests := map[string]struct {
+		config cty.Value
+		want   []cty.Path
+	}{
+		"no change": {
+			obj("a", 2, disk("root", "")),
+			nil,
+		},
+		"name changed": {
+			obj("b", 2, disk("root", "")),
+			[]cty.Path{cty.GetAttrPath("name")},
+		},
+		"size grown": {
+			obj("a", 3, disk("root", "")),
+			nil,
+		},
+		"size shrunk": {
+			obj("a", 1, disk("root", "")),
+			[]cty.Path{cty.GetAttrPath("size")},
+		},
+		"nested notes changed": {
+			obj("a", 2, disk("root", "hello")),
+			nil,
+		},
+		"nested label changed": {
+			obj("a", 2, disk("boot", "")),
+			[]cty.Path{cty.GetAttrPath("disk").Index(cty.NumberIntVal(0)).GetAttr("label")},
+		},
+		"nested block added": {
+			obj("a", 2, disk("root", ""), disk("data", "")),
+			[]cty.Path{cty.GetAttrPath("disk").Index(cty.NumberIntVal(1)).GetAttr("label")},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, got, diags := rt.planChange(context.Background(), nil, prior, test.config, test.config)
+			if diags.HasErrors() {
+				t.Fatalf("unexpected errors: %#v", diags)
+			}
+			if got, want := len(got.List()), len(test.want); got != want {
+				t.Fatalf("wrong number of paths %d; want %d\n%#v", got, want, test.want)
+			}
+			for _, path := range test.want {
+				if !got.Has(path) {
+					t.Errorf("missing path %#v", path)
+				}
+			}
+		})
+	}
+}
+
+func TestManagedResourceTypePlanChangeRequiresReplaceSet(t *testing.T) {
+	schema := &tfschema.BlockType{
+		NestedBlockTypes: map[string]*tfschema.NestedBlockType{
+			"rule": {
+				Nesting: tfschema.NestingSet,
+				Content: tfschema.BlockType{
+					Attributes: map[string]*tfschema.Attribute{
+						"port": {Type: cty.Number, Required: true, RequiresReplace: true},
+						"protocol": {
+							Type:     cty.String,
+							Required: true,
+							// Only the letter case can be changed in-place.
+							RequiresReplaceIf: func(prior, planned cty.Value) (bool, Diagnostics) {
+								if prior.IsNull() || planned.IsNull() {
+									return false, nil
+								}
+								return !strings.EqualFold(prior.AsString(), planned.AsString()), nil
+							},
+						},
+						"notes": {Type: cty.String, Optional: true},
+					},
+				},
+			},
+		},
+	}
+	rt := NewManagedResourceType(&ResourceTypeDef{
+		ConfigSchema: schema,
+	})
+	rule := func(port int64, protocol, notes string) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"port":     cty.NumberIntVal(port),
+			"protocol": cty.StringVal(protocol),
+			"notes":    cty.StringVal(notes),
+		})
+	}
+	obj := func(rules ...cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"rule": cty.SetVal(rules),
+		})
+	}
+	// Paths through set elements can't be sent to Terraform, so replacement
+	// is always reported against the set block as a whole.
+	rulePath := cty.GetAttrPath("rule")
+	unknownPortRule := cty.ObjectVal(map[string]cty.Value{
+		"port":     cty.UnknownVal(cty.Number),
+		"protocol": cty.StringVal("tcp"),
+		"notes":    cty.StringVal(""),
+	})
+	prior := obj(rule(80, "tcp", ""), rule(53, "udp", ""))
+
+	tests := map[string]struct {
+		config cty.Value
+		want   []cty.Path
+	}{
+		"no change": {
+			obj(rule(80, "tcp", ""), rule(53, "udp", "")),
+			nil,
+		},
+		"notes changed": {
+			obj(rule(80, "tcp", "web"), rule(53, "udp", "")),
+			nil,
+		},
+		"protocol case changed": {
+			obj(rule(80, "TCP", ""), rule(53, "udp", "")),
+			nil,
+		},
+		"protocol changed": {
+			obj(rule(80, "udp", ""), rule(53, "udp", "")),
+			[]cty.Path{rulePath},
+		},
+		"port changed": {
+			obj(rule(8080, "tcp", ""), rule(53, "udp", "")),
+			[]cty.Path{rulePath},
+		},
+		"rule added": {
+			obj(rule(80, "tcp", ""), rule(53, "udp", ""), rule(443, "tcp", "")),
+			[]cty.Path{rulePath},
+		},
+		"rule removed": {
+			obj(rule(80, "tcp", "")),
+			[]cty.Path{rulePath},
+		},
+		"unknown port": {
+			obj(unknownPortRule, rule(53, "udp", "")),
+			[]cty.Path{rulePath},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, got, diags := rt.planChange(context.Background(), nil, prior, test.config, test.config)
+			if diags.HasErrors() {
+				t.Fatalf("unexpected errors: %#v", diags)
+			}
+			if got, want := len(got.List()), len(test.want); got != want {
+				t.Fatalf("wrong number of paths %d; want %d\n%#v", got, want, test.want)
+			}
+			for _, path := range test.want {
+				if !got.Has(path) {
+					t.Errorf("missing path %#v", path)
+				}
+			}
+		})
+	}
+}
+
+func TestManagedResourceTypePlanChangeRequiresReplaceSetDiagnostics(t *testing.T) {
+	schema := &tfschema.BlockType{
+		NestedBlockTypes: map[string]*tfschema.NestedBlockType{
+			"rule": {
+				Nesting: tfschema.NestingSet,
+				Content: tfschema.BlockType{
+					Attributes: map[string]*tfschema.Attribute{
+						"port": {Type: cty.Number, Required: true, RequiresReplace: true},
+						"protocol": {
+							Type:     cty.String,
+							Required: true,
+							RequiresReplaceIf: func(prior, planned cty.Value) (bool, Diagnostics) {
+								var diags Diagnostics
+								diags = diags.Append(Diagnostic{
+									Severity: Error,
+									Summary:  "Cannot change protocol",
+								})
+								return false, diags
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	rt := NewManagedResourceType(&ResourceTypeDef{
+		ConfigSchema: schema,
+	})
+	rule := func(port int64, protocol string) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"port":     cty.NumberIntVal(port),
+			"protocol": cty.StringVal(protocol),
+		})
+	}
+	prior := cty.ObjectVal(map[string]cty.Value{
+		"rule": cty.SetVal([]cty.Value{rule(80, "tcp")}),
+	})
+	config := cty.ObjectVal(map[string]cty.Value{
+		"rule": cty.SetVal([]cty.Value{rule(80, "udp")}),
+	})
+
+	_, _, diags := rt.planChange(context.Background(), nil, prior, config, config)
+	if len(diags) != 1 {
+		t.Fatalf("wrong number of diagnostics %d; want 1\n%#v", len(diags), diags)
+	}
+	// The diagnostic identifies the planned set element by its value.
+	want := cty.GetAttrPath("rule").Index(rule(80, "udp")).GetAttr("protocol")
+	if got := diags[0].Path; !want.Equals(got) {
+		t.Errorf("wrong path %#v; want %#v", got, want)
+	}
+
+	// The plugin protocol can't represent the element, so only the path of
+	// the set itself is sent to Terraform.
+	encoded := encodeAttrPathToTFPlugin5(diags[0].Path)
+	if got, want := len(encoded.Steps), 1; got != want {
+		t.Fatalf("wrong number of encoded steps %d; want %d", got, want)
+	}
+	if got, want := encoded.Steps[0].GetAttributeName(), "rule"; got != want {
+		t.Errorf("wrong encoded step %q; want %q", got, want)
+	}
+}
+
+func TestManagedResourceTypePlanChangeRequiresReplaceTuple(t *testing.T) {
+	// A dynamically-typed attribute makes a list block into a tuple, whose
+	// elements must still be correlated by index.
+	schema := &tfschema.BlockType{
+		NestedBlockTypes: map[string]*tfschema.NestedBlockType{
+			"tag": {
+				Nesting: tfschema.NestingList,
+				Content: tfschema.BlockType{
+					Attributes: map[string]*tfschema.Attribute{
+						"key":   {Type: cty.String, Required: true, RequiresReplace: true},
+						"value": {Type: cty.DynamicPseudoType, Optional: true},
+					},
+				},
+			},
+		},
+	}
+	rt := NewManagedResourceType(&ResourceTypeDef{
+		ConfigSchema: schema,
+	})
+	obj := func(tags ...cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"tag": cty.TupleVal(tags),
+		})
+	}
+	tag := func(key string, value cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"key":   cty.StringVal(key),
+			"value": value,
+		})
+	}
+	prior := obj(tag("a", cty.StringVal("x")), tag("b", cty.True))
+
+	t.Run("value changed", func(t *testing.T) {
+		config := obj(tag("a", cty.NumberIntVal(1)), tag("b", cty.True))
+		_, got, diags := rt.planChange(context.Background(), nil, prior, config, config)
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors: %#v", diags)
+		}
+		if got := got.List(); len(got) != 0 {
+			t.Errorf("unexpected paths %#v", got)
+		}
+	})
+	t.Run("key changed", func(t *testing.T) {
+		config := obj(tag("a", cty.StringVal("x")), tag("c", cty.True))
+		_, got, diags := rt.planChange(context.Background(), nil, prior, config, config)
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors: %#v", diags)
+		}
+		want := cty.GetAttrPath("tag").Index(cty.NumberIntVal(1)).GetAttr("key")
+		if got := got.List(); len(got) != 1 || !got[0].Equals(want) {
+			t.Errorf("wrong paths %#v; want %#v", got, want)
+		}
+	})
+}
+
+func TestManagedResourceTypePlanChangeSemanticEqual(t *testing.T) {
+	caseInsensitive := func(a, b string) (bool, Diagnostics) {
+		return strings.EqualFold(a, b), nil
+	}
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"name": {Type: cty.String, Required: true, SemanticEqualFn: caseInsensitive},
+		},
+		NestedBlockTypes: map[string]*tfschema.NestedBlockType{
+			"disk": {
+				Nesting: tfschema.NestingList,
+				Content: tfschema.BlockType{
+					Attributes: map[string]*tfschema.Attribute{
+						"label": {Type: cty.String, Required: true, SemanticEqualFn: caseInsensitive},
+					},
+				},
+			},
+		},
+	}
+	rt := NewManagedResourceType(&ResourceTypeDef{
+		ConfigSchema: schema,
+	})
+	obj := func(name string, labels ...string) cty.Value {
+		disks := make([]cty.Value, len(labels))
+		for i, label := range labels {
+			disks[i] = cty.ObjectVal(map[string]cty.Value{
+				"label": cty.StringVal(label),
+			})
+		}
+		return cty.ObjectVal(map[string]cty.Value{
+			"name": cty.StringVal(name),
+			"disk": cty.ListVal(disks),
+		})
+	}
+	prior := obj("Example", "Root")
+
+	tests := map[string]struct {
+		config cty.Value
+		want   cty.Value
+	}{
+		"no change": {
+			obj("Example", "Root"),
+			obj("Example", "Root"),
+		},
+		"equivalent": {
+			obj("EXAMPLE", "root"),
+			obj("Example", "Root"),
+		},
+		"changed": {
+			obj("other", "root"),
+			obj("other", "Root"),
+		},
+		"block added": {
+			obj("example", "root", "Data"),
+			obj("Example", "Root", "Data"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, _, diags := rt.planChange(context.Background(), nil, prior, test.config, test.config)
+			if diags.HasErrors() {
+				t.Fatalf("unexpected errors: %#v", diags)
+			}
+			if !test.want.RawEquals(got) {
+				t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestManagedResourceTypePlanChangePlanModifiers(t *testing.T) {
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"id": {
+				Type:          cty.String,
+				Computed:      true,
+				PlanModifiers: []interface{}{tfobj.UseStateForUnknown},
+			},
+			"name": {
+				Type:          cty.String,
+				Required:      true,
+				PlanModifiers: []interface{}{tfobj.RequiresReplaceIfChanged},
+			},
+		},
+		NestedBlockTypes: map[string]*tfschema.NestedBlockType{
+			"disk": {
+				Nesting: tfschema.NestingList,
+				Content: tfschema.BlockType{
+					Attributes: map[string]*tfschema.Attribute{
+						"size": {Type: cty.Number, Optional: true},
+						"size_label": {
+							Type:     cty.String,
+							Computed: true,
+							PlanModifiers: []interface{}{
+								func(plan tfobj.AttributePlanBuilder) Diagnostics {
+									size := plan.Object().Attr("size")
+									if size.IsKnown() && !size.IsNull() {
+										plan.SetPlanned(cty.StringVal(size.AsBigFloat().String() + "GB"))
+									}
+									return nil
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	rt := NewManagedResourceType(&ResourceTypeDef{
+		ConfigSchema: schema,
+		PlanFn: func(ctx context.Context, client interface{}, plan tfobj.PlanBuilder) (cty.Value, cty.PathSet, Diagnostics) {
+			// The PlanFn sees the results of the plan modifiers.
+			if got, want := plan.Attr("id"), cty.StringVal("abc"); !want.RawEquals(got) {
+				t.Errorf("PlanFn got id %#v; want %#v", got, want)
+			}
+			return plan.ObjectVal(), plan.RequiresReplace(), nil
+		},
+	})
+	obj := func(id cty.Value, name string, size int64, label cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"id":   id,
+			"name": cty.StringVal(name),
+			"disk": cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"size":       cty.NumberIntVal(size),
+					"size_label": label,
+				}),
+			}),
+		})
+	}
+	prior := obj(cty.StringVal("abc"), "a", 10, cty.StringVal("10GB"))
+	config := obj(cty.NullVal(cty.String), "b", 20, cty.NullVal(cty.String))
+	proposed := obj(cty.UnknownVal(cty.String), "b", 20, cty.StringVal("10GB"))
+
+	got, requiresReplace, diags := rt.planChange(context.Background(), nil, prior, config, proposed)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %#v", diags)
+	}
+	want := obj(cty.StringVal("abc"), "b", 20, cty.StringVal("20GB"))
+	if !want.RawEquals(got) {
+		t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, want)
+	}
+	if !requiresReplace.Has(cty.GetAttrPath("name")) {
+		t.Errorf("name is not marked as requiring replacement")
+	}
+}
+
+func TestManagedResourceTypePlanChangePlanModifiersUnknownBlocks(t *testing.T) {
+	diskS := tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"size": {Type: cty.Number, Optional: true, Default: 10},
+		},
+	}
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"id": {
+				Type:          cty.String,
+				Computed:      true,
+				PlanModifiers: []interface{}{tfobj.UseStateForUnknown},
+			},
+		},
+		NestedBlockTypes: map[string]*tfschema.NestedBlockType{
+			"disk": {Nesting: tfschema.NestingList, Content: diskS},
+			"boot": {Nesting: tfschema.NestingSingle, Content: diskS},
+			"tags": {Nesting: tfschema.NestingMap, Content: diskS},
+		},
+	}
+	rt := NewManagedResourceType(&ResourceTypeDef{
+		ConfigSchema: schema,
+	})
+	diskTy := diskS.ImpliedCtyType()
+	obj := func(id cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"id":   id,
+			"disk": cty.UnknownVal(cty.List(diskTy)),
+			"boot": cty.UnknownVal(diskTy),
+			"tags": cty.UnknownVal(cty.Map(diskTy)),
+		})
+	}
+	prior := cty.ObjectVal(map[string]cty.Value{
+		"id": cty.StringVal("abc"),
+		"disk": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{"size": cty.NumberIntVal(10)}),
+		}),
+		"boot": cty.NullVal(diskTy),
+		"tags": cty.MapValEmpty(diskTy),
+	})
+	config := obj(cty.NullVal(cty.String))
+	proposed := obj(cty.StringVal("abc"))
+
+	got, _, diags := rt.planChange(context.Background(), nil, prior, config, proposed)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %#v", diags)
+	}
+	want := obj(cty.StringVal("abc"))
+	if !want.RawEquals(got) {
+		t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, want)
+	}
+}
+
+func TestManagedResourceTypeApplyChangeUnknowns(t *testing.T) {
+	type instance struct {
+		ID      tfobj.String `cty:"id"`
+		Name    string       `cty:"name"`
+		Version *int         `cty:"version"`
+	}
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"id":      {Type: cty.String, Computed: true},
+			"name":    {Type: cty.String, Required: true},
+			"version": {Type: cty.Number, Computed: true},
+		},
+	}
+	rt := NewManagedResourceType(&ResourceTypeDef{
+		ConfigSchema: schema,
+		UpdateFn: func(ctx context.Context, client interface{}, prior *instance, planned *instance) (*instance, Diagnostics) {
+			if !planned.ID.IsUnknown() {
+				t.Errorf("planned id is not unknown: %#v", planned.ID)
+			}
+			if planned.Version != nil {
+				t.Errorf("planned version is not nil: %#v", planned.Version)
+			}
+			planned.ID = prior.ID
+			version := 2
+			planned.Version = &version
+			return planned, nil
+		},
+	})
+	prior := cty.ObjectVal(map[string]cty.Value{
+		"id":      cty.StringVal("abc"),
+		"name":    cty.StringVal("a"),
+		"version": cty.NumberIntVal(1),
+	})
+	planned := cty.ObjectVal(map[string]cty.Value{
+		"id":      cty.UnknownVal(cty.String),
+		"name":    cty.StringVal("b"),
+		"version": cty.UnknownVal(cty.Number),
+	})
+
+	got, diags := rt.applyChange(context.Background(), nil, prior, planned)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %#v", diags)
+	}
+	want := cty.ObjectVal(map[string]cty.Value{
+		"id":      cty.StringVal("abc"),
+		"name":    cty.StringVal("b"),
+		"version": cty.NumberIntVal(2),
+	})
+	if !want.RawEquals(got) {
+		t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, want)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/apparentlymart/terraform-sdk/internal/dynfunc"
+	"github.com/apparentlymart/terraform-sdk/tfobj"
 	"github.com/apparentlymart/terraform-sdk/tfschema"
 	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/convert"
@@ -42,6 +43,14 @@ func ValidateBlockObject(schema *tfschema.BlockType, val cty.Value) Diagnostics
 			})
 			continue
 		}
+		if attrS.Deprecated != "" && !av.IsNull() {
+			diags = diags.Append(Diagnostic{
+				Severity: Warning,
+				Summary:  "Argument is deprecated",
+				Detail:   attrS.Deprecated,
+				Path:     path,
+			})
+		}
 		attrDiags := ValidateAttrValue(attrS, av)
 		diags = diags.Append(attrDiags.UnderPath(path))
 	}
@@ -50,6 +59,27 @@ func ValidateBlockObject(schema *tfschema.BlockType, val cty.Value) Diagnostics
 		path := path.GetAttr(name)
 		av := val.GetAttr(name)
 
+		if blockS.Deprecated != "" && nestedBlocksPresent(blockS, av) {
+			diags = diags.Append(Diagnostic{
+				Severity: Warning,
+				Summary:  "Block is deprecated",
+				Detail:   blockS.Deprecated,
+				Path:     path,
+			})
+		}
+
+		if !av.IsKnown() {
+			// A block collection can be unknown if it was generated
+			// dynamically from an unknown value, in which case we'll check
+			// it once its value is known.
+			continue
+		}
+		countDiags := validateNestedBlockCount(name, blockS, av)
+		for i := range countDiags {
+			countDiags[i].Path = path
+		}
+		diags = diags.Append(countDiags)
+
 		switch blockS.Nesting {
 		case tfschema.NestingSingle, tfschema.NestingGroup:
 			if !av.IsNull() {
@@ -84,6 +114,120 @@ func ValidateBlockObject(schema *tfschema.BlockType, val cty.Value) Diagnostics
 		}
 	}
 
+	if !val.IsKnown() || val.IsNull() {
+		return diags
+	}
+
+	diags = diags.Append(validateRelationships(schema, val))
+
+	if diags.HasErrors() {
+		// As with attributes, we skip the block's own validation function
+		// if we've already found problems, so that the function need not
+		// be resilient to them.
+		return diags
+	}
+
+	// Diagnostics from the validation function are already relative to
+	// this block, and so our caller will place them under the block's path
+	// in the same way as for all of our other diagnostics.
+	validate, err := dynfunc.WrapSimpleFunction(schema.ValidateFn, tfobj.NewObjectReader(schema, val))
+	if err != nil {
+		diags = diags.Append(Diagnostic{
+			Severity: Error,
+			Summary:  "Invalid provider schema",
+			Detail:   fmt.Sprintf("Invalid ValidateFn: %s.\nThis is a bug in the provider that should be reported in its own issue tracker.", err),
+		})
+		return diags
+	}
+	diags = diags.Append(validate())
+
+	return diags
+}
+
+// nestedBlocksPresent returns true if the given value representing all of the
+// blocks of the given nested block type indicates that at least one such block
+// is present in the configuration, or might be once the value is known.
+func nestedBlocksPresent(schema *tfschema.NestedBlockType, val cty.Value) bool {
+	switch {
+	case !val.IsKnown():
+		return true
+	case val.IsNull():
+		return false
+	}
+
+	switch schema.Nesting {
+	case tfschema.NestingSingle:
+		return true
+	case tfschema.NestingGroup:
+		// A group block is never null, even when it is absent from the
+		// configuration, so we consider it present only if something
+		// inside it has been set.
+		for it := val.ElementIterator(); it.Next(); {
+			_, v := it.Element()
+			if !v.IsKnown() || (!v.IsNull() && !(v.Type().IsCollectionType() && v.LengthInt() == 0)) {
+				return true
+			}
+		}
+		return false
+	default:
+		return val.LengthInt() > 0
+	}
+}
+
+// validateNestedBlockCount checks the given known value representing all of
+// the blocks of the given nested block type against the MinItems and MaxItems
+// constraints of that block type.
+//
+// The returned diagnostics have no paths; the caller should set them to the
+// path of the block type.
+func validateNestedBlockCount(name string, schema *tfschema.NestedBlockType, val cty.Value) Diagnostics {
+	var diags Diagnostics
+	if schema.MinItems == 0 && schema.MaxItems == 0 {
+		return diags
+	}
+
+	var count int
+	switch schema.Nesting {
+	case tfschema.NestingSingle, tfschema.NestingGroup:
+		if !val.IsNull() {
+			count = 1
+		}
+	case tfschema.NestingSet:
+		if !val.IsWhollyKnown() {
+			// Elements with unknown values might turn out to be equal to
+			// others once known, and so the final count is not known yet.
+			return diags
+		}
+		count = val.LengthInt()
+	case tfschema.NestingList, tfschema.NestingMap:
+		count = val.LengthInt()
+	default:
+		// Unsupported nesting modes are reported by our caller.
+		return diags
+	}
+
+	if count < schema.MinItems {
+		what := "blocks are"
+		if schema.MinItems == 1 {
+			what = "block is"
+		}
+		diags = diags.Append(Diagnostic{
+			Severity: Error,
+			Summary:  fmt.Sprintf("Insufficient %s blocks", name),
+			Detail:   fmt.Sprintf("At least %d %q %s required.", schema.MinItems, name, what),
+		})
+	}
+	if schema.MaxItems > 0 && count > schema.MaxItems {
+		what := "blocks are"
+		if schema.MaxItems == 1 {
+			what = "block is"
+		}
+		diags = diags.Append(Diagnostic{
+			Severity: Error,
+			Summary:  fmt.Sprintf("Too many %s blocks", name),
+			Detail:   fmt.Sprintf("No more than %d %q %s allowed.", schema.MaxItems, name, what),
+		})
+	}
 	return diags
 }
 
@@ -106,7 +250,7 @@ func ValidateAttrValue(schema *tfschema.Attribute, val cty.Value) Diagnostics {
 		})
 	}
 
-	convVal, err := convert.Convert(val, schema.Type)
+	convVal, err := convert.Convert(val, schema.ImpliedCtyType())
 	if err != nil {
 		diags = diags.Append(Diagnostic{
 			Severity: Error,
@@ -137,6 +281,13 @@ func ValidateAttrValue(schema *tfschema.Attribute, val cty.Value) Diagnostics {
 		return diags
 	}
 
+	if schema.NestedType != nil {
+		diags = diags.Append(validateNestedAttrValue(schema.NestedType, convVal))
+		if diags.HasErrors() {
+			return diags
+		}
+	}
+
 	// The validation function gets the already-converted value, for convenience.
 	validate, err := dynfunc.WrapSimpleFunction(schema.ValidateFn, convVal)
 	if err != nil {
@@ -152,3 +303,86 @@ func ValidateAttrValue(schema *tfschema.Attribute, val cty.Value) Diagnostics {
 	diags = diags.Append(moreDiags)
 	return diags
 }
+
+// validateNestedAttrValue checks each of the objects in the given known,
+// non-null value against the attributes of the given nested attribute type.
+func validateNestedAttrValue(schema *tfschema.NestedAttributeType, val cty.Value) Diagnostics {
+	var diags Diagnostics
+	content := &tfschema.BlockType{Attributes: schema.Attributes}
+
+	switch schema.Nesting {
+	case tfschema.NestingSingle:
+		diags = diags.Append(ValidateBlockObject(content, val))
+	case tfschema.NestingList, tfschema.NestingMap:
+		for it := val.ElementIterator(); it.Next(); {
+			ek, ev := it.Element()
+			if ev.IsNull() {
+				continue
+			}
+			objDiags := ValidateBlockObject(content, ev)
+			diags = diags.Append(objDiags.UnderPath(cty.Path{cty.IndexStep{Key: ek}}))
+		}
+	case tfschema.NestingSet:
+		// As with nested blocks, set elements have no key we can use in a
+		// path and so any errors are reported against the set as a whole.
+		for it := val.ElementIterator(); it.Next(); {
+			_, ev := it.Element()
+			if ev.IsNull() {
+				continue
+			}
+			diags = diags.Append(ValidateBlockObject(content, ev))
+		}
+	default:
+		diags = diags.Append(Diagnostic{
+			Severity: Error,
+			Summary:  "Unsupported nested attribute mode",
+			Detail:   fmt.Sprintf("This attribute has an unsupported nesting mode %#v. This is a bug in the provider; please report it in the provider's own issue tracker.", schema.Nesting),
+		})
+	}
+
+	return diags
+}
+
+// applyDefaults applies the defaults from the given schema to the given
+// object, which must conform to it, calling any DefaultFn functions in the
+// same way as the other functions in a schema.
+func applyDefaults(schema *tfschema.BlockType, val cty.Value) (cty.Value, Diagnostics) {
+	return schema.ApplyDefaultsFunc(val, callDefaultFn)
+}
+
+// callDefaultFn is a tfschema.DefaultFnCaller that calls DefaultFn using
+// package dynfunc.
+func callDefaultFn(attr *tfschema.Attribute, name string, obj cty.Value) (cty.Value, Diagnostics) {
+	var diags Diagnostics
+	wantTy := attr.ImpliedCtyType()
+	path := cty.GetAttrPath(name)
+
+	fn, err := dynfunc.WrapFunctionWithReturnValueCty(attr.DefaultFn, wantTy, obj)
+	if err != nil {
+		diags = diags.Append(Diagnostic{
+			Severity: Error,
+			Summary:  "Invalid provider schema",
+			Detail:   fmt.Sprintf("Invalid DefaultFn: %s.\nThis is a bug in the provider that should be reported in its own issue tracker.", err),
+			Path:     path,
+		})
+		return cty.NullVal(wantTy), diags
+	}
+	v, diags := fn()
+	if diags.HasErrors() {
+		return cty.NullVal(wantTy), diags
+	}
+
+	// A cty.Value result is passed through verbatim, so we must convert it
+	// to the attribute's type ourselves.
+	v, err = convert.Convert(v, wantTy)
+	if err != nil {
+		diags = diags.Append(Diagnostic{
+			Severity: Error,
+			Summary:  "Invalid default value",
+			Detail:   fmt.Sprintf("The default value for this argument is not suitable: %s.", FormatError(err)),
+			Path:     path,
+		})
+		return cty.NullVal(wantTy), diags
+	}
+	return v, diags
+}
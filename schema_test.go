@@ -1,10 +1,12 @@
 package tfsdk_test
 
 import (
+	"sort"
 	"strings"
 	"testing"
 
 	tfsdk "github.com/apparentlymart/terraform-sdk"
+	"github.com/apparentlymart/terraform-sdk/tfobj"
 	"github.com/apparentlymart/terraform-sdk/tfschema"
 	"github.com/google/go-cmp/cmp"
 	"github.com/zclconf/go-cty/cty"
@@ -72,6 +74,70 @@ func TestValidateAttrValue(t *testing.T) {
 				`[ERROR] Invalid argument value: Incorrect value type: attribute "foo" is required.`,
 			},
 		},
+		"nested type ok": {
+			&tfschema.Attribute{
+				NestedType: &tfschema.NestedAttributeType{
+					Nesting: tfschema.NestingSingle,
+					Attributes: map[string]*tfschema.Attribute{
+						"name": {Type: cty.String, Required: true},
+						"id":   {Type: cty.String, Computed: true},
+					},
+				},
+				Optional: true,
+			},
+			cty.ObjectVal(map[string]cty.Value{
+				"name": cty.StringVal("foo"),
+				"id":   cty.NullVal(cty.String),
+			}),
+			nil,
+		},
+		"nested type missing required attribute": {
+			&tfschema.Attribute{
+				NestedType: &tfschema.NestedAttributeType{
+					Nesting: tfschema.NestingSingle,
+					Attributes: map[string]*tfschema.Attribute{
+						"name": {Type: cty.String, Required: true},
+					},
+				},
+				Optional: true,
+			},
+			cty.ObjectVal(map[string]cty.Value{
+				"name": cty.NullVal(cty.String),
+			}),
+			[]string{
+				`[ERROR] Missing required argument: The argument "name" must be set.`,
+			},
+		},
+		"nested type map element invalid": {
+			&tfschema.Attribute{
+				NestedType: &tfschema.NestedAttributeType{
+					Nesting: tfschema.NestingMap,
+					Attributes: map[string]*tfschema.Attribute{
+						"name": {
+							Type:     cty.String,
+							Required: true,
+							ValidateFn: func(v string) tfsdk.Diagnostics {
+								return tfsdk.Diagnostics{
+									{
+										Severity: tfsdk.Error,
+										Summary:  "Not ok",
+									},
+								}
+							},
+						},
+					},
+				},
+				Optional: true,
+			},
+			cty.MapVal(map[string]cty.Value{
+				"a": cty.ObjectVal(map[string]cty.Value{
+					"name": cty.StringVal("foo"),
+				}),
+			}),
+			[]string{
+				`[ERROR] Not ok (in ["a"].name)`,
+			},
+		},
 		"custom validate function ok": {
 			&tfschema.Attribute{
 				Type:     cty.String,
@@ -172,6 +238,464 @@ func TestValidateAttrValue(t *testing.T) {
 	}
 }
 
+func TestValidateBlockObject(t *testing.T) {
+	diskType := &tfschema.NestedBlockType{
+		Nesting: tfschema.NestingList,
+		Content: tfschema.BlockType{
+			Attributes: map[string]*tfschema.Attribute{
+				"label": {Type: cty.String, Optional: true},
+			},
+		},
+		MinItems: 1,
+		MaxItems: 2,
+	}
+	schema := &tfschema.BlockType{
+		NestedBlockTypes: map[string]*tfschema.NestedBlockType{
+			"disk": diskType,
+			"tag": {
+				Nesting: tfschema.NestingSet,
+				Content: tfschema.BlockType{
+					Attributes: map[string]*tfschema.Attribute{
+						"key": {Type: cty.String, Optional: true},
+					},
+				},
+				MaxItems: 1,
+			},
+		},
+	}
+	diskTy := schema.ImpliedCtyType().AttributeType("disk").ElementType()
+	tagTy := schema.ImpliedCtyType().AttributeType("tag").ElementType()
+	disk := func(label string) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"label": cty.StringVal(label),
+		})
+	}
+	tag := func(key cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"key": key,
+		})
+	}
+
+	tests := map[string]struct {
+		Try       cty.Value
+		WantDiags []string
+	}{
+		"ok": {
+			cty.ObjectVal(map[string]cty.Value{
+				"disk": cty.ListVal([]cty.Value{disk("a")}),
+				"tag":  cty.SetValEmpty(tagTy),
+			}),
+			nil,
+		},
+		"too few blocks": {
+			cty.ObjectVal(map[string]cty.Value{
+				"disk": cty.ListValEmpty(diskTy),
+				"tag":  cty.SetValEmpty(tagTy),
+			}),
+			[]string{
+				`[ERROR] Insufficient disk blocks: At least 1 "disk" block is required. (in .disk)`,
+			},
+		},
+		"too many blocks": {
+			cty.ObjectVal(map[string]cty.Value{
+				"disk": cty.ListVal([]cty.Value{disk("a"), disk("b"), disk("c")}),
+				"tag":  cty.SetVal([]cty.Value{tag(cty.StringVal("a")), tag(cty.StringVal("b"))}),
+			}),
+			[]string{
+				`[ERROR] Too many disk blocks: No more than 2 "disk" blocks are allowed. (in .disk)`,
+				`[ERROR] Too many tag blocks: No more than 1 "tag" block is allowed. (in .tag)`,
+			},
+		},
+		"unknown block collection": {
+			cty.ObjectVal(map[string]cty.Value{
+				"disk": cty.UnknownVal(cty.List(diskTy)),
+				"tag":  cty.SetValEmpty(tagTy),
+			}),
+			nil,
+		},
+		"set with unknown elements": {
+			// These might turn out to be equal once known, and so
+			// there might be only one element.
+			cty.ObjectVal(map[string]cty.Value{
+				"disk": cty.ListVal([]cty.Value{disk("a")}),
+				"tag":  cty.SetVal([]cty.Value{tag(cty.UnknownVal(cty.String)), tag(cty.StringVal("a"))}),
+			}),
+			nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotDiags := tfsdk.ValidateBlockObject(schema, test.Try)
+
+			if len(test.WantDiags) > 0 {
+				gotDiagsStr := diagnosticStringsForTests(gotDiags)
+				sort.Strings(gotDiagsStr)
+				if !cmp.Equal(gotDiagsStr, test.WantDiags) {
+					t.Fatalf("wrong diagnostics\n%s", cmp.Diff(test.WantDiags, gotDiagsStr))
+				}
+				return
+			}
+
+			for _, diagStr := range diagnosticStringsForTests(gotDiags) {
+				t.Errorf("unexpected problem: %s", diagStr)
+			}
+		})
+	}
+}
+
+func TestValidateBlockObjectDeprecated(t *testing.T) {
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"name":  {Type: cty.String, Optional: true, Deprecated: "Use label instead."},
+			"label": {Type: cty.String, Optional: true},
+		},
+		NestedBlockTypes: map[string]*tfschema.NestedBlockType{
+			"disk": {
+				Nesting: tfschema.NestingList,
+				Content: tfschema.BlockType{
+					Attributes: map[string]*tfschema.Attribute{
+						"size": {Type: cty.Number, Optional: true, Deprecated: "Use size_gb instead."},
+					},
+				},
+				Deprecated: "Use volume blocks instead.",
+			},
+			"timeouts": {
+				Nesting: tfschema.NestingGroup,
+				Content: tfschema.BlockType{
+					Attributes: map[string]*tfschema.Attribute{
+						"create": {Type: cty.String, Optional: true},
+					},
+				},
+				Deprecated: "Timeouts are no longer supported.",
+			},
+		},
+	}
+	diskTy := schema.ImpliedCtyType().AttributeType("disk").ElementType()
+	noTimeouts := cty.ObjectVal(map[string]cty.Value{
+		"create": cty.NullVal(cty.String),
+	})
+
+	tests := map[string]struct {
+		Try       cty.Value
+		WantDiags []string
+	}{
+		"none set": {
+			cty.ObjectVal(map[string]cty.Value{
+				"name":     cty.NullVal(cty.String),
+				"label":    cty.StringVal("a"),
+				"disk":     cty.ListValEmpty(diskTy),
+				"timeouts": noTimeouts,
+			}),
+			nil,
+		},
+		"all set": {
+			cty.ObjectVal(map[string]cty.Value{
+				"name":  cty.UnknownVal(cty.String),
+				"label": cty.NullVal(cty.String),
+				"disk": cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						"size": cty.NumberIntVal(1),
+					}),
+				}),
+				"timeouts": cty.ObjectVal(map[string]cty.Value{
+					"create": cty.StringVal("10m"),
+				}),
+			}),
+			[]string{
+				`[WARNING] Argument is deprecated: Use label instead. (in .name)`,
+				`[WARNING] Argument is deprecated: Use size_gb instead. (in .disk[0].size)`,
+				`[WARNING] Block is deprecated: Timeouts are no longer supported. (in .timeouts)`,
+				`[WARNING] Block is deprecated: Use volume blocks instead. (in .disk)`,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotDiags := tfsdk.ValidateBlockObject(schema, test.Try)
+
+			if len(test.WantDiags) > 0 {
+				gotDiagsStr := diagnosticStringsForTests(gotDiags)
+				sort.Strings(gotDiagsStr)
+				if !cmp.Equal(gotDiagsStr, test.WantDiags) {
+					t.Fatalf("wrong diagnostics\n%s", cmp.Diff(test.WantDiags, gotDiagsStr))
+				}
+				return
+			}
+
+			for _, diagStr := range diagnosticStringsForTests(gotDiags) {
+				t.Errorf("unexpected problem: %s", diagStr)
+			}
+		})
+	}
+}
+
+func TestValidateBlockObjectRelationships(t *testing.T) {
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"a": {Type: cty.String, Optional: true},
+			"b": {Type: cty.String, Optional: true},
+			"c": {Type: cty.String, Optional: true},
+			"d": {Type: cty.String, Optional: true},
+		},
+		NestedBlockTypes: map[string]*tfschema.NestedBlockType{
+			"disk": {
+				Nesting: tfschema.NestingList,
+				Content: tfschema.BlockType{
+					Attributes: map[string]*tfschema.Attribute{
+						"label": {Type: cty.String, Optional: true},
+					},
+				},
+			},
+		},
+		ConflictsWith: [][]cty.Path{
+			{cty.GetAttrPath("a"), cty.GetAttrPath("disk").Index(cty.NumberIntVal(0)).GetAttr("label")},
+		},
+		ExactlyOneOf: [][]cty.Path{
+			{cty.GetAttrPath("a"), cty.GetAttrPath("b")},
+		},
+		RequiredWith: [][]cty.Path{
+			{cty.GetAttrPath("c"), cty.GetAttrPath("d")},
+		},
+		AtLeastOneOf: [][]cty.Path{
+			{cty.GetAttrPath("c"), cty.GetAttrPath("disk").Index(cty.NumberIntVal(0)).GetAttr("label")},
+		},
+	}
+	diskTy := schema.ImpliedCtyType().AttributeType("disk").ElementType()
+	obj := func(a, b, c, d cty.Value, disks ...cty.Value) cty.Value {
+		disk := cty.ListValEmpty(diskTy)
+		if len(disks) > 0 {
+			disk = cty.ListVal(disks)
+		}
+		return cty.ObjectVal(map[string]cty.Value{
+			"a":    a,
+			"b":    b,
+			"c":    c,
+			"d":    d,
+			"disk": disk,
+		})
+	}
+	disk := func(label cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"label": label,
+		})
+	}
+	null := cty.NullVal(cty.String)
+	set := cty.StringVal("set")
+	unknown := cty.UnknownVal(cty.String)
+
+	tests := map[string]struct {
+		Try       cty.Value
+		WantDiags []string
+	}{
+		"ok": {
+			obj(set, null, set, set),
+			nil,
+		},
+		"conflict": {
+			obj(set, null, set, set, disk(set)),
+			[]string{
+				`[ERROR] Conflicting configuration arguments: The argument "a" cannot be used together with "disk[0].label". (in .a)`,
+				`[ERROR] Conflicting configuration arguments: The argument "disk[0].label" cannot be used together with "a". (in .disk[0].label)`,
+			},
+		},
+		"more than one of exactly one": {
+			obj(set, set, set, set),
+			[]string{
+				`[ERROR] Conflicting configuration arguments: Only one of "a" or "b" may be set. (in .a)`,
+				`[ERROR] Conflicting configuration arguments: Only one of "a" or "b" may be set. (in .b)`,
+			},
+		},
+		"none of exactly one": {
+			obj(null, null, set, set),
+			[]string{
+				`[ERROR] Missing required argument: Exactly one of "a" or "b" must be set.`,
+			},
+		},
+		"none of exactly one with unknown": {
+			obj(null, unknown, set, set),
+			nil,
+		},
+		"required with": {
+			obj(null, set, null, set, disk(set)),
+			[]string{
+				`[ERROR] Missing required argument: The argument "c" must be set when "d" is set. (in .c)`,
+			},
+		},
+		"required with unknown": {
+			obj(null, set, unknown, set),
+			nil,
+		},
+		"none of at least one": {
+			obj(null, set, null, null, disk(null)),
+			[]string{
+				`[ERROR] Missing required argument: At least one of "c" or "disk[0].label" must be set.`,
+			},
+		},
+		"none of at least one with unknown block": {
+			cty.ObjectVal(map[string]cty.Value{
+				"a":    null,
+				"b":    set,
+				"c":    null,
+				"d":    null,
+				"disk": cty.UnknownVal(cty.List(diskTy)),
+			}),
+			nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotDiags := tfsdk.ValidateBlockObject(schema, test.Try)
+
+			if len(test.WantDiags) > 0 {
+				gotDiagsStr := diagnosticStringsForTests(gotDiags)
+				sort.Strings(gotDiagsStr)
+				if !cmp.Equal(gotDiagsStr, test.WantDiags) {
+					t.Fatalf("wrong diagnostics\n%s", cmp.Diff(test.WantDiags, gotDiagsStr))
+				}
+				return
+			}
+
+			for _, diagStr := range diagnosticStringsForTests(gotDiags) {
+				t.Errorf("unexpected problem: %s", diagStr)
+			}
+		})
+	}
+}
+
+func TestValidateBlockObjectValidateFn(t *testing.T) {
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"a": {Type: cty.String, Optional: true},
+			"b": {Type: cty.String, Optional: true},
+		},
+		NestedBlockTypes: map[string]*tfschema.NestedBlockType{
+			"rule": {
+				Nesting: tfschema.NestingList,
+				Content: tfschema.BlockType{
+					Attributes: map[string]*tfschema.Attribute{
+						"enabled": {Type: cty.Bool, Optional: true},
+						"port":    {Type: cty.Number, Optional: true},
+					},
+					ValidateFn: func(obj tfobj.ObjectReader) tfsdk.Diagnostics {
+						var diags tfsdk.Diagnostics
+						enabled := obj.Attr("enabled")
+						port := obj.Attr("port")
+						if enabled.IsKnown() && enabled.True() && port.IsNull() {
+							diags = diags.Append(tfsdk.Diagnostic{
+								Severity: tfsdk.Error,
+								Summary:  "Missing port",
+								Detail:   "A port is required for an enabled rule.",
+								Path:     cty.GetAttrPath("port"),
+							})
+						}
+						return diags
+					},
+				},
+			},
+		},
+		ValidateFn: func(obj tfobj.ObjectReader) tfsdk.Diagnostics {
+			var diags tfsdk.Diagnostics
+			a, b := obj.Attr("a"), obj.Attr("b")
+			if !a.IsKnown() || !b.IsKnown() {
+				return diags
+			}
+			if a.IsNull() == b.IsNull() {
+				diags = diags.Append(tfsdk.Diagnostic{
+					Severity: tfsdk.Error,
+					Summary:  "Invalid arguments",
+					Detail:   "Exactly one of a or b must be set.",
+				})
+			}
+			return diags
+		},
+	}
+	ruleTy := schema.ImpliedCtyType().AttributeType("rule").ElementType()
+	rule := func(enabled bool, port cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"enabled": cty.BoolVal(enabled),
+			"port":    port,
+		})
+	}
+
+	tests := map[string]struct {
+		Try       cty.Value
+		WantDiags []string
+	}{
+		"ok": {
+			cty.ObjectVal(map[string]cty.Value{
+				"a":    cty.StringVal("a"),
+				"b":    cty.NullVal(cty.String),
+				"rule": cty.ListVal([]cty.Value{rule(true, cty.NumberIntVal(80))}),
+			}),
+			nil,
+		},
+		"conflict": {
+			cty.ObjectVal(map[string]cty.Value{
+				"a":    cty.StringVal("a"),
+				"b":    cty.StringVal("b"),
+				"rule": cty.ListValEmpty(ruleTy),
+			}),
+			[]string{
+				`[ERROR] Invalid arguments: Exactly one of a or b must be set.`,
+			},
+		},
+		"unknown": {
+			cty.ObjectVal(map[string]cty.Value{
+				"a":    cty.StringVal("a"),
+				"b":    cty.UnknownVal(cty.String),
+				"rule": cty.ListValEmpty(ruleTy),
+			}),
+			nil,
+		},
+		"nested block": {
+			cty.ObjectVal(map[string]cty.Value{
+				"a": cty.NullVal(cty.String),
+				"b": cty.StringVal("b"),
+				"rule": cty.ListVal([]cty.Value{
+					rule(false, cty.NullVal(cty.Number)),
+					rule(true, cty.NullVal(cty.Number)),
+				}),
+			}),
+			[]string{
+				`[ERROR] Missing port: A port is required for an enabled rule. (in .rule[1].port)`,
+			},
+		},
+		"skipped after nested errors": {
+			// The top-level function is not called because the nested
+			// block already has an error.
+			cty.ObjectVal(map[string]cty.Value{
+				"a":    cty.NullVal(cty.String),
+				"b":    cty.NullVal(cty.String),
+				"rule": cty.ListVal([]cty.Value{rule(true, cty.NullVal(cty.Number))}),
+			}),
+			[]string{
+				`[ERROR] Missing port: A port is required for an enabled rule. (in .rule[0].port)`,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotDiags := tfsdk.ValidateBlockObject(schema, test.Try)
+
+			if len(test.WantDiags) > 0 {
+				gotDiagsStr := diagnosticStringsForTests(gotDiags)
+				if !cmp.Equal(gotDiagsStr, test.WantDiags) {
+					t.Fatalf("wrong diagnostics\n%s", cmp.Diff(test.WantDiags, gotDiagsStr))
+				}
+				return
+			}
+
+			for _, diagStr := range diagnosticStringsForTests(gotDiags) {
+				t.Errorf("unexpected problem: %s", diagStr)
+			}
+		})
+	}
+}
+
 // diagnosticStringForTests converts a diagnostic into a compact string that
 // is easier to use for matching in test assertions.
 func diagnosticStringForTests(diag tfsdk.Diagnostic) string {
@@ -0,0 +1,130 @@
+package tfsdk
+
+import (
+	"fmt"
+
+	"github.com/apparentlymart/terraform-sdk/internal/dynfunc"
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// keepSemanticallyEqual returns a copy of the given new object, which must
+// conform to the given schema, where the value of each attribute that has a
+// SemanticEqualFn is replaced with the corresponding value from the given old
+// object if the function considers the two values to be equivalent.
+//
+// Attributes inside nested blocks are handled too. Elements of list blocks
+// are correlated by index and elements of map blocks by key. Elements of set
+// blocks cannot be correlated, so they are left unchanged.
+func keepSemanticallyEqual(schema *tfschema.BlockType, old, new cty.Value, path cty.Path) (cty.Value, Diagnostics) {
+	var diags Diagnostics
+	if !blockHasSemanticEqual(schema) {
+		return new, diags
+	}
+	if old.IsNull() || new.IsNull() || !old.IsKnown() || !new.IsKnown() || old.RawEquals(new) {
+		return new, diags
+	}
+
+	vals := make(map[string]cty.Value)
+	for name, av := range new.AsValueMap() {
+		vals[name] = av
+	}
+
+	for name, attrS := range schema.Attributes {
+		if attrS.SemanticEqualFn == nil {
+			continue
+		}
+		path := path.GetAttr(name)
+		oldV, newV := old.GetAttr(name), new.GetAttr(name)
+		if oldV.IsNull() || newV.IsNull() || !oldV.IsWhollyKnown() || !newV.IsWhollyKnown() || oldV.RawEquals(newV) {
+			continue
+		}
+		equal, moreDiags := attrSemanticallyEqual(attrS, oldV, newV)
+		diags = diags.Append(moreDiags.UnderPath(path))
+		if equal {
+			vals[name] = oldV
+		}
+	}
+
+	for name, blockS := range schema.NestedBlockTypes {
+		path := path.GetAttr(name)
+		oldV, newV := old.GetAttr(name), new.GetAttr(name)
+		var moreDiags Diagnostics
+		switch blockS.Nesting {
+		case tfschema.NestingSingle, tfschema.NestingGroup:
+			vals[name], moreDiags = keepSemanticallyEqual(&blockS.Content, oldV, newV, path)
+
+		case tfschema.NestingList, tfschema.NestingMap:
+			if oldV.IsNull() || newV.IsNull() || !oldV.IsKnown() || !newV.IsKnown() {
+				continue
+			}
+			// Blocks containing dynamically-typed attributes are represented
+			// as tuples or objects, whose element types might not agree after
+			// we replace some of the attribute values, so we'll leave them
+			// unchanged.
+			if ty := newV.Type(); !(ty.IsListType() || ty.IsMapType()) || !oldV.Type().Equals(ty) {
+				continue
+			}
+			elems := make(map[string]cty.Value)
+			var list []cty.Value
+			for it := newV.ElementIterator(); it.Next(); {
+				k, ev := it.Element()
+				if oldV.HasIndex(k).True() {
+					var elemDiags Diagnostics
+					ev, elemDiags = keepSemanticallyEqual(&blockS.Content, oldV.Index(k), ev, path.Index(k))
+					moreDiags = moreDiags.Append(elemDiags)
+				}
+				if newV.Type().IsListType() {
+					list = append(list, ev)
+				} else {
+					elems[k.AsString()] = ev
+				}
+			}
+			switch {
+			case newV.LengthInt() == 0:
+				// Nothing to do
+			case newV.Type().IsListType():
+				vals[name] = cty.ListVal(list)
+			default:
+				vals[name] = cty.MapVal(elems)
+			}
+		}
+		diags = diags.Append(moreDiags)
+	}
+
+	return cty.ObjectVal(vals), diags
+}
+
+// attrSemanticallyEqual calls the SemanticEqualFn of the given attribute
+// schema with the given known, non-null values.
+func attrSemanticallyEqual(schema *tfschema.Attribute, a, b cty.Value) (bool, Diagnostics) {
+	var diags Diagnostics
+	var equal bool
+	fn, err := dynfunc.WrapFunctionWithReturnValue(schema.SemanticEqualFn, &equal, a, b)
+	if err != nil {
+		diags = diags.Append(Diagnostic{
+			Severity: Error,
+			Summary:  "Invalid provider schema",
+			Detail:   fmt.Sprintf("Invalid SemanticEqualFn: %s.\nThis is a bug in the provider that should be reported in its own issue tracker.", err),
+		})
+		return false, diags
+	}
+	diags = diags.Append(fn())
+	return equal && !diags.HasErrors(), diags
+}
+
+// blockHasSemanticEqual returns true if any attribute in the given block, or
+// in any block nested within it, has a SemanticEqualFn.
+func blockHasSemanticEqual(schema *tfschema.BlockType) bool {
+	for _, attrS := range schema.Attributes {
+		if attrS.SemanticEqualFn != nil {
+			return true
+		}
+	}
+	for _, blockS := range schema.NestedBlockTypes {
+		if blockHasSemanticEqual(&blockS.Content) {
+			return true
+		}
+	}
+	return false
+}
@@ -3,10 +3,10 @@ package test
 import (
 	"context"
 	"log"
-	"net/url"
 
 	tfsdk "github.com/apparentlymart/terraform-sdk"
 	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/apparentlymart/terraform-sdk/tfvalidate"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/zclconf/go-cty/cty"
 )
@@ -20,20 +20,9 @@ func Provider() *tfsdk.Provider {
 					Optional: true,
 				},
 				"optional_url": {
-					Type:     cty.String,
-					Optional: true,
-					ValidateFn: func(v string) tfsdk.Diagnostics {
-						var diags tfsdk.Diagnostics
-						u, err := url.Parse(v)
-						if err != nil || u.Scheme != "https" {
-							diags = diags.Append(tfsdk.Diagnostic{
-								Severity: tfsdk.Error,
-								Summary:  "Invalid URL",
-								Detail:   "Must be a valid absolute HTTPS URL.",
-							})
-						}
-						return diags
-					},
+					Type:       cty.String,
+					Optional:   true,
+					ValidateFn: tfvalidate.URLWithScheme("https"),
 				},
 			},
 		},
@@ -4,8 +4,18 @@ import (
 	"os"
 
 	tftest "github.com/apparentlymart/terraform-plugin-test"
+	"github.com/apparentlymart/terraform-sdk/internal/sdkbridge"
+	"github.com/apparentlymart/terraform-sdk/internal/tfplugin5"
 )
 
+func init() {
+	// Package tfsdktest drives providers in-process through the same server
+	// implementation that handles real plugin RPC requests.
+	sdkbridge.NewProviderServer = func(p interface{}) tfplugin5.ProviderServer {
+		return p.(*Provider).tfplugin5Server()
+	}
+}
+
 // InitProviderTesting is the main entrypoint for testing provider plugins
 // using this package. It is intended to be called during TestMain to prepare
 // for provider testing.
@@ -27,7 +37,9 @@ import (
 //
 // If a suitable Terraform CLI executable cannot be found, or some other
 // environmental problem is detected, this function will print an error message
-// to stderr and exit the process immediately with status 1.
+// to stderr and exit the process immediately with status 1. Package tfsdktest
+// offers an alternative in-process test harness that does not require
+// Terraform CLI.
 //
 // The usual pattern for initialization in TestMain is:
 //
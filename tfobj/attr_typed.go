@@ -0,0 +1,136 @@
+package tfobj
+
+import (
+	"fmt"
+
+	"github.com/apparentlymart/terraform-sdk/internal/sdkdiags"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	"github.com/zclconf/go-cty/cty/gocty"
+)
+
+func (r *objectReaderVal) AttrString(name string) (string, ValueState, sdkdiags.Diagnostics) {
+	return attrString(r, name)
+}
+
+func (r *objectReaderVal) AttrInt64(name string) (int64, ValueState, sdkdiags.Diagnostics) {
+	return attrInt64(r, name)
+}
+
+func (r *objectReaderVal) AttrBool(name string) (bool, ValueState, sdkdiags.Diagnostics) {
+	return attrBool(r, name)
+}
+
+func (r *objectReaderVal) AttrStringList(name string) ([]string, ValueState, sdkdiags.Diagnostics) {
+	return attrStringList(r, name)
+}
+
+func (r *objectReaderVal) AttrStringMap(name string) (map[string]string, ValueState, sdkdiags.Diagnostics) {
+	return attrStringMap(r, name)
+}
+
+func (b *objectBuilder) AttrString(name string) (string, ValueState, sdkdiags.Diagnostics) {
+	return attrString(b, name)
+}
+
+func (b *objectBuilder) AttrInt64(name string) (int64, ValueState, sdkdiags.Diagnostics) {
+	return attrInt64(b, name)
+}
+
+func (b *objectBuilder) AttrBool(name string) (bool, ValueState, sdkdiags.Diagnostics) {
+	return attrBool(b, name)
+}
+
+func (b *objectBuilder) AttrStringList(name string) ([]string, ValueState, sdkdiags.Diagnostics) {
+	return attrStringList(b, name)
+}
+
+func (b *objectBuilder) AttrStringMap(name string) (map[string]string, ValueState, sdkdiags.Diagnostics) {
+	return attrStringMap(b, name)
+}
+
+func (b *planBuilder) AttrString(name string) (string, ValueState, sdkdiags.Diagnostics) {
+	return attrString(b, name)
+}
+
+func (b *planBuilder) AttrInt64(name string) (int64, ValueState, sdkdiags.Diagnostics) {
+	return attrInt64(b, name)
+}
+
+func (b *planBuilder) AttrBool(name string) (bool, ValueState, sdkdiags.Diagnostics) {
+	return attrBool(b, name)
+}
+
+func (b *planBuilder) AttrStringList(name string) ([]string, ValueState, sdkdiags.Diagnostics) {
+	return attrStringList(b, name)
+}
+
+func (b *planBuilder) AttrStringMap(name string) (map[string]string, ValueState, sdkdiags.Diagnostics) {
+	return attrStringMap(b, name)
+}
+
+func attrString(r ObjectReader, name string) (string, ValueState, sdkdiags.Diagnostics) {
+	var ret string
+	state, diags := attrGoValue(r, name, cty.String, "a string", &ret)
+	return ret, state, diags
+}
+
+func attrInt64(r ObjectReader, name string) (int64, ValueState, sdkdiags.Diagnostics) {
+	var ret int64
+	state, diags := attrGoValue(r, name, cty.Number, "a whole number", &ret)
+	return ret, state, diags
+}
+
+func attrBool(r ObjectReader, name string) (bool, ValueState, sdkdiags.Diagnostics) {
+	var ret bool
+	state, diags := attrGoValue(r, name, cty.Bool, "a bool", &ret)
+	return ret, state, diags
+}
+
+func attrStringList(r ObjectReader, name string) ([]string, ValueState, sdkdiags.Diagnostics) {
+	var ret []string
+	state, diags := attrGoValue(r, name, cty.List(cty.String), "a list of strings", &ret)
+	return ret, state, diags
+}
+
+func attrStringMap(r ObjectReader, name string) (map[string]string, ValueState, sdkdiags.Diagnostics) {
+	var ret map[string]string
+	state, diags := attrGoValue(r, name, cty.Map(cty.String), "a map of strings", &ret)
+	return ret, state, diags
+}
+
+// attrGoValue converts the value of the given attribute to the given type and
+// then decodes it into the given pointer using gocty.
+//
+// It returns ValueNull or ValueUnknown without changing the target if the
+// value is null or is not wholly known, respectively. It returns error
+// diagnostics, with paths relative to the object, if the value cannot be
+// converted.
+func attrGoValue(r ObjectReader, name string, ty cty.Type, tyDesc string, to interface{}) (ValueState, sdkdiags.Diagnostics) {
+	var diags sdkdiags.Diagnostics
+	val := r.Attr(name)
+	switch {
+	case !val.IsWhollyKnown():
+		return ValueUnknown, diags
+	case val.IsNull():
+		return ValueNull, diags
+	}
+
+	val, err := convert.Convert(val, ty)
+	if err == nil {
+		err = gocty.FromCtyValue(val, to)
+	}
+	if err != nil {
+		path := cty.GetAttrPath(name)
+		if pErr, ok := err.(cty.PathError); ok {
+			path = append(path, pErr.Path...)
+		}
+		diags = diags.Append(sdkdiags.Diagnostic{
+			Severity: sdkdiags.Error,
+			Summary:  "Unsuitable attribute value",
+			Detail:   fmt.Sprintf("The value of attribute %q must be %s: %s.", name, tyDesc, sdkdiags.FormatError(err)),
+			Path:     path,
+		})
+	}
+	return ValueKnown, diags
+}
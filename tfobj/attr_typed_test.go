@@ -0,0 +1,141 @@
+package tfobj
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/apparentlymart/terraform-sdk/internal/sdkdiags"
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestObjectReaderTypedAttrs(t *testing.T) {
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"name":    {Type: cty.String, Required: true},
+			"count":   {Type: cty.Number, Optional: true},
+			"ratio":   {Type: cty.Number, Optional: true},
+			"public":  {Type: cty.Bool, Optional: true},
+			"id":      {Type: cty.String, Computed: true},
+			"zones":   {Type: cty.List(cty.String), Optional: true},
+			"ports":   {Type: cty.List(cty.Number), Optional: true},
+			"aliases": {Type: cty.List(cty.String), Optional: true},
+			"tags":    {Type: cty.Map(cty.String), Optional: true},
+			"labels":  {Type: cty.Map(cty.String), Optional: true},
+			"note":    {Type: cty.String, Optional: true},
+			"size":    {Type: cty.Number, Computed: true},
+		},
+	}
+	r := NewObjectReader(schema, cty.ObjectVal(map[string]cty.Value{
+		"name":    cty.StringVal("web"),
+		"count":   cty.NumberIntVal(3),
+		"ratio":   cty.NumberFloatVal(1.5),
+		"public":  cty.StringVal("true"),
+		"id":      cty.UnknownVal(cty.String),
+		"zones":   cty.ListVal([]cty.Value{cty.StringVal("a"), cty.StringVal("b")}),
+		"ports":   cty.ListVal([]cty.Value{cty.NumberIntVal(80)}),
+		"aliases": cty.ListVal([]cty.Value{cty.StringVal("a"), cty.UnknownVal(cty.String)}),
+		"tags":    cty.MapVal(map[string]cty.Value{"env": cty.StringVal("prod")}),
+		"labels":  cty.NullVal(cty.Map(cty.String)),
+		"note":    cty.NullVal(cty.String),
+		"size":    cty.UnknownVal(cty.Number),
+	}))
+
+	assertOK := func(t *testing.T, name string, state ValueState, diags sdkdiags.Diagnostics) {
+		t.Helper()
+		if diags.HasErrors() {
+			t.Fatalf("unexpected errors for %q: %#v", name, diags)
+		}
+		if state != ValueKnown {
+			t.Fatalf("%q is %s, not known", name, state)
+		}
+	}
+
+	{
+		got, state, diags := r.AttrString("name")
+		assertOK(t, "name", state, diags)
+		if got != "web" {
+			t.Errorf("wrong name %q", got)
+		}
+	}
+	{
+		got, state, diags := r.AttrInt64("count")
+		assertOK(t, "count", state, diags)
+		if got != 3 {
+			t.Errorf("wrong count %d", got)
+		}
+	}
+	{
+		got, state, diags := r.AttrBool("public")
+		assertOK(t, "public", state, diags)
+		if got != true {
+			t.Errorf("wrong public %#v", got)
+		}
+	}
+	{
+		got, state, diags := r.AttrString("count")
+		assertOK(t, "count", state, diags)
+		if got != "3" {
+			t.Errorf("wrong count as string %q", got)
+		}
+	}
+	{
+		got, state, diags := r.AttrStringList("zones")
+		assertOK(t, "zones", state, diags)
+		if !reflect.DeepEqual(got, []string{"a", "b"}) {
+			t.Errorf("wrong zones %#v", got)
+		}
+	}
+	{
+		got, state, diags := r.AttrStringList("ports")
+		assertOK(t, "ports", state, diags)
+		if !reflect.DeepEqual(got, []string{"80"}) {
+			t.Errorf("wrong ports %#v", got)
+		}
+	}
+	{
+		got, state, diags := r.AttrStringMap("tags")
+		assertOK(t, "tags", state, diags)
+		if !reflect.DeepEqual(got, map[string]string{"env": "prod"}) {
+			t.Errorf("wrong tags %#v", got)
+		}
+	}
+
+	if got, state, diags := r.AttrString("id"); state != ValueUnknown || diags.HasErrors() || got != "" {
+		t.Errorf("wrong result for unknown id: %q, %s, %#v", got, state, diags)
+	}
+	if got, state, diags := r.AttrInt64("size"); state != ValueUnknown || diags.HasErrors() || got != 0 {
+		t.Errorf("wrong result for unknown size: %d, %s, %#v", got, state, diags)
+	}
+	if got, state, diags := r.AttrStringList("aliases"); state != ValueUnknown || diags.HasErrors() || got != nil {
+		t.Errorf("wrong result for partially-unknown aliases: %#v, %s, %#v", got, state, diags)
+	}
+	if got, state, diags := r.AttrString("note"); state != ValueNull || diags.HasErrors() || got != "" {
+		t.Errorf("wrong result for null note: %q, %s, %#v", got, state, diags)
+	}
+	if got, state, diags := r.AttrStringMap("labels"); state != ValueNull || diags.HasErrors() || got != nil {
+		t.Errorf("wrong result for null labels: %#v, %s, %#v", got, state, diags)
+	}
+
+	t.Run("not a whole number", func(t *testing.T) {
+		_, _, diags := r.AttrInt64("ratio")
+		if !diags.HasErrors() {
+			t.Fatalf("unexpected success")
+		}
+		if got, want := diags[0].Summary, "Unsuitable attribute value"; got != want {
+			t.Errorf("wrong summary %q; want %q", got, want)
+		}
+		if got, want := diags[0].Path, cty.GetAttrPath("ratio"); !got.Equals(want) {
+			t.Errorf("wrong path %#v; want %#v", got, want)
+		}
+	})
+	t.Run("not a bool", func(t *testing.T) {
+		_, _, diags := r.AttrBool("name")
+		if !diags.HasErrors() {
+			t.Fatalf("unexpected success")
+		}
+		if got, want := diags[0].Path, cty.GetAttrPath("name"); !got.Equals(want) {
+			t.Errorf("wrong path %#v; want %#v", got, want)
+		}
+	})
+}
@@ -1,15 +1,464 @@
 package tfobj
 
 import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/gocty"
 )
 
 // Decode attempts to unpack the data from the given reader's underlying object
-// using the gocty package.
+// using DecodeValue.
 func Decode(r ObjectReader, to interface{}) error {
 	obj := r.ObjectVal()
-	return gocty.FromCtyValue(obj, to)
+	return DecodeValue(obj, to)
+}
+
+// DecodeValue attempts to unpack the given value into the value that the given
+// pointer refers to, using the gocty package.
+//
+// Unlike gocty alone, DecodeValue also supports the types in this package that
+// can represent null and unknown values, such as String and List, including
+// within structs, slices, and maps. Other Go types cannot represent unknown
+// values, so DecodeValue returns an error if an unknown value would be decoded
+// into one of them.
+func DecodeValue(val cty.Value, to interface{}) error {
+	return decodeValueTo(val, to, false)
+}
+
+// DecodeValueUnknownAsNull is like DecodeValue except that an unknown value
+// to be decoded into a Go type that cannot represent unknown values is
+// treated as if it were null, rather than causing an error. The types in this
+// package still decode unknown values as unknown.
+//
+// This is for situations where the caller has already accepted that null and
+// unknown may be conflated for such types, such as when presenting a planned
+// object to a provider's apply functions.
+func DecodeValueUnknownAsNull(val cty.Value, to interface{}) error {
+	return decodeValueTo(val, to, true)
+}
+
+func decodeValueTo(val cty.Value, to interface{}, unknownAsNull bool) error {
+	rv := reflect.ValueOf(to)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("target must be a non-nil pointer, not %T", to)
+	}
+	return decodeValue(val, rv.Elem(), nil, unknownAsNull)
+}
+
+// EncodeValue is the opposite of DecodeValue, producing a value of the given
+// type from the given Go value using the gocty package, with the same
+// additional support for the types in this package that can represent null
+// and unknown values.
+func EncodeValue(from interface{}, ty cty.Type) (cty.Value, error) {
+	return encodeValue(reflect.ValueOf(from), ty, nil)
+}
+
+// Encode is the opposite of Decode, populating the given builder with
+// attribute values and nested blocks from the given struct, or pointer to
+// struct, whose fields are annotated with "cty" tags in the same way as for
+// gocty.
+//
+// Attribute values are converted using EncodeValue. Nested blocks of
+// tfschema.NestingSingle or tfschema.NestingGroup mode are populated from
+// struct or struct pointer fields, tfschema.NestingList or
+// tfschema.NestingSet from slices, and tfschema.NestingMap from maps with
+// string keys, with each block populated recursively by the same rules.
+//
+// Any attribute that has no corresponding field in the struct is set to
+// null and any nested block type that has no corresponding field has no
+// blocks. Encode returns an error if any field does not correspond to an
+// attribute or nested block type or if its value is not suitable.
+func Encode(to ObjectBuilderFull, from interface{}) error {
+	return encode(to, reflect.ValueOf(from), nil)
+}
+
+func encode(to ObjectBuilderFull, from reflect.Value, path cty.Path) error {
+	for from.Kind() == reflect.Ptr || from.Kind() == reflect.Interface {
+		if from.IsNil() {
+			return path.NewErrorf("value must not be nil")
+		}
+		from = from.Elem()
+	}
+	if from.Kind() != reflect.Struct {
+		return path.NewErrorf("must be a struct or pointer to struct, not %s", from.Type())
+	}
+
+	schema := to.Schema()
+	fields := make(map[string]reflect.Value)
+	ft := from.Type()
+	for i := 0; i < ft.NumField(); i++ {
+		field := ft.Field(i)
+		name := field.Tag.Get("cty")
+		if name == "" || field.PkgPath != "" {
+			continue
+		}
+		_, isAttr := schema.Attributes[name]
+		_, isBlock := schema.NestedBlockTypes[name]
+		if !isAttr && !isBlock {
+			return path.NewErrorf("field %s: no attribute or nested block type named %q", field.Name, name)
+		}
+		fields[name] = from.Field(i)
+	}
+
+	for name, attrS := range schema.Attributes {
+		fv, ok := fields[name]
+		if !ok {
+			to.SetAttr(name, cty.NullVal(attrS.ImpliedCtyType()))
+			continue
+		}
+		v, err := encodeValue(fv, attrS.ImpliedCtyType(), path.GetAttr(name))
+		if err != nil {
+			return err
+		}
+		to.SetAttr(name, v)
+	}
+
+	for name, blockS := range schema.NestedBlockTypes {
+		fv, ok := fields[name]
+		path := path.GetAttr(name)
+		switch blockS.Nesting {
+		case tfschema.NestingSingle, tfschema.NestingGroup:
+			if !ok || (fv.Kind() == reflect.Ptr && fv.IsNil()) {
+				to.ReplaceBlockSingle(name, nil)
+				continue
+			}
+			nb := to.NewBlockBuilder(name)
+			if err := encode(nb, fv, path); err != nil {
+				return err
+			}
+			to.ReplaceBlockSingle(name, nb)
+
+		case tfschema.NestingList, tfschema.NestingSet:
+			if !ok {
+				to.ReplaceBlocksList(name, nil)
+				continue
+			}
+			if fv.Kind() != reflect.Slice && fv.Kind() != reflect.Array {
+				return path.NewErrorf("must be a slice, not %s", fv.Type())
+			}
+			nbs := make([]ObjectBuilderFull, fv.Len())
+			for i := range nbs {
+				nbs[i] = to.NewBlockBuilder(name)
+				if err := encode(nbs[i], fv.Index(i), path.Index(cty.NumberIntVal(int64(i)))); err != nil {
+					return err
+				}
+			}
+			to.ReplaceBlocksList(name, nbs)
+
+		case tfschema.NestingMap:
+			if !ok {
+				to.ReplaceBlocksMap(name, nil)
+				continue
+			}
+			if fv.Kind() != reflect.Map || fv.Type().Key().Kind() != reflect.String {
+				return path.NewErrorf("must be a map with string keys, not %s", fv.Type())
+			}
+			nbs := make(map[string]ObjectBuilderFull, fv.Len())
+			for _, k := range fv.MapKeys() {
+				key := k.String()
+				nbs[key] = to.NewBlockBuilder(name)
+				if err := encode(nbs[key], fv.MapIndex(k), path.Index(cty.StringVal(key))); err != nil {
+					return err
+				}
+			}
+			to.ReplaceBlocksMap(name, nbs)
+
+		default:
+			return path.NewErrorf("unsupported nesting mode %s", blockS.Nesting)
+		}
+	}
+
+	return nil
 }
 
-// TODO: Also an Encode function that takes an ObjectBuilderFull and populates
-// it with the result of reverse-gocty on a given interface{}.
+// prefixPathError returns the given error with the given path prepended to
+// its own path, if it is a cty.PathError, or as a new cty.PathError otherwise.
+func prefixPathError(prefix cty.Path, err error) error {
+	pErr, ok := err.(cty.PathError)
+	if !ok {
+		return prefix.NewError(err)
+	}
+	path := make(cty.Path, 0, len(prefix)+len(pErr.Path))
+	path = append(path, prefix...)
+	path = append(path, pErr.Path...)
+	return path.NewError(errors.New(pErr.Error()))
+}
+
+// decodeValue decodes the given value into the given addressable target. If
+// unknownAsNull is set then unknown values to be decoded into Go types that
+// cannot represent them are treated as null; otherwise they are an error.
+func decodeValue(val cty.Value, to reflect.Value, path cty.Path, unknownAsNull bool) error {
+	if dec, ok := to.Addr().Interface().(valueDecoder); ok {
+		return dec.decodeCtyValue(val, path)
+	}
+	if !hasValueDecoders(to.Type(), nil) {
+		if unknownAsNull {
+			val = cty.UnknownAsNull(val)
+		}
+		if err := gocty.FromCtyValue(val, to.Addr().Interface()); err != nil {
+			return prefixPathError(path, err)
+		}
+		return nil
+	}
+
+	// If we get here then there's at least one of our own value types
+	// somewhere inside the target, so we must walk the value ourselves in
+	// order to give them the opportunity to see any unknown values. Only a
+	// pointer can pass an unknown value on to one of those types, because
+	// the containers themselves cannot be unknown.
+	if !val.IsKnown() {
+		switch {
+		case unknownAsNull:
+			val = cty.NullVal(val.Type())
+		case to.Kind() != reflect.Ptr:
+			return path.NewErrorf("value must be known")
+		}
+	}
+	ty := val.Type()
+	switch to.Kind() {
+	case reflect.Ptr:
+		if val.IsNull() {
+			to.Set(reflect.Zero(to.Type()))
+			return nil
+		}
+		if to.IsNil() {
+			to.Set(reflect.New(to.Type().Elem()))
+		}
+		return decodeValue(val, to.Elem(), path, unknownAsNull)
+
+	case reflect.Struct:
+		if val.IsNull() {
+			return path.NewErrorf("value must not be null")
+		}
+		if !ty.IsObjectType() {
+			return path.NewErrorf("object or map value is required")
+		}
+		fields := structFieldIndices(to.Type())
+		for name, idx := range fields {
+			if !ty.HasAttribute(name) {
+				field := to.Field(idx)
+				if dec, ok := field.Addr().Interface().(valueDecoder); ok {
+					if err := dec.decodeCtyValue(cty.NullVal(cty.DynamicPseudoType), path.GetAttr(name)); err != nil {
+						return err
+					}
+					continue
+				}
+				switch field.Kind() {
+				case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface:
+					field.Set(reflect.Zero(field.Type()))
+				default:
+					return path.NewErrorf("missing required attribute %q", name)
+				}
+			}
+		}
+		for name := range ty.AttributeTypes() {
+			idx, ok := fields[name]
+			if !ok {
+				return path.NewErrorf("unsupported attribute %q", name)
+			}
+			if err := decodeValue(val.GetAttr(name), to.Field(idx), path.GetAttr(name), unknownAsNull); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case reflect.Slice:
+		if val.IsNull() {
+			to.Set(reflect.Zero(to.Type()))
+			return nil
+		}
+		if !(ty.IsListType() || ty.IsSetType() || ty.IsTupleType()) {
+			return path.NewErrorf("list, set, or tuple value is required")
+		}
+		elems := reflect.MakeSlice(to.Type(), val.LengthInt(), val.LengthInt())
+		i := 0
+		for it := val.ElementIterator(); it.Next(); i++ {
+			k, ev := it.Element()
+			if err := decodeValue(ev, elems.Index(i), path.Index(k), unknownAsNull); err != nil {
+				return err
+			}
+		}
+		to.Set(elems)
+		return nil
+
+	case reflect.Map:
+		if val.IsNull() {
+			to.Set(reflect.Zero(to.Type()))
+			return nil
+		}
+		if !(ty.IsMapType() || ty.IsObjectType()) {
+			return path.NewErrorf("map or object value is required")
+		}
+		elems := reflect.MakeMapWithSize(to.Type(), val.LengthInt())
+		for it := val.ElementIterator(); it.Next(); {
+			k, ev := it.Element()
+			elem := reflect.New(to.Type().Elem()).Elem()
+			if err := decodeValue(ev, elem, path.Index(k), unknownAsNull); err != nil {
+				return err
+			}
+			elems.SetMapIndex(reflect.ValueOf(k.AsString()).Convert(to.Type().Key()), elem)
+		}
+		to.Set(elems)
+		return nil
+
+	default:
+		// Should never get here, because hasValueDecoders returns false for
+		// all other kinds.
+		return path.NewErrorf("unsupported target type %s", to.Type())
+	}
+}
+
+func encodeValue(from reflect.Value, ty cty.Type, path cty.Path) (cty.Value, error) {
+	if !from.IsValid() {
+		return cty.NullVal(ty), nil
+	}
+	if enc, ok := from.Interface().(valueEncoder); ok {
+		return enc.encodeCtyValue(ty, path)
+	}
+	if !hasValueDecoders(from.Type(), nil) {
+		ret, err := gocty.ToCtyValue(from.Interface(), ty)
+		if err != nil {
+			return cty.NilVal, prefixPathError(path, err)
+		}
+		return ret, nil
+	}
+
+	switch from.Kind() {
+	case reflect.Ptr:
+		if from.IsNil() {
+			return cty.NullVal(ty), nil
+		}
+		return encodeValue(from.Elem(), ty, path)
+
+	case reflect.Struct:
+		if !ty.IsObjectType() {
+			return cty.NilVal, path.NewErrorf("%s object is not compatible with %s", from.Type(), ty.FriendlyName())
+		}
+		fields := structFieldIndices(from.Type())
+		for name := range fields {
+			if !ty.HasAttribute(name) {
+				return cty.NilVal, path.NewErrorf("unsupported attribute %q", name)
+			}
+		}
+		vals := make(map[string]cty.Value)
+		for name, aty := range ty.AttributeTypes() {
+			idx, ok := fields[name]
+			if !ok {
+				vals[name] = cty.NullVal(aty)
+				continue
+			}
+			v, err := encodeValue(from.Field(idx), aty, path.GetAttr(name))
+			if err != nil {
+				return cty.NilVal, err
+			}
+			vals[name] = v
+		}
+		return cty.ObjectVal(vals), nil
+
+	case reflect.Slice:
+		if from.IsNil() {
+			return cty.NullVal(ty), nil
+		}
+		var vals []cty.Value
+		for i := 0; i < from.Len(); i++ {
+			path := path.Index(cty.NumberIntVal(int64(i)))
+			var ety cty.Type
+			switch {
+			case ty.IsListType() || ty.IsSetType():
+				ety = ty.ElementType()
+			case ty.IsTupleType() && i < len(ty.TupleElementTypes()):
+				ety = ty.TupleElementType(i)
+			case ty == cty.DynamicPseudoType:
+				ety = cty.DynamicPseudoType
+			default:
+				return cty.NilVal, path.NewErrorf("%s slice is not compatible with %s", from.Type(), ty.FriendlyName())
+			}
+			v, err := encodeValue(from.Index(i), ety, path)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			vals = append(vals, v)
+		}
+		return encodePrimitive(cty.TupleVal(vals), ty, path)
+
+	case reflect.Map:
+		if from.IsNil() {
+			return cty.NullVal(ty), nil
+		}
+		vals := make(map[string]cty.Value, from.Len())
+		for _, k := range from.MapKeys() {
+			key := k.String()
+			path := path.Index(cty.StringVal(key))
+			var ety cty.Type
+			switch {
+			case ty.IsMapType():
+				ety = ty.ElementType()
+			case ty.IsObjectType() && ty.HasAttribute(key):
+				ety = ty.AttributeType(key)
+			case ty == cty.DynamicPseudoType:
+				ety = cty.DynamicPseudoType
+			default:
+				return cty.NilVal, path.NewErrorf("%s map is not compatible with %s", from.Type(), ty.FriendlyName())
+			}
+			v, err := encodeValue(from.MapIndex(k), ety, path)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			vals[key] = v
+		}
+		return encodePrimitive(cty.ObjectVal(vals), ty, path)
+
+	default:
+		// Should never get here, because hasValueDecoders returns false for
+		// all other kinds.
+		return cty.NilVal, path.NewErrorf("unsupported type %s", from.Type())
+	}
+}
+
+// hasValueDecoders returns true if the given type is, or contains, one of the
+// types in this package that can represent null and unknown values, in which
+// case DecodeValue and EncodeValue must handle it rather than just using
+// gocty directly.
+func hasValueDecoders(rt reflect.Type, seen map[reflect.Type]bool) bool {
+	if reflect.PtrTo(rt).Implements(valueDecoderType) {
+		return true
+	}
+	if seen[rt] {
+		return false
+	}
+	if seen == nil {
+		seen = make(map[reflect.Type]bool)
+	}
+	seen[rt] = true
+
+	switch rt.Kind() {
+	case reflect.Ptr, reflect.Slice:
+		return hasValueDecoders(rt.Elem(), seen)
+	case reflect.Map:
+		return rt.Key().Kind() == reflect.String && hasValueDecoders(rt.Elem(), seen)
+	case reflect.Struct:
+		for _, idx := range structFieldIndices(rt) {
+			if hasValueDecoders(rt.Field(idx).Type, seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// structFieldIndices returns the indices of the fields of the given struct
+// type that have "cty" tags, keyed by tag value.
+func structFieldIndices(rt reflect.Type) map[string]int {
+	ret := make(map[string]int)
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if name := field.Tag.Get("cty"); name != "" && field.PkgPath == "" {
+			ret[name] = i
+		}
+	}
+	return ret
+}
@@ -0,0 +1,156 @@
+package tfobj
+
+import (
+	"testing"
+
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestEncode(t *testing.T) {
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"id":   {Type: cty.String, Computed: true},
+			"name": {Type: cty.String, Required: true},
+			"tags": {Type: cty.Map(cty.String), Optional: true},
+		},
+		NestedBlockTypes: map[string]*tfschema.NestedBlockType{
+			"access": {
+				Nesting: tfschema.NestingGroup,
+				Content: tfschema.BlockType{
+					Attributes: map[string]*tfschema.Attribute{
+						"policy": {Type: cty.String, Optional: true},
+					},
+				},
+			},
+			"placement": {
+				Nesting: tfschema.NestingSingle,
+				Content: tfschema.BlockType{
+					Attributes: map[string]*tfschema.Attribute{
+						"zone": {Type: cty.String, Required: true},
+					},
+				},
+			},
+			"disk": {
+				Nesting: tfschema.NestingList,
+				Content: tfschema.BlockType{
+					Attributes: map[string]*tfschema.Attribute{
+						"size": {Type: cty.Number, Required: true},
+					},
+				},
+			},
+			"network_interface": {
+				Nesting: tfschema.NestingMap,
+				Content: tfschema.BlockType{
+					Attributes: map[string]*tfschema.Attribute{
+						"public": {Type: cty.Bool, Optional: true},
+					},
+				},
+			},
+		},
+	}
+	type disk struct {
+		Size int `cty:"size"`
+	}
+	type networkInterface struct {
+		Public bool `cty:"public"`
+	}
+	type placement struct {
+		Zone string `cty:"zone"`
+	}
+	type instance struct {
+		Name              string                       `cty:"name"`
+		Tags              map[string]string            `cty:"tags"`
+		Placement         *placement                   `cty:"placement"`
+		Disks             []disk                       `cty:"disk"`
+		NetworkInterfaces map[string]*networkInterface `cty:"network_interface"`
+	}
+
+	t.Run("full", func(t *testing.T) {
+		b := NewObjectBuilderFull(schema, cty.NilVal)
+		err := Encode(b, &instance{
+			Name:      "example",
+			Placement: &placement{Zone: "a"},
+			Disks:     []disk{{Size: 10}, {Size: 20}},
+			NetworkInterfaces: map[string]*networkInterface{
+				"eth0": {Public: true},
+			},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		got := b.ObjectVal()
+		want := cty.ObjectVal(map[string]cty.Value{
+			"id":   cty.NullVal(cty.String),
+			"name": cty.StringVal("example"),
+			"tags": cty.NullVal(cty.Map(cty.String)),
+			"access": cty.ObjectVal(map[string]cty.Value{
+				"policy": cty.NullVal(cty.String),
+			}),
+			"placement": cty.ObjectVal(map[string]cty.Value{
+				"zone": cty.StringVal("a"),
+			}),
+			"disk": cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{"size": cty.NumberIntVal(10)}),
+				cty.ObjectVal(map[string]cty.Value{"size": cty.NumberIntVal(20)}),
+			}),
+			"network_interface": cty.MapVal(map[string]cty.Value{
+				"eth0": cty.ObjectVal(map[string]cty.Value{"public": cty.True}),
+			}),
+		})
+		if !want.RawEquals(got) {
+			t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, want)
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		b := NewObjectBuilderFull(schema, cty.NilVal)
+		if err := Encode(b, instance{Name: "example"}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		got := b.ObjectVal()
+		if got, want := got.GetAttr("placement"), cty.NullVal(schema.NestedBlockTypes["placement"].Content.ImpliedCtyType()); !want.RawEquals(got) {
+			t.Errorf("wrong placement\ngot:  %#v\nwant: %#v", got, want)
+		}
+		if got := got.GetAttr("disk").LengthInt(); got != 0 {
+			t.Errorf("wrong number of disks %d; want 0", got)
+		}
+		if got := got.GetAttr("network_interface").LengthInt(); got != 0 {
+			t.Errorf("wrong number of network interfaces %d; want 0", got)
+		}
+	})
+	t.Run("unknown field", func(t *testing.T) {
+		b := NewObjectBuilderFull(schema, cty.NilVal)
+		err := Encode(b, struct {
+			Name  string `cty:"name"`
+			Color string `cty:"color"`
+		}{})
+		if err == nil {
+			t.Fatalf("unexpected success")
+		}
+		if got, want := err.Error(), `field Color: no attribute or nested block type named "color"`; got != want {
+			t.Errorf("wrong error\ngot:  %s\nwant: %s", got, want)
+		}
+	})
+	t.Run("unsuitable value", func(t *testing.T) {
+		b := NewObjectBuilderFull(schema, cty.NilVal)
+		err := Encode(b, struct {
+			Disks []struct {
+				Size string `cty:"size"`
+			} `cty:"disk"`
+		}{
+			Disks: []struct {
+				Size string `cty:"size"`
+			}{{Size: "big"}},
+		})
+		if err == nil {
+			t.Fatalf("unexpected success")
+		}
+		pErr, ok := err.(cty.PathError)
+		if !ok {
+			t.Fatalf("error is %T, not cty.PathError", err)
+		}
+		if want := cty.GetAttrPath("disk").Index(cty.NumberIntVal(0)).GetAttr("size"); !want.Equals(pErr.Path) {
+			t.Errorf("wrong error path %#v; want %#v", pErr.Path, want)
+		}
+	})
+}
@@ -29,6 +29,16 @@ type ObjectBuilder interface {
 	// type constraint given for the attribute in the schema.
 	SetAttr(name string, val cty.Value)
 
+	// SetPath replaces the value at the given path within the object, which
+	// must refer either to an attribute or to a value nested inside an
+	// attribute's value. The path may pass through existing nested blocks,
+	// but cannot refer to a nested block as a whole.
+	//
+	// Unlike SetAttr, SetPath does not panic if the path or value is not
+	// valid, and instead returns error diagnostics and leaves the object
+	// unchanged.
+	SetPath(path cty.Path, val cty.Value) sdkdiags.Diagnostics
+
 	// The Block... family of methods echoes the methods with similar names on
 	// ObjectReader but each returns an ObjectBuilder that can be used to
 	// mutate the content of the requested block.
@@ -107,26 +117,41 @@ type objectBuilder struct {
 	singleBlocks map[string]*objectBuilder
 	listBlocks   map[string][]*objectBuilder
 	mapBlocks    map[string]map[string]*objectBuilder
+
+	// unknownBlocks holds the values of any nested block types whose blocks
+	// are not known yet. The builder cannot represent their individual
+	// blocks, so it returns these values verbatim from ObjectVal.
+	unknownBlocks map[string]cty.Value
 }
 
 func newObjectBuilder(schema *tfschema.BlockType, initial cty.Value) *objectBuilder {
 	ret := &objectBuilder{
-		schema:       schema,
-		attrs:        make(map[string]cty.Value),
-		singleBlocks: make(map[string]*objectBuilder),
-		listBlocks:   make(map[string][]*objectBuilder),
-		mapBlocks:    make(map[string]map[string]*objectBuilder),
+		schema:        schema,
+		attrs:         make(map[string]cty.Value),
+		singleBlocks:  make(map[string]*objectBuilder),
+		listBlocks:    make(map[string][]*objectBuilder),
+		mapBlocks:     make(map[string]map[string]*objectBuilder),
+		unknownBlocks: make(map[string]cty.Value),
 	}
 
 	for name, attrS := range schema.Attributes {
 		if initial == cty.NilVal {
-			ret.attrs[name] = cty.NullVal(attrS.Type)
+			ret.attrs[name] = cty.NullVal(attrS.ImpliedCtyType())
 			continue
 		}
 		ret.attrs[name] = initial.GetAttr(name)
 	}
 
 	for name, blockS := range schema.NestedBlockTypes {
+		if initial != cty.NilVal {
+			if nv := initial.GetAttr(name); !nv.IsKnown() {
+				ret.unknownBlocks[name] = nv
+				if blockS.Nesting == tfschema.NestingSingle || blockS.Nesting == tfschema.NestingGroup {
+					ret.singleBlocks[name] = nil
+				}
+				continue
+			}
+		}
 		switch blockS.Nesting {
 		case tfschema.NestingSingle, tfschema.NestingGroup:
 			if initial == cty.NilVal {
@@ -186,6 +211,17 @@ func (b *objectBuilder) ObjectVal() cty.Value {
 		vals[name] = val
 	}
 	for name, nb := range b.singleBlocks {
+		if nb == nil {
+			blockS := b.schema.NestedBlockTypes[name]
+			if blockS.Nesting == tfschema.NestingGroup {
+				// A group block is never null, so an absent block is
+				// represented as if all of its arguments were unset.
+				nb = newObjectBuilder(&blockS.Content, cty.NilVal)
+			} else {
+				vals[name] = cty.NullVal(blockS.Content.ImpliedCtyType())
+				continue
+			}
+		}
 		vals[name] = nb.ObjectVal()
 	}
 	for name, nbs := range b.listBlocks {
@@ -240,6 +276,9 @@ func (b *objectBuilder) ObjectVal() cty.Value {
 			vals[name] = cty.MapVal(subVals)
 		}
 	}
+	for name, nv := range b.unknownBlocks {
+		vals[name] = nv
+	}
 	return cty.ObjectVal(vals)
 }
 
@@ -255,7 +294,7 @@ func (b *objectBuilder) SetAttr(name string, val cty.Value) {
 	if !ok {
 		panic(fmt.Sprintf("no attribute named %q", name))
 	}
-	val, err := convert.Convert(val, attrS.Type)
+	val, err := convert.Convert(val, attrS.ImpliedCtyType())
 	if err != nil {
 		panic(fmt.Sprintf("unsuitable value for %q: %s", name, sdkdiags.FormatError(err)))
 	}
@@ -409,9 +448,10 @@ func (b objectBuilderFull) NewBlockBuilder(typeName string) ObjectBuilderFull {
 
 func (b objectBuilderFull) ReplaceBlockSingle(typeName string, nb ObjectBuilderFull) {
 	blockS, ok := b.schema.NestedBlockTypes[typeName]
-	if !ok || blockS.Nesting != tfschema.NestingSingle {
-		panic(fmt.Sprintf("%q is not a nested block type of tfschema.NestingSingle", typeName))
+	if !ok || (blockS.Nesting != tfschema.NestingSingle && blockS.Nesting != tfschema.NestingGroup) {
+		panic(fmt.Sprintf("%q is not a nested block type of tfschema.NestingSingle or tfschema.NestingGroup", typeName))
 	}
+	delete(b.objectBuilder.unknownBlocks, typeName)
 	if nb == nil {
 		b.objectBuilder.singleBlocks[typeName] = nil
 		return
@@ -424,6 +464,7 @@ func (b objectBuilderFull) ReplaceBlocksList(typeName string, nbs []ObjectBuilde
 	if !ok || (blockS.Nesting != tfschema.NestingList && blockS.Nesting != tfschema.NestingSet) {
 		panic(fmt.Sprintf("%q is not a nested block type of tfschema.NestingList or tfschema.NestingSet", typeName))
 	}
+	delete(b.objectBuilder.unknownBlocks, typeName)
 	if len(nbs) == 0 {
 		b.objectBuilder.listBlocks[typeName] = make([]*objectBuilder, 0)
 		return
@@ -440,8 +481,9 @@ func (b objectBuilderFull) ReplaceBlocksMap(typeName string, nbs map[string]Obje
 	if !ok || blockS.Nesting != tfschema.NestingMap {
 		panic(fmt.Sprintf("%q is not a nested block type of tfschema.NestingMap", typeName))
 	}
+	delete(b.objectBuilder.unknownBlocks, typeName)
 	if len(nbs) == 0 {
-		b.objectBuilder.listBlocks[typeName] = make([]*objectBuilder, 0)
+		b.objectBuilder.mapBlocks[typeName] = make(map[string]*objectBuilder)
 		return
 	}
 	new := make(map[string]*objectBuilder, len(nbs))
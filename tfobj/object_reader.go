@@ -3,6 +3,7 @@ package tfobj
 import (
 	"fmt"
 
+	"github.com/apparentlymart/terraform-sdk/internal/sdkdiags"
 	"github.com/apparentlymart/terraform-sdk/tfschema"
 	"github.com/zclconf/go-cty/cty"
 )
@@ -23,6 +24,21 @@ type ObjectReader interface {
 	// in its schema.
 	Attr(name string) cty.Value
 
+	// The "Attr..." family of typed accessors each return the value for the
+	// attribute of the given name as a Go value, converting it first to the
+	// corresponding cty type. Like Attr, they panic if the given name is not
+	// defined as an attribute for this object in its schema.
+	//
+	// The ValueState result is ValueNull if the value is null or
+	// ValueUnknown if it is not wholly known, in which case the Go value is
+	// its zero value. If the value cannot be converted then the result also
+	// has error diagnostics whose paths are relative to this object.
+	AttrString(name string) (string, ValueState, sdkdiags.Diagnostics)
+	AttrInt64(name string) (int64, ValueState, sdkdiags.Diagnostics)
+	AttrBool(name string) (bool, ValueState, sdkdiags.Diagnostics)
+	AttrStringList(name string) ([]string, ValueState, sdkdiags.Diagnostics)
+	AttrStringMap(name string) (map[string]string, ValueState, sdkdiags.Diagnostics)
+
 	// BlockCount returns the number of blocks present of the given type, or
 	// panics if the given name isn't declared as a block type in the schema.
 	BlockCount(blockType string) int
@@ -45,6 +61,17 @@ type ObjectReader interface {
 	BlockMap(blockType string) map[string]ObjectReader
 	BlockFromList(blockType string, idx int) ObjectReader
 	BlockFromMap(blockType string, key string) ObjectReader
+
+	// GetPath returns the value at the given path within the object. The
+	// path may refer to an attribute, to a nested block or collection of
+	// nested blocks, or to a value nested inside an attribute's value, such
+	// as the path of a diagnostic returned during validation.
+	//
+	// If the path passes through a null or unknown value then the result is
+	// a null or unknown value, respectively, of the type the path refers to.
+	// If the path is not valid for the object's schema or value then the
+	// result is cty.DynamicVal along with error diagnostics.
+	GetPath(path cty.Path) (cty.Value, sdkdiags.Diagnostics)
 }
 
 // NewObjectReader constructs a new ObjectReader for reading the given object
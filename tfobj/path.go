@@ -0,0 +1,409 @@
+package tfobj
+
+import (
+	"fmt"
+
+	"github.com/apparentlymart/terraform-sdk/internal/sdkdiags"
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+)
+
+func (r *objectReaderVal) GetPath(path cty.Path) (cty.Value, sdkdiags.Diagnostics) {
+	return getPath(r.schema, r.v, path)
+}
+
+func (b *objectBuilder) GetPath(path cty.Path) (cty.Value, sdkdiags.Diagnostics) {
+	return getPath(b.schema, b.ObjectVal(), path)
+}
+
+func (b *planBuilder) GetPath(path cty.Path) (cty.Value, sdkdiags.Diagnostics) {
+	if b.planned == nil {
+		var diags sdkdiags.Diagnostics
+		diags = diags.Append(pathError(path, "the object is planned to be deleted, so it has no planned values"))
+		return cty.DynamicVal, diags
+	}
+	return b.planned.GetPath(path)
+}
+
+func (b *objectBuilder) SetPath(path cty.Path, val cty.Value) sdkdiags.Diagnostics {
+	return b.setPath(nil, path, val)
+}
+
+// setPath is the main implementation of SetPath. The base path is the path
+// of the receiving builder's object within the object that SetPath was called
+// on, and is used only to construct the paths of diagnostics.
+func (b *objectBuilder) setPath(base, path cty.Path, val cty.Value) sdkdiags.Diagnostics {
+	var diags sdkdiags.Diagnostics
+	full := make(cty.Path, 0, len(base)+len(path))
+	full = append(full, base...)
+	full = append(full, path...)
+	stepPath := func(n int) cty.Path {
+		return full[:len(base)+n]
+	}
+
+	if len(path) == 0 {
+		diags = diags.Append(pathError(full, "can set only attribute values, not whole blocks"))
+		return diags
+	}
+	name, ok := pathStepAttrName(path[0])
+	if !ok {
+		diags = diags.Append(pathError(stepPath(1), "must be an attribute or nested block type name"))
+		return diags
+	}
+
+	if attrS, ok := b.schema.Attributes[name]; ok {
+		newV, diags := setValuePath(b.attrs[name], attrS.ImpliedCtyType(), stepPath(1), path[1:], val)
+		if !diags.HasErrors() {
+			b.attrs[name] = newV
+		}
+		return diags
+	}
+
+	blockS, ok := b.schema.NestedBlockTypes[name]
+	if !ok {
+		diags = diags.Append(pathError(stepPath(1), fmt.Sprintf("there is no attribute or nested block type named %q", name)))
+		return diags
+	}
+	switch blockS.Nesting {
+	case tfschema.NestingSingle, tfschema.NestingGroup:
+		nb := b.singleBlocks[name]
+		if nb == nil {
+			diags = diags.Append(pathError(stepPath(1), fmt.Sprintf("there is no %q block", name)))
+			return diags
+		}
+		return nb.setPath(stepPath(1), path[1:], val)
+	case tfschema.NestingList, tfschema.NestingMap:
+		if len(path) == 1 {
+			diags = diags.Append(pathError(full, "can set only attribute values, not whole blocks"))
+			return diags
+		}
+		key, ok := blockIndexKey(blockS, path[1])
+		if !ok {
+			diags = diags.Append(pathError(stepPath(2), "must be an index into a collection of nested blocks"))
+			return diags
+		}
+		var nb *objectBuilder
+		if blockS.Nesting == tfschema.NestingList {
+			if idx, ok := pathListIndex(key, len(b.listBlocks[name])); ok {
+				nb = b.listBlocks[name][idx]
+			}
+		} else {
+			nb = b.mapBlocks[name][key.AsString()]
+		}
+		if nb == nil {
+			diags = diags.Append(pathError(stepPath(2), fmt.Sprintf("there is no %q block with this key", name)))
+			return diags
+		}
+		return nb.setPath(stepPath(2), path[2:], val)
+	default:
+		diags = diags.Append(pathError(stepPath(1), fmt.Sprintf("cannot address individual %q blocks because they are a set", name)))
+		return diags
+	}
+}
+
+func (b *planBuilder) SetPath(path cty.Path, val cty.Value) sdkdiags.Diagnostics {
+	if b.planned == nil {
+		var diags sdkdiags.Diagnostics
+		diags = diags.Append(pathError(path, "the object is planned to be deleted, so its plan cannot be altered"))
+		return diags
+	}
+	return b.planned.SetPath(path, val)
+}
+
+// getPath returns the value at the given path within the given object, which
+// must conform to the given schema.
+//
+// If the path traverses through a null or unknown value then the result is
+// a null or unknown value, respectively, of the type that the path would
+// otherwise refer to.
+func getPath(schema *tfschema.BlockType, obj cty.Value, path cty.Path) (cty.Value, sdkdiags.Diagnostics) {
+	var diags sdkdiags.Diagnostics
+	val := obj
+	block := schema
+	var blocks *tfschema.NestedBlockType
+
+	for i, step := range path {
+		stepPath := path[:i+1]
+		switch {
+		case blocks != nil:
+			// The previous step selected a collection of nested blocks, so
+			// this step must select one of them.
+			key, ok := blockIndexKey(blocks, step)
+			if !ok {
+				diags = diags.Append(pathError(stepPath, "must be an index into a collection of nested blocks"))
+				return cty.DynamicVal, diags
+			}
+			var err error
+			val, err = pathIndex(val, key, blocks.Content.ImpliedCtyType())
+			if err != nil {
+				diags = diags.Append(pathError(stepPath, err.Error()))
+				return cty.DynamicVal, diags
+			}
+			block, blocks = &blocks.Content, nil
+
+		case block != nil:
+			name, ok := pathStepAttrName(step)
+			if !ok {
+				diags = diags.Append(pathError(stepPath, "must be an attribute or nested block type name"))
+				return cty.DynamicVal, diags
+			}
+			if attrS, ok := block.Attributes[name]; ok {
+				val = pathGetAttr(val, name, attrS.ImpliedCtyType())
+				block = nil
+				continue
+			}
+			blockS, ok := block.NestedBlockTypes[name]
+			if !ok {
+				diags = diags.Append(pathError(stepPath, fmt.Sprintf("there is no attribute or nested block type named %q", name)))
+				return cty.DynamicVal, diags
+			}
+			val = pathGetAttr(val, name, block.ImpliedCtyType().AttributeType(name))
+			switch blockS.Nesting {
+			case tfschema.NestingSingle, tfschema.NestingGroup:
+				block = &blockS.Content
+			case tfschema.NestingList, tfschema.NestingMap:
+				block, blocks = nil, blockS
+			default:
+				if i+1 < len(path) {
+					diags = diags.Append(pathError(stepPath, fmt.Sprintf("cannot address individual %q blocks because they are a set", name)))
+					return cty.DynamicVal, diags
+				}
+			}
+
+		default:
+			// We're now inside an attribute value, so the remaining steps
+			// follow the value's type.
+			var err error
+			val, err = pathValueStep(val, step)
+			if err != nil {
+				diags = diags.Append(pathError(stepPath, err.Error()))
+				return cty.DynamicVal, diags
+			}
+		}
+	}
+
+	return val, diags
+}
+
+// setValuePath returns a copy of the given value, which has the given type,
+// with the value at the given relative path replaced by the given new value.
+// The base path is used only to construct the paths of diagnostics.
+func setValuePath(cur cty.Value, ty cty.Type, base, path cty.Path, val cty.Value) (cty.Value, sdkdiags.Diagnostics) {
+	var diags sdkdiags.Diagnostics
+	if len(path) == 0 {
+		newV, err := convert.Convert(val, ty)
+		if err != nil {
+			diags = diags.Append(sdkdiags.Diagnostic{
+				Severity: sdkdiags.Error,
+				Summary:  "Unsuitable value for path",
+				Detail:   fmt.Sprintf("The given value is not suitable for %s: %s.", sdkdiags.FormatPath(base), sdkdiags.FormatError(err)),
+				Path:     base,
+			})
+			return cur, diags
+		}
+		return newV, diags
+	}
+
+	stepPath := append(base.Copy(), path[0])
+	if cur.IsNull() || !cur.IsKnown() {
+		diags = diags.Append(pathError(stepPath, "cannot set a value inside a null or unknown value"))
+		return cur, diags
+	}
+	curTy := cur.Type()
+
+	switch step := path[0].(type) {
+	case cty.GetAttrStep:
+		if !curTy.IsObjectType() || !curTy.HasAttribute(step.Name) {
+			diags = diags.Append(pathError(stepPath, fmt.Sprintf("there is no attribute named %q", step.Name)))
+			return cur, diags
+		}
+		attrs := cur.AsValueMap()
+		attrs[step.Name], diags = setValuePath(attrs[step.Name], curTy.AttributeType(step.Name), stepPath, path[1:], val)
+		return cty.ObjectVal(attrs), diags
+
+	case cty.IndexStep:
+		switch {
+		case curTy.IsListType() || curTy.IsTupleType():
+			elems := cur.AsValueSlice()
+			idx, ok := pathListIndex(step.Key, len(elems))
+			if !ok {
+				diags = diags.Append(pathError(stepPath, "there is no element with this index"))
+				return cur, diags
+			}
+			ety := elems[idx].Type()
+			if curTy.IsListType() {
+				ety = curTy.ElementType()
+			}
+			elems[idx], diags = setValuePath(elems[idx], ety, stepPath, path[1:], val)
+			if curTy.IsListType() {
+				return cty.ListVal(elems), diags
+			}
+			return cty.TupleVal(elems), diags
+
+		case curTy.IsMapType():
+			if step.Key.Type() != cty.String || !step.Key.IsKnown() || step.Key.IsNull() {
+				diags = diags.Append(pathError(stepPath, "must be a string key"))
+				return cur, diags
+			}
+			elems := cur.AsValueMap()
+			if elems == nil {
+				elems = make(map[string]cty.Value)
+			}
+			key := step.Key.AsString()
+			ev, exists := elems[key]
+			if !exists {
+				if len(path) > 1 {
+					diags = diags.Append(pathError(stepPath, "there is no element with this key"))
+					return cur, diags
+				}
+				ev = cty.NullVal(curTy.ElementType())
+			}
+			elems[key], diags = setValuePath(ev, curTy.ElementType(), stepPath, path[1:], val)
+			return cty.MapVal(elems), diags
+		}
+	}
+
+	diags = diags.Append(pathError(stepPath, fmt.Sprintf("cannot set an element of a %s", curTy.FriendlyName())))
+	return cur, diags
+}
+
+// pathValueStep applies the given step to the given value, returning a null
+// or unknown result of the appropriate type if the value is null or unknown.
+func pathValueStep(val cty.Value, step cty.PathStep) (cty.Value, error) {
+	ty := val.Type()
+	if ty == cty.DynamicPseudoType {
+		if !val.IsKnown() {
+			return cty.DynamicVal, nil
+		}
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	}
+
+	switch step := step.(type) {
+	case cty.GetAttrStep:
+		if !ty.IsObjectType() || !ty.HasAttribute(step.Name) {
+			return cty.NilVal, fmt.Errorf("there is no attribute named %q", step.Name)
+		}
+		return pathGetAttr(val, step.Name, ty.AttributeType(step.Name)), nil
+	case cty.IndexStep:
+		var ety cty.Type
+		switch {
+		case ty.IsListType() || ty.IsMapType():
+			ety = ty.ElementType()
+		case ty.IsTupleType():
+			idx, ok := pathListIndex(step.Key, len(ty.TupleElementTypes()))
+			if !ok {
+				return cty.NilVal, fmt.Errorf("there is no element with this index")
+			}
+			ety = ty.TupleElementType(idx)
+		default:
+			return cty.NilVal, fmt.Errorf("cannot access an element of a %s", ty.FriendlyName())
+		}
+		return pathIndex(val, step.Key, ety)
+	default:
+		return cty.NilVal, fmt.Errorf("unsupported path step")
+	}
+}
+
+// pathGetAttr returns the given attribute of the given object, or a null or
+// unknown value of the given type if the object is null or unknown.
+func pathGetAttr(obj cty.Value, name string, ty cty.Type) cty.Value {
+	switch {
+	case !obj.IsKnown():
+		return cty.UnknownVal(ty)
+	case obj.IsNull():
+		return cty.NullVal(ty)
+	default:
+		return obj.GetAttr(name)
+	}
+}
+
+// pathIndex returns the element of the given collection with the given key,
+// or a null or unknown value of the given type if the collection is null or
+// unknown.
+func pathIndex(coll cty.Value, key cty.Value, ty cty.Type) (cty.Value, error) {
+	switch {
+	case !coll.IsKnown():
+		return cty.UnknownVal(ty), nil
+	case coll.IsNull():
+		return cty.NullVal(ty), nil
+	}
+
+	collTy := coll.Type()
+	switch {
+	case collTy.IsListType() || collTy.IsTupleType():
+		idx, ok := pathListIndex(key, coll.LengthInt())
+		if !ok {
+			return cty.NilVal, fmt.Errorf("there is no element with this index")
+		}
+		return coll.Index(cty.NumberIntVal(int64(idx))), nil
+	case collTy.IsMapType() || collTy.IsObjectType():
+		if key.Type() != cty.String || !key.IsKnown() || key.IsNull() {
+			return cty.NilVal, fmt.Errorf("must be a string key")
+		}
+		// Object values are used for maps of nested blocks that contain
+		// dynamically-typed attributes.
+		if collTy.IsObjectType() {
+			if !collTy.HasAttribute(key.AsString()) {
+				return cty.NilVal, fmt.Errorf("there is no element with this key")
+			}
+			return coll.GetAttr(key.AsString()), nil
+		}
+		if !coll.HasIndex(key).True() {
+			return cty.NilVal, fmt.Errorf("there is no element with this key")
+		}
+		return coll.Index(key), nil
+	default:
+		return cty.NilVal, fmt.Errorf("cannot access an element of a %s", collTy.FriendlyName())
+	}
+}
+
+// pathListIndex returns the given key as an index into a sequence of the
+// given length, or false if it is not a valid index.
+func pathListIndex(key cty.Value, length int) (int, bool) {
+	if key.Type() != cty.Number || !key.IsKnown() || key.IsNull() {
+		return 0, false
+	}
+	idx, acc := key.AsBigFloat().Int64()
+	if acc != 0 || idx < 0 || idx >= int64(length) {
+		return 0, false
+	}
+	return int(idx), true
+}
+
+// blockIndexKey returns the key of the given step if it is a suitable index
+// into a collection of nested blocks of the given type.
+func blockIndexKey(blockS *tfschema.NestedBlockType, step cty.PathStep) (cty.Value, bool) {
+	idx, ok := step.(cty.IndexStep)
+	if !ok || !idx.Key.IsKnown() || idx.Key.IsNull() {
+		return cty.NilVal, false
+	}
+	switch blockS.Nesting {
+	case tfschema.NestingList:
+		return idx.Key, idx.Key.Type() == cty.Number
+	case tfschema.NestingMap:
+		return idx.Key, idx.Key.Type() == cty.String
+	default:
+		return cty.NilVal, false
+	}
+}
+
+// pathStepAttrName returns the attribute name from the given step, or false
+// if it is not a GetAttrStep.
+func pathStepAttrName(step cty.PathStep) (string, bool) {
+	if ga, ok := step.(cty.GetAttrStep); ok {
+		return ga.Name, true
+	}
+	return "", false
+}
+
+// pathError returns an error diagnostic describing a path that cannot be
+// used with an object.
+func pathError(path cty.Path, msg string) sdkdiags.Diagnostic {
+	return sdkdiags.Diagnostic{
+		Severity: sdkdiags.Error,
+		Summary:  "Invalid object path",
+		Detail:   fmt.Sprintf("Cannot use the path %s: %s.", sdkdiags.FormatPath(path), msg),
+		Path:     path,
+	}
+}
@@ -0,0 +1,292 @@
+package tfobj
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var pathTestSchema = &tfschema.BlockType{
+	Attributes: map[string]*tfschema.Attribute{
+		"name": {Type: cty.String, Required: true},
+		"tags": {Type: cty.Map(cty.String), Optional: true},
+		"ports": {Type: cty.List(cty.Object(map[string]cty.Type{
+			"number": cty.Number,
+		})), Optional: true},
+	},
+	NestedBlockTypes: map[string]*tfschema.NestedBlockType{
+		"placement": {
+			Nesting: tfschema.NestingSingle,
+			Content: tfschema.BlockType{
+				Attributes: map[string]*tfschema.Attribute{
+					"zone": {Type: cty.String, Required: true},
+				},
+			},
+		},
+		"disk": {
+			Nesting: tfschema.NestingList,
+			Content: tfschema.BlockType{
+				Attributes: map[string]*tfschema.Attribute{
+					"size": {Type: cty.Number, Required: true},
+				},
+			},
+		},
+		"network_interface": {
+			Nesting: tfschema.NestingMap,
+			Content: tfschema.BlockType{
+				Attributes: map[string]*tfschema.Attribute{
+					"public": {Type: cty.Bool, Optional: true},
+				},
+			},
+		},
+		"rule": {
+			Nesting: tfschema.NestingSet,
+			Content: tfschema.BlockType{
+				Attributes: map[string]*tfschema.Attribute{
+					"cidr": {Type: cty.String, Required: true},
+				},
+			},
+		},
+	},
+}
+
+var pathTestObj = cty.ObjectVal(map[string]cty.Value{
+	"name": cty.StringVal("web"),
+	"tags": cty.MapVal(map[string]cty.Value{
+		"env": cty.StringVal("prod"),
+	}),
+	"ports": cty.UnknownVal(cty.List(cty.Object(map[string]cty.Type{
+		"number": cty.Number,
+	}))),
+	"placement": cty.NullVal(cty.Object(map[string]cty.Type{
+		"zone": cty.String,
+	})),
+	"disk": cty.ListVal([]cty.Value{
+		cty.ObjectVal(map[string]cty.Value{
+			"size": cty.NumberIntVal(10),
+		}),
+	}),
+	"network_interface": cty.MapVal(map[string]cty.Value{
+		"eth0": cty.ObjectVal(map[string]cty.Value{
+			"public": cty.True,
+		}),
+	}),
+	"rule": cty.SetValEmpty(cty.Object(map[string]cty.Type{
+		"cidr": cty.String,
+	})),
+})
+
+func TestGetPath(t *testing.T) {
+	tests := map[string]struct {
+		path    cty.Path
+		want    cty.Value
+		wantErr string
+	}{
+		"attribute": {
+			cty.GetAttrPath("name"),
+			cty.StringVal("web"),
+			"",
+		},
+		"map element": {
+			cty.GetAttrPath("tags").Index(cty.StringVal("env")),
+			cty.StringVal("prod"),
+			"",
+		},
+		"inside unknown": {
+			cty.GetAttrPath("ports").Index(cty.NumberIntVal(0)).GetAttr("number"),
+			cty.UnknownVal(cty.Number),
+			"",
+		},
+		"inside null single block": {
+			cty.GetAttrPath("placement").GetAttr("zone"),
+			cty.NullVal(cty.String),
+			"",
+		},
+		"list block": {
+			cty.GetAttrPath("disk").Index(cty.NumberIntVal(0)).GetAttr("size"),
+			cty.NumberIntVal(10),
+			"",
+		},
+		"map block": {
+			cty.GetAttrPath("network_interface").Index(cty.StringVal("eth0")).GetAttr("public"),
+			cty.True,
+			"",
+		},
+		"whole set of blocks": {
+			cty.GetAttrPath("rule"),
+			cty.SetValEmpty(cty.Object(map[string]cty.Type{
+				"cidr": cty.String,
+			})),
+			"",
+		},
+		"no such attribute": {
+			cty.GetAttrPath("nope"),
+			cty.DynamicVal,
+			`Cannot use the path .nope: there is no attribute or nested block type named "nope".`,
+		},
+		"no such map element": {
+			cty.GetAttrPath("tags").Index(cty.StringVal("nope")),
+			cty.DynamicVal,
+			`Cannot use the path .tags["nope"]: there is no element with this key.`,
+		},
+		"list block out of range": {
+			cty.GetAttrPath("disk").Index(cty.NumberIntVal(1)),
+			cty.DynamicVal,
+			`Cannot use the path .disk[1]: there is no element with this index.`,
+		},
+		"list block with string key": {
+			cty.GetAttrPath("disk").Index(cty.StringVal("0")),
+			cty.DynamicVal,
+			`must be an index into a collection of nested blocks`,
+		},
+		"inside set block": {
+			cty.GetAttrPath("rule").Index(cty.NumberIntVal(0)),
+			cty.DynamicVal,
+			`cannot address individual "rule" blocks because they are a set`,
+		},
+		"attribute of string": {
+			cty.GetAttrPath("name").GetAttr("length"),
+			cty.DynamicVal,
+			`there is no attribute named "length"`,
+		},
+	}
+
+	r := NewObjectReader(pathTestSchema, pathTestObj)
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, diags := r.GetPath(test.path)
+			if test.wantErr != "" {
+				if !diags.HasErrors() {
+					t.Fatalf("unexpected success")
+				}
+				if got := diags[0].Detail; !strings.Contains(got, test.wantErr) {
+					t.Errorf("wrong error\ngot:  %s\nwant: %s", got, test.wantErr)
+				}
+			} else if diags.HasErrors() {
+				t.Fatalf("unexpected errors: %#v", diags)
+			}
+			if !got.RawEquals(test.want) {
+				t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSetPath(t *testing.T) {
+	tests := map[string]struct {
+		path    cty.Path
+		val     cty.Value
+		want    cty.Value
+		wantErr string
+	}{
+		"attribute": {
+			cty.GetAttrPath("name"),
+			cty.StringVal("db"),
+			cty.StringVal("db"),
+			"",
+		},
+		"new map element": {
+			cty.GetAttrPath("tags").Index(cty.StringVal("team")),
+			cty.StringVal("infra"),
+			cty.StringVal("infra"),
+			"",
+		},
+		"attribute in list block": {
+			cty.GetAttrPath("disk").Index(cty.NumberIntVal(0)).GetAttr("size"),
+			cty.StringVal("20"),
+			cty.NumberIntVal(20),
+			"",
+		},
+		"attribute in map block": {
+			cty.GetAttrPath("network_interface").Index(cty.StringVal("eth0")).GetAttr("public"),
+			cty.False,
+			cty.False,
+			"",
+		},
+		"unsuitable value": {
+			cty.GetAttrPath("disk").Index(cty.NumberIntVal(0)).GetAttr("size"),
+			cty.StringVal("big"),
+			cty.NilVal,
+			`The given value is not suitable for .disk[0].size`,
+		},
+		"inside unknown": {
+			cty.GetAttrPath("ports").Index(cty.NumberIntVal(0)),
+			cty.EmptyObjectVal,
+			cty.NilVal,
+			`cannot set a value inside a null or unknown value`,
+		},
+		"absent single block": {
+			cty.GetAttrPath("placement").GetAttr("zone"),
+			cty.StringVal("a"),
+			cty.NilVal,
+			`there is no "placement" block`,
+		},
+		"absent map block": {
+			cty.GetAttrPath("network_interface").Index(cty.StringVal("eth1")).GetAttr("public"),
+			cty.True,
+			cty.NilVal,
+			`Cannot use the path .network_interface["eth1"]: there is no "network_interface" block with this key.`,
+		},
+		"no such nested attribute": {
+			cty.GetAttrPath("disk").Index(cty.NumberIntVal(0)).GetAttr("nope"),
+			cty.True,
+			cty.NilVal,
+			`Cannot use the path .disk[0].nope: there is no attribute or nested block type named "nope".`,
+		},
+		"whole block": {
+			cty.GetAttrPath("disk").Index(cty.NumberIntVal(0)),
+			cty.EmptyObjectVal,
+			cty.NilVal,
+			`can set only attribute values, not whole blocks`,
+		},
+		"set block": {
+			cty.GetAttrPath("rule").Index(cty.NumberIntVal(0)).GetAttr("cidr"),
+			cty.StringVal("10.0.0.0/8"),
+			cty.NilVal,
+			`cannot address individual "rule" blocks because they are a set`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			b := NewObjectBuilder(pathTestSchema, pathTestObj)
+			diags := b.SetPath(test.path, test.val)
+			if test.wantErr != "" {
+				if !diags.HasErrors() {
+					t.Fatalf("unexpected success")
+				}
+				if got := diags[0].Detail; !strings.Contains(got, test.wantErr) {
+					t.Errorf("wrong error\ngot:  %s\nwant: %s", got, test.wantErr)
+				}
+				if got := b.ObjectVal(); !got.RawEquals(pathTestObj) {
+					t.Errorf("object was modified\ngot:  %#v\nwant: %#v", got, pathTestObj)
+				}
+				return
+			}
+			if diags.HasErrors() {
+				t.Fatalf("unexpected errors: %#v", diags)
+			}
+
+			got, diags := b.GetPath(test.path)
+			if diags.HasErrors() {
+				t.Fatalf("unexpected errors reading back: %#v", diags)
+			}
+			if !got.RawEquals(test.want) {
+				t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPlanBuilderSetPath(t *testing.T) {
+	b := NewPlanBuilder(pathTestSchema, pathTestObj, pathTestObj, cty.NullVal(pathTestSchema.ImpliedCtyType()))
+	diags := b.SetPath(cty.GetAttrPath("name"), cty.StringVal("db"))
+	if !diags.HasErrors() {
+		t.Fatalf("unexpected success")
+	}
+	if got, want := diags[0].Summary, "Invalid object path"; got != want {
+		t.Errorf("wrong summary %q; want %q", got, want)
+	}
+}
@@ -3,6 +3,7 @@ package tfobj
 import (
 	"fmt"
 
+	"github.com/apparentlymart/terraform-sdk/internal/sdkdiags"
 	"github.com/apparentlymart/terraform-sdk/tfschema"
 	"github.com/zclconf/go-cty/cty"
 )
@@ -140,8 +141,14 @@ type PlanBuilder interface {
 	BlockPlanBuilderFromList(blockType string, idx int) PlanBuilder
 	BlockPlanBuilderFromMap(blockType string, key string) PlanBuilder
 
-	// SetAttr is the same as for ObjectBuilder.
+	// AttrPlanBuilder returns an AttributePlanBuilder for the attribute of
+	// the given name, which allows inspecting and adjusting the plan for
+	// just that attribute.
+	AttrPlanBuilder(name string) AttributePlanBuilder
+
+	// SetAttr and SetPath are the same as for ObjectBuilder.
 	SetAttr(name string, val cty.Value)
+	SetPath(path cty.Path, val cty.Value) sdkdiags.Diagnostics
 
 	// The Block... family of methods are the same as for ObjectBuilder.
 	BlockBuilderSingle(blockType string) ObjectBuilder
@@ -151,6 +158,47 @@ type PlanBuilder interface {
 	BlockBuilderFromMap(blockType string, key string) ObjectBuilder
 }
 
+// AttributePlanBuilder is a PlanBuilder scoped to a single attribute of the
+// object being planned. It is used by the plan modifier functions in
+// tfschema.Attribute.PlanModifiers.
+type AttributePlanBuilder interface {
+	// Name returns the name of the attribute, and Path returns the path to
+	// the attribute from the top-level object being planned.
+	Name() string
+	Path() cty.Path
+
+	// Schema returns the schema for the attribute.
+	Schema() *tfschema.Attribute
+
+	// Action is the same as for PlanReader, describing the action to be
+	// taken for the object that contains the attribute.
+	Action() Action
+
+	// Object returns a PlanReader for the object that contains the
+	// attribute.
+	Object() PlanReader
+
+	// Prior, Config, and Planned return the value of the attribute in the
+	// prior object, the configuration object, and the planned new object
+	// respectively. Prior returns a null value when the containing object is
+	// being created.
+	Prior() cty.Value
+	Config() cty.Value
+	Planned() cty.Value
+
+	// HasChange returns true if the planned value for the attribute is
+	// different than its prior value, with the same meaning as
+	// PlanReader.AttrHasChange.
+	HasChange() bool
+
+	// SetPlanned replaces the planned value for the attribute.
+	SetPlanned(val cty.Value)
+
+	// SetRequiresReplacement marks the attribute as requiring the target
+	// object to be replaced, as for PlanBuilder.SetAttrRequiresReplacement.
+	SetRequiresReplacement()
+}
+
 // Make sure that we remember to update PlanBuilder if we add anything new to
 // ObjectBuilder, since that's not represented explicitly in the decl above.
 var _ ObjectBuilder = PlanBuilder(nil)
@@ -272,12 +320,12 @@ func (b *planBuilder) AttrChange(name string) (prior cty.Value, planned cty.Valu
 	if b.prior != nil {
 		prior = b.prior.Attr(name)
 	} else {
-		prior = cty.NullVal(attrS.Type)
+		prior = cty.NullVal(attrS.ImpliedCtyType())
 	}
 	if b.planned != nil {
 		planned = b.Attr(name)
 	} else {
-		planned = cty.NullVal(attrS.Type)
+		planned = cty.NullVal(attrS.ImpliedCtyType())
 	}
 	return
 }
@@ -291,7 +339,7 @@ func (b *planBuilder) AttrHasChange(name string) bool {
 		// known result actually matches prior after all.
 		return true
 	}
-	return eqV.True()
+	return eqV.False()
 }
 
 func (b *planBuilder) CanProvideAttrDefault(name string) bool {
@@ -316,7 +364,7 @@ func (b *planBuilder) SetAttrUnknown(name string) {
 	if !ok {
 		panic(fmt.Sprintf("%q is not an attribute", name))
 	}
-	b.SetAttr(name, cty.UnknownVal(attrS.Type))
+	b.SetAttr(name, cty.UnknownVal(attrS.ImpliedCtyType()))
 }
 
 func (b *planBuilder) SetAttrNull(name string) {
@@ -324,7 +372,7 @@ func (b *planBuilder) SetAttrNull(name string) {
 	if !ok {
 		panic(fmt.Sprintf("%q is not an attribute", name))
 	}
-	b.SetAttr(name, cty.NullVal(attrS.Type))
+	b.SetAttr(name, cty.NullVal(attrS.ImpliedCtyType()))
 }
 
 func (b *planBuilder) SetAttrRequiresReplacement(name string) {
@@ -338,6 +386,13 @@ func (b *planBuilder) SetAttrRequiresReplacement(name string) {
 	b.requiresReplace.AddAllSteps(path)
 }
 
+func (b *planBuilder) AttrPlanBuilder(name string) AttributePlanBuilder {
+	if _, ok := b.Schema().Attributes[name]; !ok {
+		panic(fmt.Sprintf("%q is not an attribute", name))
+	}
+	return attrPlanBuilder{object: b, name: name}
+}
+
 func (b *planBuilder) BlockCount(typeName string) int {
 	return b.planned.BlockCount(typeName)
 }
@@ -600,3 +655,59 @@ func (b *planBuilder) subBuilder(schema *tfschema.NestedBlockType, subPath cty.P
 		requiresReplace: b.requiresReplace,
 	}
 }
+
+type attrPlanBuilder struct {
+	object *planBuilder
+	name   string
+}
+
+func (b attrPlanBuilder) Name() string {
+	return b.name
+}
+
+func (b attrPlanBuilder) Path() cty.Path {
+	path := make(cty.Path, 0, len(b.object.basePath)+1)
+	path = append(path, b.object.basePath...)
+	return path.GetAttr(b.name)
+}
+
+func (b attrPlanBuilder) Schema() *tfschema.Attribute {
+	return b.object.Schema().Attributes[b.name]
+}
+
+func (b attrPlanBuilder) Action() Action {
+	return b.object.Action()
+}
+
+func (b attrPlanBuilder) Object() PlanReader {
+	return b.object
+}
+
+func (b attrPlanBuilder) Prior() cty.Value {
+	prior, _ := b.object.AttrChange(b.name)
+	return prior
+}
+
+func (b attrPlanBuilder) Config() cty.Value {
+	if b.object.config == nil {
+		return cty.NullVal(b.Schema().ImpliedCtyType())
+	}
+	return b.object.config.Attr(b.name)
+}
+
+func (b attrPlanBuilder) Planned() cty.Value {
+	_, planned := b.object.AttrChange(b.name)
+	return planned
+}
+
+func (b attrPlanBuilder) HasChange() bool {
+	return b.object.AttrHasChange(b.name)
+}
+
+func (b attrPlanBuilder) SetPlanned(val cty.Value) {
+	b.object.SetAttr(b.name, val)
+}
+
+func (b attrPlanBuilder) SetRequiresReplacement() {
+	b.object.SetAttrRequiresReplacement(b.name)
+}
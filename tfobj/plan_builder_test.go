@@ -0,0 +1,46 @@
+package tfobj
+
+import (
+	"testing"
+
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestPlanBuilderAttrHasChange(t *testing.T) {
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"name": {Type: cty.String, Required: true},
+			"size": {Type: cty.Number, Optional: true},
+			"id":   {Type: cty.String, Computed: true},
+			"tags": {Type: cty.Map(cty.String), Optional: true},
+		},
+	}
+	prior := cty.ObjectVal(map[string]cty.Value{
+		"name": cty.StringVal("web"),
+		"size": cty.NumberIntVal(1),
+		"id":   cty.StringVal("i-abc123"),
+		"tags": cty.MapVal(map[string]cty.Value{"env": cty.StringVal("prod")}),
+	})
+	planned := cty.ObjectVal(map[string]cty.Value{
+		"name": cty.StringVal("web"),
+		"size": cty.NumberIntVal(2),
+		"id":   cty.UnknownVal(cty.String),
+		"tags": cty.MapVal(map[string]cty.Value{"env": cty.UnknownVal(cty.String)}),
+	})
+	b := NewPlanBuilder(schema, prior, planned, planned)
+
+	tests := map[string]bool{
+		"name": false, // unchanged
+		"size": true,  // changed
+		"id":   true,  // unknown
+		"tags": true,  // partially unknown
+	}
+	for name, want := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := b.AttrHasChange(name); got != want {
+				t.Errorf("wrong result %#v; want %#v", got, want)
+			}
+		})
+	}
+}
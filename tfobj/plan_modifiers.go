@@ -0,0 +1,35 @@
+package tfobj
+
+import (
+	"github.com/apparentlymart/terraform-sdk/internal/sdkdiags"
+)
+
+// UseStateForUnknown is a plan modifier, for use in
+// tfschema.Attribute.PlanModifiers, that replaces an unknown planned value
+// for the attribute with its prior value, if there is one.
+//
+// Use this for computed attributes whose values are decided when an object is
+// created and then never change, such as a remote object's id, so that they
+// remain known when planning an update.
+func UseStateForUnknown(plan AttributePlanBuilder) sdkdiags.Diagnostics {
+	prior, planned := plan.Prior(), plan.Planned()
+	if !planned.IsKnown() && !prior.IsNull() {
+		plan.SetPlanned(prior)
+	}
+	return nil
+}
+
+// RequiresReplaceIfChanged is a plan modifier, for use in
+// tfschema.Attribute.PlanModifiers, that marks the attribute as requiring the
+// target object to be replaced whenever its planned value differs from its
+// prior value.
+//
+// This is equivalent to setting tfschema.Attribute.RequiresReplace, but it
+// takes effect before the PlanFn is called and so the PlanFn can see the
+// result by calling PlanBuilder.RequiresReplace.
+func RequiresReplaceIfChanged(plan AttributePlanBuilder) sdkdiags.Diagnostics {
+	if plan.Action() != Delete && plan.HasChange() {
+		plan.SetRequiresReplacement()
+	}
+	return nil
+}
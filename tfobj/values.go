@@ -0,0 +1,293 @@
+package tfobj
+
+import (
+	"reflect"
+
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	"github.com/zclconf/go-cty/cty/gocty"
+)
+
+// The types in this file are Go representations of values that, unlike the
+// Go types that gocty works with, can also be null or unknown. Use them as
+// the types of struct fields when decoding with Decode or DecodeValue, or
+// when encoding with Encode or EncodeValue, to distinguish an attribute that
+// isn't set from one whose value won't be known until the apply step.
+//
+// The zero value of each type is a known, non-null value: the zero value of
+// the corresponding Go type, or an empty collection.
+
+// String is a string value that may be null or unknown.
+type String struct {
+	Value string
+	state ValueState
+}
+
+// Int64 is a whole number value that may be null or unknown.
+type Int64 struct {
+	Value int64
+	state ValueState
+}
+
+// Bool is a boolean value that may be null or unknown.
+type Bool struct {
+	Value bool
+	state ValueState
+}
+
+// List is a list or set value that may be null or unknown. Its elements may
+// themselves be null or unknown.
+type List struct {
+	Elems []cty.Value
+	state ValueState
+}
+
+// Map is a map value that may be null or unknown. Its elements may themselves
+// be null or unknown.
+type Map struct {
+	Elems map[string]cty.Value
+	state ValueState
+}
+
+// Object is an object value that may be null or unknown. Its attribute values
+// may themselves be null or unknown.
+type Object struct {
+	Attrs map[string]cty.Value
+	state ValueState
+}
+
+// ValueState describes whether a value is known and not null, null, or
+// unknown. It is returned by the typed attribute accessors of ObjectReader.
+type ValueState int
+
+const (
+	ValueKnown ValueState = iota
+	ValueNull
+	ValueUnknown
+)
+
+//go:generate stringer -type=ValueState
+
+// NullString returns a null String, and UnknownString returns an unknown one.
+func NullString() String    { return String{state: ValueNull} }
+func UnknownString() String { return String{state: ValueUnknown} }
+
+// NullInt64 returns a null Int64, and UnknownInt64 returns an unknown one.
+func NullInt64() Int64    { return Int64{state: ValueNull} }
+func UnknownInt64() Int64 { return Int64{state: ValueUnknown} }
+
+// NullBool returns a null Bool, and UnknownBool returns an unknown one.
+func NullBool() Bool    { return Bool{state: ValueNull} }
+func UnknownBool() Bool { return Bool{state: ValueUnknown} }
+
+// NullList returns a null List, and UnknownList returns an unknown one.
+func NullList() List    { return List{state: ValueNull} }
+func UnknownList() List { return List{state: ValueUnknown} }
+
+// NullMap returns a null Map, and UnknownMap returns an unknown one.
+func NullMap() Map    { return Map{state: ValueNull} }
+func UnknownMap() Map { return Map{state: ValueUnknown} }
+
+// NullObject returns a null Object, and UnknownObject returns an unknown one.
+func NullObject() Object    { return Object{state: ValueNull} }
+func UnknownObject() Object { return Object{state: ValueUnknown} }
+
+// IsNull returns true if the value is null.
+func (v String) IsNull() bool { return v.state == ValueNull }
+func (v Int64) IsNull() bool  { return v.state == ValueNull }
+func (v Bool) IsNull() bool   { return v.state == ValueNull }
+func (v List) IsNull() bool   { return v.state == ValueNull }
+func (v Map) IsNull() bool    { return v.state == ValueNull }
+func (v Object) IsNull() bool { return v.state == ValueNull }
+
+// IsUnknown returns true if the value is unknown.
+func (v String) IsUnknown() bool { return v.state == ValueUnknown }
+func (v Int64) IsUnknown() bool  { return v.state == ValueUnknown }
+func (v Bool) IsUnknown() bool   { return v.state == ValueUnknown }
+func (v List) IsUnknown() bool   { return v.state == ValueUnknown }
+func (v Map) IsUnknown() bool    { return v.state == ValueUnknown }
+func (v Object) IsUnknown() bool { return v.state == ValueUnknown }
+
+// valueDecoder is implemented by pointers to the types in this file, allowing
+// DecodeValue to populate them from a cty.Value.
+type valueDecoder interface {
+	decodeCtyValue(val cty.Value, path cty.Path) error
+}
+
+// valueEncoder is implemented by the types in this file, allowing EncodeValue
+// to derive a cty.Value of the given type from them.
+type valueEncoder interface {
+	encodeCtyValue(ty cty.Type, path cty.Path) (cty.Value, error)
+}
+
+var valueDecoderType = reflect.TypeOf((*valueDecoder)(nil)).Elem()
+
+// decodeState returns the state for the given value, or false if the value is
+// known and not null and so the caller must decode it.
+func decodeState(val cty.Value) (ValueState, bool) {
+	switch {
+	case !val.IsKnown():
+		return ValueUnknown, true
+	case val.IsNull():
+		return ValueNull, true
+	default:
+		return ValueKnown, false
+	}
+}
+
+// encodeState returns the value for the given state, or false if the state
+// is ValueKnown and so the caller must encode the value itself.
+func encodeState(state ValueState, ty cty.Type) (cty.Value, bool) {
+	switch state {
+	case ValueUnknown:
+		return cty.UnknownVal(ty), true
+	case ValueNull:
+		return cty.NullVal(ty), true
+	default:
+		return cty.NilVal, false
+	}
+}
+
+func (v *String) decodeCtyValue(val cty.Value, path cty.Path) error {
+	*v = String{}
+	if state, done := decodeState(val); done {
+		v.state = state
+		return nil
+	}
+	return decodePrimitive(val, cty.String, &v.Value, path)
+}
+
+func (v *Int64) decodeCtyValue(val cty.Value, path cty.Path) error {
+	*v = Int64{}
+	if state, done := decodeState(val); done {
+		v.state = state
+		return nil
+	}
+	return decodePrimitive(val, cty.Number, &v.Value, path)
+}
+
+func (v *Bool) decodeCtyValue(val cty.Value, path cty.Path) error {
+	*v = Bool{}
+	if state, done := decodeState(val); done {
+		v.state = state
+		return nil
+	}
+	return decodePrimitive(val, cty.Bool, &v.Value, path)
+}
+
+func (v *List) decodeCtyValue(val cty.Value, path cty.Path) error {
+	*v = List{}
+	if state, done := decodeState(val); done {
+		v.state = state
+		return nil
+	}
+	ty := val.Type()
+	if !(ty.IsListType() || ty.IsSetType() || ty.IsTupleType()) {
+		return path.NewErrorf("list or set value is required")
+	}
+	v.Elems = make([]cty.Value, 0, val.LengthInt())
+	for it := val.ElementIterator(); it.Next(); {
+		_, ev := it.Element()
+		v.Elems = append(v.Elems, ev)
+	}
+	return nil
+}
+
+func (v *Map) decodeCtyValue(val cty.Value, path cty.Path) error {
+	*v = Map{}
+	if state, done := decodeState(val); done {
+		v.state = state
+		return nil
+	}
+	ty := val.Type()
+	if !(ty.IsMapType() || ty.IsObjectType()) {
+		return path.NewErrorf("map value is required")
+	}
+	v.Elems = val.AsValueMap()
+	if v.Elems == nil {
+		v.Elems = make(map[string]cty.Value)
+	}
+	return nil
+}
+
+func (v *Object) decodeCtyValue(val cty.Value, path cty.Path) error {
+	*v = Object{}
+	if state, done := decodeState(val); done {
+		v.state = state
+		return nil
+	}
+	if !val.Type().IsObjectType() {
+		return path.NewErrorf("object value is required")
+	}
+	v.Attrs = val.AsValueMap()
+	if v.Attrs == nil {
+		v.Attrs = make(map[string]cty.Value)
+	}
+	return nil
+}
+
+func (v String) encodeCtyValue(ty cty.Type, path cty.Path) (cty.Value, error) {
+	if ret, done := encodeState(v.state, ty); done {
+		return ret, nil
+	}
+	return encodePrimitive(cty.StringVal(v.Value), ty, path)
+}
+
+func (v Int64) encodeCtyValue(ty cty.Type, path cty.Path) (cty.Value, error) {
+	if ret, done := encodeState(v.state, ty); done {
+		return ret, nil
+	}
+	return encodePrimitive(cty.NumberIntVal(v.Value), ty, path)
+}
+
+func (v Bool) encodeCtyValue(ty cty.Type, path cty.Path) (cty.Value, error) {
+	if ret, done := encodeState(v.state, ty); done {
+		return ret, nil
+	}
+	return encodePrimitive(cty.BoolVal(v.Value), ty, path)
+}
+
+func (v List) encodeCtyValue(ty cty.Type, path cty.Path) (cty.Value, error) {
+	if ret, done := encodeState(v.state, ty); done {
+		return ret, nil
+	}
+	return encodePrimitive(cty.TupleVal(v.Elems), ty, path)
+}
+
+func (v Map) encodeCtyValue(ty cty.Type, path cty.Path) (cty.Value, error) {
+	if ret, done := encodeState(v.state, ty); done {
+		return ret, nil
+	}
+	return encodePrimitive(cty.ObjectVal(v.Elems), ty, path)
+}
+
+func (v Object) encodeCtyValue(ty cty.Type, path cty.Path) (cty.Value, error) {
+	if ret, done := encodeState(v.state, ty); done {
+		return ret, nil
+	}
+	return encodePrimitive(cty.ObjectVal(v.Attrs), ty, path)
+}
+
+// decodePrimitive converts the given known, non-null value to the given type
+// and then decodes it into the given pointer using gocty, returning any error
+// with the given path prepended.
+func decodePrimitive(val cty.Value, ty cty.Type, to interface{}, path cty.Path) error {
+	val, err := convert.Convert(val, ty)
+	if err == nil {
+		err = gocty.FromCtyValue(val, to)
+	}
+	if err != nil {
+		return prefixPathError(path, err)
+	}
+	return nil
+}
+
+// encodePrimitive converts the given value to the given type, returning any
+// error with the given path prepended.
+func encodePrimitive(val cty.Value, ty cty.Type, path cty.Path) (cty.Value, error) {
+	ret, err := convert.Convert(val, ty)
+	if err != nil {
+		return cty.NilVal, prefixPathError(path, err)
+	}
+	return ret, nil
+}
@@ -0,0 +1,149 @@
+package tfobj
+
+import (
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestDecodeValueUnknowns(t *testing.T) {
+	type disk struct {
+		Label String `cty:"label"`
+		Size  *int   `cty:"size"`
+	}
+	type instance struct {
+		ID      String          `cty:"id"`
+		Name    String          `cty:"name"`
+		Version Int64           `cty:"version"`
+		Public  Bool            `cty:"public"`
+		Zones   List            `cty:"zones"`
+		Tags    Map             `cty:"tags"`
+		Access  Object          `cty:"access"`
+		Note    *string         `cty:"note"`
+		Disks   []disk          `cty:"disk"`
+		ByName  map[string]disk `cty:"by_name"`
+	}
+	diskTy := cty.Object(map[string]cty.Type{
+		"label": cty.String,
+		"size":  cty.Number,
+	})
+	val := cty.ObjectVal(map[string]cty.Value{
+		"id":      cty.UnknownVal(cty.String),
+		"name":    cty.StringVal("example"),
+		"version": cty.NullVal(cty.Number),
+		"public":  cty.True,
+		"zones":   cty.ListVal([]cty.Value{cty.StringVal("a"), cty.UnknownVal(cty.String)}),
+		"tags":    cty.UnknownVal(cty.Map(cty.String)),
+		"access":  cty.NullVal(cty.EmptyObject),
+		"note":    cty.NullVal(cty.String),
+		"disk": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"label": cty.UnknownVal(cty.String),
+				"size":  cty.NumberIntVal(10),
+			}),
+		}),
+		"by_name": cty.MapValEmpty(diskTy),
+	})
+
+	var got instance
+	if err := DecodeValue(val, &got); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !got.ID.IsUnknown() || got.ID.IsNull() {
+		t.Errorf("id is not unknown: %#v", got.ID)
+	}
+	if got.Name.IsUnknown() || got.Name.IsNull() || got.Name.Value != "example" {
+		t.Errorf("wrong name: %#v", got.Name)
+	}
+	if !got.Version.IsNull() {
+		t.Errorf("version is not null: %#v", got.Version)
+	}
+	if !got.Public.Value {
+		t.Errorf("wrong public: %#v", got.Public)
+	}
+	if len(got.Zones.Elems) != 2 || got.Zones.Elems[1].IsKnown() {
+		t.Errorf("wrong zones: %#v", got.Zones)
+	}
+	if !got.Tags.IsUnknown() {
+		t.Errorf("tags is not unknown: %#v", got.Tags)
+	}
+	if !got.Access.IsNull() {
+		t.Errorf("access is not null: %#v", got.Access)
+	}
+	if got.Note != nil {
+		t.Errorf("null note is not decoded as nil: %#v", got.Note)
+	}
+	if len(got.Disks) != 1 || !got.Disks[0].Label.IsUnknown() || *got.Disks[0].Size != 10 {
+		t.Errorf("wrong disks: %#v", got.Disks)
+	}
+	if got.ByName == nil || len(got.ByName) != 0 {
+		t.Errorf("wrong by_name: %#v", got.ByName)
+	}
+
+	// Encoding the result should produce the original value again.
+	gotVal, err := EncodeValue(got, val.Type())
+	if err != nil {
+		t.Fatalf("unexpected error encoding: %s", err)
+	}
+	if !val.RawEquals(gotVal) {
+		t.Errorf("wrong encoded value\ngot:  %#v\nwant: %#v", gotVal, val)
+	}
+}
+
+func TestDecodeValueWithoutUnknownAwareTypes(t *testing.T) {
+	type instance struct {
+		Name  *string  `cty:"name"`
+		Zones []String `cty:"zones"`
+	}
+	tests := map[string]struct {
+		val      cty.Value
+		wantPath cty.Path
+	}{
+		"unknown primitive": {
+			cty.ObjectVal(map[string]cty.Value{
+				"name":  cty.UnknownVal(cty.String),
+				"zones": cty.NullVal(cty.List(cty.String)),
+			}),
+			cty.GetAttrPath("name"),
+		},
+		"unknown collection": {
+			cty.ObjectVal(map[string]cty.Value{
+				"name":  cty.StringVal("example"),
+				"zones": cty.UnknownVal(cty.List(cty.String)),
+			}),
+			cty.GetAttrPath("zones"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got instance
+			err := DecodeValue(test.val, &got)
+			if err == nil {
+				t.Fatalf("unexpected success")
+			}
+			pErr, ok := err.(cty.PathError)
+			if !ok {
+				t.Fatalf("error is %T, not cty.PathError", err)
+			}
+			if !pErr.Path.Equals(test.wantPath) {
+				t.Errorf("wrong error path %#v; want %#v", pErr.Path, test.wantPath)
+			}
+			if got, want := pErr.Error(), "value must be known"; got != want {
+				t.Errorf("wrong error %q; want %q", got, want)
+			}
+
+			got = instance{}
+			if err := DecodeValueUnknownAsNull(test.val, &got); err != nil {
+				t.Fatalf("unexpected error with unknowns as null: %s", err)
+			}
+			if v := test.val.GetAttr("name"); !v.IsKnown() && got.Name != nil {
+				t.Errorf("unknown name is not decoded as nil: %#v", got.Name)
+			}
+			if v := test.val.GetAttr("zones"); !v.IsKnown() && got.Zones != nil {
+				t.Errorf("unknown zones is not decoded as nil: %#v", got.Zones)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package tfschema
+
+import (
+	"os"
+
+	"github.com/apparentlymart/terraform-sdk/internal/sdkdiags"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// EnvDefaultFn returns a function suitable for use as Attribute.DefaultFn
+// that returns the value of the environment variable with the given name, or
+// the given fallback value if that variable is not set or is empty.
+//
+// The environment variable's value is a string, which will be converted to
+// the attribute's type. Pass a null value as the fallback to leave the
+// attribute unset if the environment variable is not set.
+func EnvDefaultFn(name string, fallback cty.Value) func(cty.Value) (cty.Value, sdkdiags.Diagnostics) {
+	return MultiEnvDefaultFn([]string{name}, fallback)
+}
+
+// MultiEnvDefaultFn is like EnvDefaultFn except that it checks each of the
+// given environment variables in turn and returns the value of the first one
+// that is set and not empty.
+func MultiEnvDefaultFn(names []string, fallback cty.Value) func(cty.Value) (cty.Value, sdkdiags.Diagnostics) {
+	return func(cty.Value) (cty.Value, sdkdiags.Diagnostics) {
+		for _, name := range names {
+			if v := os.Getenv(name); v != "" {
+				return cty.StringVal(v), nil
+			}
+		}
+		return fallback, nil
+	}
+}
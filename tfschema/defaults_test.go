@@ -0,0 +1,160 @@
+package tfschema
+
+import (
+	"os"
+	"testing"
+
+	"github.com/apparentlymart/terraform-sdk/internal/sdkdiags"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+)
+
+func TestBlockTypeApplyDefaultsDefaultFn(t *testing.T) {
+	os.Setenv("TFSCHEMA_TEST_PORT", "8080")
+	defer os.Unsetenv("TFSCHEMA_TEST_PORT")
+
+	schema := &BlockType{
+		Attributes: map[string]*Attribute{
+			"host": {Type: cty.String, Optional: true},
+			"port": {
+				Type:      cty.Number,
+				Optional:  true,
+				DefaultFn: EnvDefaultFn("TFSCHEMA_TEST_PORT", cty.NullVal(cty.Number)),
+			},
+			"region": {
+				Type:      cty.String,
+				Optional:  true,
+				DefaultFn: MultiEnvDefaultFn([]string{"TFSCHEMA_TEST_UNSET_1", "TFSCHEMA_TEST_UNSET_2"}, cty.StringVal("us-east-1")),
+			},
+		},
+		NestedBlockTypes: map[string]*NestedBlockType{
+			"endpoint": {
+				Nesting: NestingList,
+				Content: BlockType{
+					Attributes: map[string]*Attribute{
+						"name": {
+							Type:     cty.String,
+							Optional: true,
+							DefaultFn: func(obj cty.Value) (cty.Value, sdkdiags.Diagnostics) {
+								var diags sdkdiags.Diagnostics
+								diags = diags.Append(sdkdiags.Diagnostic{
+									Severity: sdkdiags.Error,
+									Summary:  "No default name",
+									Path:     cty.GetAttrPath("name"),
+								})
+								return cty.NullVal(cty.String), diags
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	given := cty.ObjectVal(map[string]cty.Value{
+		"host":   cty.StringVal("example.com"),
+		"port":   cty.NullVal(cty.Number),
+		"region": cty.NullVal(cty.String),
+		"endpoint": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"name": cty.StringVal("a"),
+			}),
+			cty.ObjectVal(map[string]cty.Value{
+				"name": cty.NullVal(cty.String),
+			}),
+		}),
+	})
+	got, diags := schema.ApplyDefaultsFunc(given, testCallDefaultFn)
+
+	want := cty.ObjectVal(map[string]cty.Value{
+		"host":   cty.StringVal("example.com"),
+		"port":   cty.NumberIntVal(8080),
+		"region": cty.StringVal("us-east-1"),
+		"endpoint": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"name": cty.StringVal("a"),
+			}),
+			cty.ObjectVal(map[string]cty.Value{
+				"name": cty.NullVal(cty.String),
+			}),
+		}),
+	})
+	if !got.RawEquals(want) {
+		t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, want)
+	}
+
+	if len(diags) != 1 {
+		t.Fatalf("wrong number of diagnostics %d; want 1", len(diags))
+	}
+	if got, want := sdkdiags.FormatPath(diags[0].Path), ".endpoint[1].name"; got != want {
+		t.Errorf("wrong diagnostic path %s; want %s", got, want)
+	}
+
+	// ApplyDefaults can't call DefaultFn, so it leaves those attributes null.
+	got = schema.ApplyDefaults(given)
+	if !got.RawEquals(given) {
+		t.Errorf("wrong result from ApplyDefaults\ngot:  %#v\nwant: %#v", got, given)
+	}
+}
+
+func TestNestedAttributeTypeApplyDefaultsNullElements(t *testing.T) {
+	nested := &NestedAttributeType{
+		Nesting: NestingList,
+		Attributes: map[string]*Attribute{
+			"port": {Type: cty.Number, Optional: true, Default: 80},
+		},
+	}
+	objTy := cty.Object(map[string]cty.Type{"port": cty.Number})
+
+	tests := map[string]struct {
+		given, want cty.Value
+	}{
+		"null element": {
+			cty.ListVal([]cty.Value{
+				cty.NullVal(objTy),
+				cty.ObjectVal(map[string]cty.Value{"port": cty.NullVal(cty.Number)}),
+			}),
+			cty.ListVal([]cty.Value{
+				cty.NullVal(objTy),
+				cty.ObjectVal(map[string]cty.Value{"port": cty.NumberIntVal(80)}),
+			}),
+		},
+		"unknown element": {
+			cty.ListVal([]cty.Value{
+				cty.UnknownVal(objTy),
+				cty.ObjectVal(map[string]cty.Value{"port": cty.NullVal(cty.Number)}),
+			}),
+			cty.ListVal([]cty.Value{
+				cty.UnknownVal(objTy),
+				cty.ObjectVal(map[string]cty.Value{"port": cty.NumberIntVal(80)}),
+			}),
+		},
+		"unknown list": {
+			cty.UnknownVal(cty.List(objTy)),
+			cty.UnknownVal(cty.List(objTy)),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := nested.ApplyDefaults(test.given)
+			if !got.RawEquals(test.want) {
+				t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, test.want)
+			}
+		})
+	}
+}
+
+// testCallDefaultFn is a DefaultFnCaller for functions with exactly the
+// signature returned by EnvDefaultFn. The SDK's own caller accepts any
+// function that its other dynamic calls would.
+func testCallDefaultFn(attr *Attribute, name string, obj cty.Value) (cty.Value, sdkdiags.Diagnostics) {
+	v, diags := attr.DefaultFn.(func(cty.Value) (cty.Value, sdkdiags.Diagnostics))(obj)
+	if diags.HasErrors() {
+		return v, diags
+	}
+	v, err := convert.Convert(v, attr.ImpliedCtyType())
+	if err != nil {
+		panic(err)
+	}
+	return v, diags
+}
@@ -0,0 +1,252 @@
+package tfschema
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+var bigFloatType = reflect.TypeOf(big.Float{})
+var bigIntType = reflect.TypeOf(big.Int{})
+var ctyValueType = reflect.TypeOf(cty.Value{})
+
+// FromStruct derives a block type schema from the given struct type, which is
+// usually given as a nil pointer to the struct, such as (*instance)(nil). The
+// result is suitable for use with gocty to convert values conforming to the
+// schema to and from values of the struct type.
+//
+// Each exported field with a "cty" tag becomes either an attribute or a nested
+// block type named by that tag. Fields without a "cty" tag are ignored.
+//
+// Fields of a struct type, a pointer to a struct type, a slice of structs or
+// struct pointers, or a map with string keys and structs or struct pointers as
+// elements become nested block types. A struct field becomes a block of
+// NestingGroup mode, a struct pointer field NestingSingle, a slice field
+// NestingList, and a map field NestingMap.
+//
+// All other fields become attributes, with types derived from their Go types.
+// Go strings, numbers, and bools correspond to the primitive types, slices to
+// list types, maps with string keys to map types, and structs within those
+// to object types. A cty.Value field becomes an attribute of the dynamic
+// pseudo-type.
+//
+// A pointer field becomes an optional attribute and any other field a required
+// attribute, unless the field's "tf" tag says otherwise. The "tf" tag is a
+// comma-separated list of the following options:
+//
+//	required   the attribute is required
+//	optional   the attribute is optional
+//	computed   the attribute is computed, and optional only if "optional"
+//	           is also given
+//	sensitive  the attribute is sensitive
+//	set        a slice field is a set rather than a list, for both attributes
+//	           and nested block types
+//
+// The "description" tag, if present, gives the attribute's description.
+//
+// FromStruct returns an error if the given type is not a struct or pointer to
+// struct, if any of its tagged fields cannot be represented in a schema, or if
+// a struct type refers to itself, directly or indirectly, because a schema
+// cannot be recursive.
+// Other schema features, such as default values and validation functions, can
+// be added to the result before use.
+func FromStruct(v interface{}) (*BlockType, error) {
+	rt := reflect.TypeOf(v)
+	if rt != nil && rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	if rt == nil || rt.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("must be a struct or pointer to struct, not %T", v)
+	}
+	return blockTypeFromStruct(rt, make(map[reflect.Type]bool))
+}
+
+// blockTypeFromStruct returns the block type corresponding to the given
+// struct type. The visiting map tracks the struct types currently being
+// converted, so that a struct type that refers to itself produces an error
+// rather than unbounded recursion.
+func blockTypeFromStruct(rt reflect.Type, visiting map[reflect.Type]bool) (*BlockType, error) {
+	visiting[rt] = true
+	defer delete(visiting, rt)
+
+	ret := &BlockType{
+		Attributes:       make(map[string]*Attribute),
+		NestedBlockTypes: make(map[string]*NestedBlockType),
+	}
+
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		name := field.Tag.Get("cty")
+		if name == "" || field.PkgPath != "" {
+			continue
+		}
+		opts := structFieldOptions(field.Tag.Get("tf"))
+
+		if nesting, elemTy := structFieldNesting(field.Type, opts); nesting != nestingInvalid {
+			if visiting[elemTy] {
+				return nil, fmt.Errorf("%s: recursive reference to %s is not supported", field.Name, elemTy)
+			}
+			content, err := blockTypeFromStruct(elemTy, visiting)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s", field.Name, err)
+			}
+			ret.NestedBlockTypes[name] = &NestedBlockType{
+				Nesting: nesting,
+				Content: *content,
+			}
+			continue
+		}
+
+		ty, err := ctyTypeFromGoType(field.Type, opts, visiting)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", field.Name, err)
+		}
+		attr := &Attribute{
+			Type:        ty,
+			Required:    opts["required"],
+			Optional:    opts["optional"],
+			Computed:    opts["computed"],
+			Sensitive:   opts["sensitive"],
+			Description: field.Tag.Get("description"),
+		}
+		if !attr.Required && !attr.Optional && !attr.Computed {
+			if field.Type.Kind() == reflect.Ptr {
+				attr.Optional = true
+			} else {
+				attr.Required = true
+			}
+		}
+		ret.Attributes[name] = attr
+	}
+
+	return ret, nil
+}
+
+// structFieldOptions parses the given "tf" struct tag value into a set of
+// options.
+func structFieldOptions(tag string) map[string]bool {
+	ret := make(map[string]bool)
+	for _, opt := range strings.Split(tag, ",") {
+		if opt = strings.TrimSpace(opt); opt != "" {
+			ret[opt] = true
+		}
+	}
+	return ret
+}
+
+// structFieldNesting returns the nesting mode and struct type for a field of
+// the given type if it represents a nested block type, or nestingInvalid if
+// it represents an attribute.
+func structFieldNesting(rt reflect.Type, opts map[string]bool) (NestingMode, reflect.Type) {
+	switch rt.Kind() {
+	case reflect.Struct:
+		if isStructBlockType(rt) {
+			return NestingGroup, rt
+		}
+	case reflect.Ptr:
+		if isStructBlockType(rt.Elem()) {
+			return NestingSingle, rt.Elem()
+		}
+	case reflect.Slice:
+		if elemTy := structElemType(rt.Elem()); elemTy != nil {
+			if opts["set"] {
+				return NestingSet, elemTy
+			}
+			return NestingList, elemTy
+		}
+	case reflect.Map:
+		if elemTy := structElemType(rt.Elem()); elemTy != nil && rt.Key().Kind() == reflect.String {
+			return NestingMap, elemTy
+		}
+	}
+	return nestingInvalid, nil
+}
+
+// structElemType returns the struct type for a collection element of the given
+// type if it is a struct or a pointer to a struct, or nil otherwise.
+func structElemType(rt reflect.Type) reflect.Type {
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	if !isStructBlockType(rt) {
+		return nil
+	}
+	return rt
+}
+
+// isStructBlockType returns true if the given type is a struct type that
+// could represent the content of a block, rather than a special struct type
+// like cty.Value.
+func isStructBlockType(rt reflect.Type) bool {
+	return rt.Kind() == reflect.Struct && rt != ctyValueType && rt != bigFloatType && rt != bigIntType
+}
+
+// ctyTypeFromGoType returns the cty type corresponding to the given Go type
+// for use as the type of an attribute. Of the given options, only "set" is
+// relevant, and applies only to the outermost type. The visiting map is as for
+// blockTypeFromStruct.
+func ctyTypeFromGoType(rt reflect.Type, opts map[string]bool, visiting map[reflect.Type]bool) (cty.Type, error) {
+	switch rt {
+	case ctyValueType:
+		return cty.DynamicPseudoType, nil
+	case bigFloatType, bigIntType:
+		return cty.Number, nil
+	}
+
+	switch rt.Kind() {
+	case reflect.Ptr:
+		return ctyTypeFromGoType(rt.Elem(), opts, visiting)
+	case reflect.String:
+		return cty.String, nil
+	case reflect.Bool:
+		return cty.Bool, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return cty.Number, nil
+	case reflect.Slice:
+		ety, err := ctyTypeFromGoType(rt.Elem(), nil, visiting)
+		if err != nil {
+			return cty.NilType, err
+		}
+		if opts["set"] {
+			return cty.Set(ety), nil
+		}
+		return cty.List(ety), nil
+	case reflect.Map:
+		if rt.Key().Kind() != reflect.String {
+			return cty.NilType, fmt.Errorf("unsupported map key type %s; must be string", rt.Key())
+		}
+		ety, err := ctyTypeFromGoType(rt.Elem(), nil, visiting)
+		if err != nil {
+			return cty.NilType, err
+		}
+		return cty.Map(ety), nil
+	case reflect.Struct:
+		if visiting[rt] {
+			return cty.NilType, fmt.Errorf("recursive reference to %s is not supported", rt)
+		}
+		visiting[rt] = true
+		defer delete(visiting, rt)
+
+		atys := make(map[string]cty.Type)
+		for i := 0; i < rt.NumField(); i++ {
+			field := rt.Field(i)
+			name := field.Tag.Get("cty")
+			if name == "" || field.PkgPath != "" {
+				continue
+			}
+			aty, err := ctyTypeFromGoType(field.Type, structFieldOptions(field.Tag.Get("tf")), visiting)
+			if err != nil {
+				return cty.NilType, fmt.Errorf("%s: %s", field.Name, err)
+			}
+			atys[name] = aty
+		}
+		return cty.Object(atys), nil
+	default:
+		return cty.NilType, fmt.Errorf("unsupported type %s", rt)
+	}
+}
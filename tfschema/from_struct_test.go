@@ -0,0 +1,198 @@
+package tfschema
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestFromStruct(t *testing.T) {
+	type networkInterface struct {
+		CreatePublicAddrs bool `cty:"create_public_addrs" tf:"optional"`
+	}
+	type access struct {
+		Policy cty.Value `cty:"policy" tf:"optional"`
+	}
+	type disk struct {
+		Label string   `cty:"label"`
+		Tags  []string `cty:"tags" tf:"optional,set"`
+	}
+	type instance struct {
+		ID       *string           `cty:"id" tf:"computed" description:"The id of the instance."`
+		Version  *int              `cty:"version" tf:"optional,computed"`
+		Type     string            `cty:"type"`
+		Image    *string           `cty:"image"`
+		Password string            `cty:"password" tf:"sensitive"`
+		Labels   map[string]string `cty:"labels" tf:"optional"`
+		Ignored  string
+
+		Access            access                       `cty:"access"`
+		Placement         *access                      `cty:"placement"`
+		Disks             []disk                       `cty:"disk" tf:"set"`
+		NetworkInterfaces map[string]*networkInterface `cty:"network_interface"`
+	}
+
+	got, err := FromStruct((*instance)(nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := &BlockType{
+		Attributes: map[string]*Attribute{
+			"id":       {Type: cty.String, Computed: true, Description: "The id of the instance."},
+			"version":  {Type: cty.Number, Optional: true, Computed: true},
+			"type":     {Type: cty.String, Required: true},
+			"image":    {Type: cty.String, Optional: true},
+			"password": {Type: cty.String, Required: true, Sensitive: true},
+			"labels":   {Type: cty.Map(cty.String), Optional: true},
+		},
+		NestedBlockTypes: map[string]*NestedBlockType{
+			"access": {
+				Nesting: NestingGroup,
+				Content: BlockType{
+					Attributes: map[string]*Attribute{
+						"policy": {Type: cty.DynamicPseudoType, Optional: true},
+					},
+					NestedBlockTypes: map[string]*NestedBlockType{},
+				},
+			},
+			"placement": {
+				Nesting: NestingSingle,
+				Content: BlockType{
+					Attributes: map[string]*Attribute{
+						"policy": {Type: cty.DynamicPseudoType, Optional: true},
+					},
+					NestedBlockTypes: map[string]*NestedBlockType{},
+				},
+			},
+			"disk": {
+				Nesting: NestingSet,
+				Content: BlockType{
+					Attributes: map[string]*Attribute{
+						"label": {Type: cty.String, Required: true},
+						"tags":  {Type: cty.Set(cty.String), Optional: true},
+					},
+					NestedBlockTypes: map[string]*NestedBlockType{},
+				},
+			},
+			"network_interface": {
+				Nesting: NestingMap,
+				Content: BlockType{
+					Attributes: map[string]*Attribute{
+						"create_public_addrs": {Type: cty.Bool, Optional: true},
+					},
+					NestedBlockTypes: map[string]*NestedBlockType{},
+				},
+			},
+		},
+	}
+
+	if !want.ImpliedCtyType().Equals(got.ImpliedCtyType()) {
+		t.Errorf("wrong type\ngot:  %#v\nwant: %#v", got.ImpliedCtyType(), want.ImpliedCtyType())
+	}
+	for name, wantS := range want.Attributes {
+		gotS := got.Attributes[name]
+		if gotS == nil {
+			t.Errorf("missing attribute %q", name)
+			continue
+		}
+		if gotS.Required != wantS.Required || gotS.Optional != wantS.Optional || gotS.Computed != wantS.Computed || gotS.Sensitive != wantS.Sensitive {
+			t.Errorf("wrong flags for %q\ngot:  %#v\nwant: %#v", name, gotS, wantS)
+		}
+		if gotS.Description != wantS.Description {
+			t.Errorf("wrong description for %q %q; want %q", name, gotS.Description, wantS.Description)
+		}
+	}
+	for name, wantS := range want.NestedBlockTypes {
+		gotS := got.NestedBlockTypes[name]
+		if gotS == nil {
+			t.Errorf("missing block type %q", name)
+			continue
+		}
+		if gotS.Nesting != wantS.Nesting {
+			t.Errorf("wrong nesting mode for %q %s; want %s", name, gotS.Nesting, wantS.Nesting)
+		}
+	}
+	if errs := got.InternalValidate(); len(errs) != 0 {
+		t.Errorf("result is invalid: %#v", errs)
+	}
+}
+
+func TestFromStructErrors(t *testing.T) {
+	tests := map[string]struct {
+		v    interface{}
+		want string
+	}{
+		"not a struct": {
+			"hello",
+			"must be a struct or pointer to struct, not string",
+		},
+		"unsupported field type": {
+			struct {
+				Fn func() `cty:"fn"`
+			}{},
+			"Fn: unsupported type func()",
+		},
+		"unsupported map key type": {
+			struct {
+				Counts map[int]string `cty:"counts"`
+			}{},
+			"Counts: unsupported map key type int; must be string",
+		},
+		"nested block": {
+			struct {
+				Block *struct {
+					Ch chan int `cty:"ch"`
+				} `cty:"block"`
+			}{},
+			"Block.Ch: unsupported type chan int",
+		},
+		"recursive nested block": {
+			(*testRecursiveBlock)(nil),
+			"Children: recursive reference to tfschema.testRecursiveBlock is not supported",
+		},
+		"indirectly recursive nested block": {
+			struct {
+				Block testRecursiveBlockA `cty:"block"`
+			}{},
+			"Block.B.A: recursive reference to tfschema.testRecursiveBlockA is not supported",
+		},
+		"recursive attribute": {
+			struct {
+				Tree [][]testRecursiveAttr `cty:"tree"`
+			}{},
+			"Tree: Children: recursive reference to tfschema.testRecursiveAttr is not supported",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := FromStruct(test.v)
+			if err == nil {
+				t.Fatalf("unexpected success")
+			}
+			if got := err.Error(); !strings.Contains(got, test.want) {
+				t.Errorf("wrong error\ngot:  %s\nwant: %s", got, test.want)
+			}
+		})
+	}
+}
+
+type testRecursiveBlock struct {
+	Name     string               `cty:"name"`
+	Children []testRecursiveBlock `cty:"child"`
+}
+
+type testRecursiveBlockA struct {
+	B *testRecursiveBlockB `cty:"b"`
+}
+
+type testRecursiveBlockB struct {
+	A []testRecursiveBlockA `cty:"a"`
+}
+
+// testRecursiveAttr is used within a list of lists, which makes it the
+// element type of an attribute rather than a nested block type.
+type testRecursiveAttr struct {
+	Children [][]testRecursiveAttr `cty:"children"`
+}
@@ -0,0 +1,294 @@
+package tfschema
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+
+	"github.com/apparentlymart/terraform-sdk/internal/sdkdiags"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/gocty"
+)
+
+// validName is the pattern that all attribute and block type names must
+// match, as required by Terraform Core.
+var validName = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+var diagnosticsType = reflect.TypeOf(sdkdiags.Diagnostics(nil))
+var boolType = reflect.TypeOf(false)
+
+// InternalValidate checks the receiving schema for mistakes that would
+// prevent it from working correctly, such as invalid combinations of
+// attribute flags or unsupported nesting modes.
+//
+// The result contains one error for each problem found, each of which is
+// a cty.PathError whose path indicates the attribute or nested block type
+// that has the problem. If the result is empty then the schema is valid.
+//
+// Providers should call InternalValidate (or Provider.InternalValidate in
+// the main SDK package, which calls it for all schemas in a provider) from
+// their tests, so that mistakes are caught before the provider is released.
+func (b *BlockType) InternalValidate() []error {
+	return b.internalValidate(nil)
+}
+
+func (b *BlockType) internalValidate(path cty.Path) []error {
+	var errs []error
+	if b == nil {
+		// A nil schema is treated as an empty block.
+		return errs
+	}
+
+	if b.ValidateFn != nil {
+		if err := checkFuncSignature(b.ValidateFn, 1, "Diagnostics", diagnosticsType); err != nil {
+			errs = append(errs, path.NewErrorf("invalid ValidateFn: %s", err))
+		}
+	}
+
+	relationships := []struct {
+		name   string
+		groups [][]cty.Path
+	}{
+		{"ConflictsWith", b.ConflictsWith},
+		{"ExactlyOneOf", b.ExactlyOneOf},
+		{"RequiredWith", b.RequiredWith},
+		{"AtLeastOneOf", b.AtLeastOneOf},
+	}
+	for _, rel := range relationships {
+		for _, group := range rel.groups {
+			if len(group) < 2 {
+				errs = append(errs, path.NewErrorf("each %s group must have at least two paths", rel.name))
+			}
+			for _, relPath := range group {
+				if err := b.checkRelativeAttrPath(relPath); err != nil {
+					errs = append(errs, path.NewErrorf("invalid path in %s: %s", rel.name, err))
+				}
+			}
+		}
+	}
+
+	for name, attrS := range b.Attributes {
+		path := path.GetAttr(name)
+		if !validName.MatchString(name) {
+			errs = append(errs, path.NewErrorf("name may contain only lowercase letters, digits, and underscores"))
+		}
+		if attrS == nil {
+			errs = append(errs, path.NewErrorf("attribute schema must not be nil"))
+			continue
+		}
+		errs = append(errs, attrS.internalValidate(path)...)
+	}
+
+	for name, blockS := range b.NestedBlockTypes {
+		path := path.GetAttr(name)
+		if !validName.MatchString(name) {
+			errs = append(errs, path.NewErrorf("name may contain only lowercase letters, digits, and underscores"))
+		}
+		if _, exists := b.Attributes[name]; exists {
+			errs = append(errs, path.NewErrorf("name is used by both an attribute and a nested block type"))
+		}
+		if blockS == nil {
+			errs = append(errs, path.NewErrorf("nested block type schema must not be nil"))
+			continue
+		}
+		errs = append(errs, blockS.internalValidate(path)...)
+	}
+
+	return errs
+}
+
+// checkRelativeAttrPath checks that the given path, relative to an object of
+// the receiving block type, refers to an attribute.
+func (b *BlockType) checkRelativeAttrPath(path cty.Path) error {
+	if len(path) == 0 {
+		return fmt.Errorf("path must not be empty")
+	}
+	current := b
+	for i := 0; i < len(path); i++ {
+		step, ok := path[i].(cty.GetAttrStep)
+		if !ok {
+			return fmt.Errorf("step %d must be an attribute or block type name", i)
+		}
+		if _, exists := current.Attributes[step.Name]; exists {
+			if i != len(path)-1 {
+				return fmt.Errorf("path cannot traverse into the value of attribute %q", step.Name)
+			}
+			return nil
+		}
+		blockS, exists := current.NestedBlockTypes[step.Name]
+		if !exists || blockS == nil {
+			return fmt.Errorf("no attribute or block type named %q", step.Name)
+		}
+		switch blockS.Nesting {
+		case NestingList, NestingMap:
+			i++
+			if i >= len(path) {
+				return fmt.Errorf("path must end with an attribute, not block type %q", step.Name)
+			}
+			idx, ok := path[i].(cty.IndexStep)
+			wantTy := cty.Number
+			if blockS.Nesting == NestingMap {
+				wantTy = cty.String
+			}
+			if !ok || idx.Key.Type() != wantTy {
+				return fmt.Errorf("block type %q must be followed by an index of type %s", step.Name, wantTy.FriendlyName())
+			}
+		case NestingSet:
+			return fmt.Errorf("path cannot traverse into block type %q, because it uses NestingSet", step.Name)
+		}
+		if i == len(path)-1 {
+			return fmt.Errorf("path must end with an attribute, not block type %q", step.Name)
+		}
+		current = &blockS.Content
+	}
+	return nil
+}
+
+func (a *Attribute) internalValidate(path cty.Path) []error {
+	var errs []error
+
+	switch {
+	case a.NestedType != nil && a.Type != cty.NilType:
+		errs = append(errs, path.NewErrorf("Type and NestedType are mutually exclusive"))
+	case a.NestedType == nil && a.Type == cty.NilType:
+		errs = append(errs, path.NewErrorf("either Type or NestedType must be set"))
+	}
+
+	switch {
+	case !a.Required && !a.Optional && !a.Computed:
+		errs = append(errs, path.NewErrorf("must set Required, Optional, or Computed"))
+	case a.Required && a.Optional:
+		errs = append(errs, path.NewErrorf("Required and Optional are mutually exclusive"))
+	case a.Required && a.Computed:
+		errs = append(errs, path.NewErrorf("Required and Computed are mutually exclusive"))
+	}
+
+	if a.Default != nil {
+		switch {
+		case !a.Optional:
+			errs = append(errs, path.NewErrorf("Default may be set only for an Optional attribute"))
+		case a.Computed:
+			errs = append(errs, path.NewErrorf("Default cannot be used with Computed, since the provider decides the value of an unset computed attribute"))
+		}
+		if a.NestedType != nil || a.Type != cty.NilType {
+			if _, err := gocty.ToCtyValue(a.Default, a.ImpliedCtyType()); err != nil {
+				errs = append(errs, path.NewErrorf("Default is not a valid value for this attribute's type: %s", err))
+			}
+		}
+	}
+
+	if a.DefaultFn != nil {
+		switch {
+		case a.Default != nil:
+			errs = append(errs, path.NewErrorf("Default and DefaultFn are mutually exclusive"))
+		case !a.Optional:
+			errs = append(errs, path.NewErrorf("DefaultFn may be set only for an Optional attribute"))
+		case a.Computed:
+			errs = append(errs, path.NewErrorf("DefaultFn cannot be used with Computed, since the provider decides the value of an unset computed attribute"))
+		}
+		if err := checkFuncSignature(a.DefaultFn, 1, "a value and Diagnostics", nil, diagnosticsType); err != nil {
+			errs = append(errs, path.NewErrorf("invalid DefaultFn: %s", err))
+		}
+	}
+
+	if a.ValidateFn != nil {
+		if err := checkFuncSignature(a.ValidateFn, 1, "Diagnostics", diagnosticsType); err != nil {
+			errs = append(errs, path.NewErrorf("invalid ValidateFn: %s", err))
+		}
+	}
+	if a.SemanticEqualFn != nil {
+		if err := checkFuncSignature(a.SemanticEqualFn, 2, "(bool, Diagnostics)", boolType, diagnosticsType); err != nil {
+			errs = append(errs, path.NewErrorf("invalid SemanticEqualFn: %s", err))
+		}
+	}
+	for i, modifier := range a.PlanModifiers {
+		if err := checkFuncSignature(modifier, 1, "Diagnostics", diagnosticsType); err != nil {
+			errs = append(errs, path.NewErrorf("invalid PlanModifiers[%d]: %s", i, err))
+		}
+	}
+	if a.RequiresReplaceIf != nil {
+		if err := checkFuncSignature(a.RequiresReplaceIf, 2, "(bool, Diagnostics)", boolType, diagnosticsType); err != nil {
+			errs = append(errs, path.NewErrorf("invalid RequiresReplaceIf: %s", err))
+		}
+	}
+
+	if a.NestedType != nil {
+		nt := a.NestedType
+		switch nt.Nesting {
+		case NestingSingle, NestingList, NestingMap, NestingSet:
+			// valid
+		default:
+			errs = append(errs, path.NewErrorf("unsupported nesting mode %s for a nested attribute type", nt.Nesting))
+		}
+		errs = append(errs, checkItemsLimits(path, nt.Nesting, nt.MinItems, nt.MaxItems)...)
+		if nt.Nesting == NestingSet && nt.ImpliedCtyType().HasDynamicTypes() {
+			errs = append(errs, path.NewErrorf("a nested attribute type with NestingSet cannot contain dynamically-typed attributes"))
+		}
+		content := &BlockType{Attributes: nt.Attributes}
+		errs = append(errs, content.internalValidate(path)...)
+	}
+
+	return errs
+}
+
+func (b *NestedBlockType) internalValidate(path cty.Path) []error {
+	var errs []error
+
+	switch b.Nesting {
+	case NestingSingle, NestingGroup, NestingList, NestingMap, NestingSet:
+		// valid
+	default:
+		errs = append(errs, path.NewErrorf("unsupported nesting mode %s", b.Nesting))
+	}
+	errs = append(errs, checkItemsLimits(path, b.Nesting, b.MinItems, b.MaxItems)...)
+	if b.Nesting == NestingSet && b.Content.ImpliedCtyType().HasDynamicTypes() {
+		errs = append(errs, path.NewErrorf("a nested block type with NestingSet cannot contain dynamically-typed attributes"))
+	}
+
+	errs = append(errs, b.Content.internalValidate(path)...)
+	return errs
+}
+
+// checkItemsLimits checks the MinItems and MaxItems settings for a nested
+// block type or nested attribute type with the given nesting mode.
+func checkItemsLimits(path cty.Path, nesting NestingMode, min, max int) []error {
+	var errs []error
+	switch {
+	case min < 0 || max < 0:
+		errs = append(errs, path.NewErrorf("MinItems and MaxItems must not be negative"))
+	case max != 0 && min > max:
+		errs = append(errs, path.NewErrorf("MinItems must be less than or equal to MaxItems"))
+	case nesting == NestingSingle && (min > 1 || max > 1):
+		errs = append(errs, path.NewErrorf("MinItems and MaxItems must be either 0 or 1 for NestingSingle"))
+	case nesting == NestingGroup && (min != 0 || max != 0):
+		errs = append(errs, path.NewErrorf("MinItems and MaxItems cannot be used with NestingGroup"))
+	}
+	return errs
+}
+
+// checkFuncSignature checks that the given value is a function with the given
+// number of arguments and the given return types, as the SDK requires for
+// dynamically-called functions such as ValidateFn. outDesc describes the
+// return types for use in error messages. A nil type in out permits a return
+// value of any type in that position.
+//
+// The argument types are not checked, because the SDK converts arguments to
+// whatever types the function expects.
+func checkFuncSignature(f interface{}, numIn int, outDesc string, out ...reflect.Type) error {
+	ft := reflect.TypeOf(f)
+	if ft.Kind() != reflect.Func {
+		return fmt.Errorf("must be a function, not %s", ft.Kind())
+	}
+	if got := ft.NumIn(); got != numIn {
+		return fmt.Errorf("must have %d arguments, but has %d", numIn, got)
+	}
+	if ft.NumOut() != len(out) {
+		return fmt.Errorf("must return %s", outDesc)
+	}
+	for i, want := range out {
+		if want != nil && !ft.Out(i).AssignableTo(want) {
+			return fmt.Errorf("must return %s", outDesc)
+		}
+	}
+	return nil
+}
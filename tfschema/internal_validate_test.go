@@ -0,0 +1,242 @@
+package tfschema
+
+import (
+	"testing"
+
+	"github.com/apparentlymart/terraform-sdk/internal/sdkdiags"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestBlockTypeInternalValidate(t *testing.T) {
+	tests := map[string]struct {
+		Schema *BlockType
+		Want   []string
+	}{
+		"valid": {
+			&BlockType{
+				Attributes: map[string]*Attribute{
+					"id":   {Type: cty.String, Computed: true},
+					"name": {Type: cty.String, Required: true},
+					"size": {Type: cty.Number, Optional: true, Default: 1},
+					"mode": {
+						Type:       cty.String,
+						Optional:   true,
+						ValidateFn: func(string) sdkdiags.Diagnostics { return nil },
+					},
+				},
+				NestedBlockTypes: map[string]*NestedBlockType{
+					"disk": {
+						Nesting:  NestingList,
+						MaxItems: 2,
+						Content: BlockType{
+							Attributes: map[string]*Attribute{
+								"label": {Type: cty.String, Required: true},
+							},
+						},
+					},
+				},
+			},
+			nil,
+		},
+		"nil": {
+			nil,
+			nil,
+		},
+		"invalid name": {
+			&BlockType{
+				Attributes: map[string]*Attribute{
+					"Name": {Type: cty.String, Required: true},
+				},
+			},
+			[]string{".Name: name may contain only lowercase letters, digits, and underscores"},
+		},
+		"required and computed": {
+			&BlockType{
+				Attributes: map[string]*Attribute{
+					"name": {Type: cty.String, Required: true, Computed: true},
+				},
+			},
+			[]string{".name: Required and Computed are mutually exclusive"},
+		},
+		"no type": {
+			&BlockType{
+				Attributes: map[string]*Attribute{
+					"name": {Optional: true},
+				},
+			},
+			[]string{".name: either Type or NestedType must be set"},
+		},
+		"default on required attribute": {
+			&BlockType{
+				Attributes: map[string]*Attribute{
+					"name": {Type: cty.String, Required: true, Default: "a"},
+				},
+			},
+			[]string{".name: Default may be set only for an Optional attribute"},
+		},
+		"default of wrong type": {
+			&BlockType{
+				Attributes: map[string]*Attribute{
+					"size": {Type: cty.Number, Optional: true, Default: "big"},
+				},
+			},
+			[]string{".size: Default is not a valid value for this attribute's type: can't convert Go string to number"},
+		},
+		"Default and DefaultFn": {
+			&BlockType{
+				Attributes: map[string]*Attribute{
+					"port": {
+						Type:      cty.Number,
+						Optional:  true,
+						Default:   80,
+						DefaultFn: EnvDefaultFn("PORT", cty.NullVal(cty.Number)),
+					},
+				},
+			},
+			[]string{".port: Default and DefaultFn are mutually exclusive"},
+		},
+		"invalid DefaultFn": {
+			&BlockType{
+				Attributes: map[string]*Attribute{
+					"port": {Type: cty.Number, Optional: true, DefaultFn: func() int { return 80 }},
+				},
+			},
+			[]string{".port: invalid DefaultFn: must have 1 arguments, but has 0"},
+		},
+		"invalid PlanModifiers": {
+			&BlockType{
+				Attributes: map[string]*Attribute{
+					"id": {Type: cty.String, Computed: true, PlanModifiers: []interface{}{"nope"}},
+				},
+			},
+			[]string{".id: invalid PlanModifiers[0]: must be a function, not string"},
+		},
+		"invalid SemanticEqualFn": {
+			&BlockType{
+				Attributes: map[string]*Attribute{
+					"name": {Type: cty.String, Optional: true, SemanticEqualFn: func(a, b string) bool { return a == b }},
+				},
+			},
+			[]string{".name: invalid SemanticEqualFn: must return (bool, Diagnostics)"},
+		},
+		"invalid ValidateFn": {
+			&BlockType{
+				Attributes: map[string]*Attribute{
+					"name": {Type: cty.String, Optional: true, ValidateFn: func(string) error { return nil }},
+				},
+			},
+			[]string{".name: invalid ValidateFn: must return Diagnostics"},
+		},
+		"invalid block ValidateFn": {
+			&BlockType{
+				NestedBlockTypes: map[string]*NestedBlockType{
+					"disk": {
+						Nesting: NestingList,
+						Content: BlockType{
+							ValidateFn: func(interface{}) bool { return true },
+						},
+					},
+				},
+			},
+			[]string{".disk: invalid ValidateFn: must return Diagnostics"},
+		},
+		"relationship paths": {
+			&BlockType{
+				Attributes: map[string]*Attribute{
+					"a": {Type: cty.String, Optional: true},
+				},
+				NestedBlockTypes: map[string]*NestedBlockType{
+					"disk": {
+						Nesting: NestingList,
+						Content: BlockType{
+							Attributes: map[string]*Attribute{
+								"label": {Type: cty.String, Optional: true},
+							},
+						},
+					},
+				},
+				ConflictsWith: [][]cty.Path{
+					{cty.GetAttrPath("a"), cty.GetAttrPath("disk").Index(cty.NumberIntVal(0)).GetAttr("label")},
+				},
+				ExactlyOneOf: [][]cty.Path{
+					{cty.GetAttrPath("a"), cty.GetAttrPath("b")},
+				},
+				AtLeastOneOf: [][]cty.Path{
+					{cty.GetAttrPath("a"), cty.GetAttrPath("disk").GetAttr("label")},
+				},
+				RequiredWith: [][]cty.Path{
+					{cty.GetAttrPath("a")},
+				},
+			},
+			[]string{
+				`invalid path in ExactlyOneOf: no attribute or block type named "b"`,
+				"each RequiredWith group must have at least two paths",
+				`invalid path in AtLeastOneOf: block type "disk" must be followed by an index of type number`,
+			},
+		},
+		"invalid nesting mode": {
+			&BlockType{
+				NestedBlockTypes: map[string]*NestedBlockType{
+					"disk": {},
+				},
+			},
+			[]string{".disk: unsupported nesting mode nestingInvalid"},
+		},
+		"MinItems greater than MaxItems": {
+			&BlockType{
+				NestedBlockTypes: map[string]*NestedBlockType{
+					"disk": {Nesting: NestingList, MinItems: 2, MaxItems: 1},
+				},
+			},
+			[]string{".disk: MinItems must be less than or equal to MaxItems"},
+		},
+		"set block with dynamic attribute": {
+			&BlockType{
+				NestedBlockTypes: map[string]*NestedBlockType{
+					"disk": {
+						Nesting: NestingSet,
+						Content: BlockType{
+							Attributes: map[string]*Attribute{
+								"value": {Type: cty.DynamicPseudoType, Optional: true},
+							},
+						},
+					},
+				},
+			},
+			[]string{".disk: a nested block type with NestingSet cannot contain dynamically-typed attributes"},
+		},
+		"problem in nested block": {
+			&BlockType{
+				NestedBlockTypes: map[string]*NestedBlockType{
+					"disk": {
+						Nesting: NestingSingle,
+						Content: BlockType{
+							Attributes: map[string]*Attribute{
+								"label": {Type: cty.String},
+							},
+						},
+					},
+				},
+			},
+			[]string{".disk.label: must set Required, Optional, or Computed"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			errs := test.Schema.InternalValidate()
+			var got []string
+			for _, err := range errs {
+				got = append(got, sdkdiags.FormatError(err))
+			}
+			if len(got) != len(test.Want) {
+				t.Fatalf("wrong errors\ngot:  %#v\nwant: %#v", got, test.Want)
+			}
+			for i := range got {
+				if got[i] != test.Want[i] {
+					t.Errorf("wrong error %d\ngot:  %s\nwant: %s", i, got[i], test.Want[i])
+				}
+			}
+		})
+	}
+}
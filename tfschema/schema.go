@@ -3,6 +3,7 @@ package tfschema
 import (
 	"fmt"
 
+	"github.com/apparentlymart/terraform-sdk/internal/sdkdiags"
 	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/gocty"
 )
@@ -10,6 +11,47 @@ import (
 type BlockType struct {
 	Attributes       map[string]*Attribute
 	NestedBlockTypes map[string]*NestedBlockType
+
+	// ValidateFn, if non-nil, must be set to a function that takes a single
+	// argument and returns Diagnostics. The function will be called during
+	// validation, after all of the attributes and nested blocks in the block
+	// have been validated individually, and so it can be used to check
+	// constraints that involve more than one attribute, such as attributes
+	// that are mutually exclusive.
+	//
+	// The argument is usually a tfobj.ObjectReader, which gives access to
+	// the whole block object. Other argument types are populated from the
+	// block's object value using package gocty.
+	//
+	// The function is not called if the individual validation checks have
+	// already produced errors. Attribute values within the object may still
+	// be unknown, so the function should skip any checks that depend on
+	// an unknown value.
+	//
+	// Diagnostics returned from the function must have Path values relative
+	// to the block object, which will be appended to the path of the block
+	// by the caller during a full validation walk.
+	ValidateFn interface{}
+
+	// ConflictsWith, ExactlyOneOf, RequiredWith, and AtLeastOneOf each
+	// declare zero or more groups of related attributes, whose relationships
+	// are checked automatically during validation. Each group is a slice of
+	// paths relative to the block object, each of which must refer to an
+	// attribute either in this block or in a nested block reached through
+	// its block type name and, for NestingList and NestingMap, an index.
+	//
+	// An attribute counts as set if its value is not null. If any attribute
+	// in a group has an unknown value then the checks that depend on it are
+	// deferred until its value is known.
+	//
+	// For ConflictsWith groups, at most one attribute in each group may be
+	// set. For ExactlyOneOf groups, exactly one attribute must be set. For
+	// RequiredWith groups, if any attribute is set then all of them must be
+	// set. For AtLeastOneOf groups, at least one attribute must be set.
+	ConflictsWith [][]cty.Path
+	ExactlyOneOf  [][]cty.Path
+	RequiredWith  [][]cty.Path
+	AtLeastOneOf  [][]cty.Path
 }
 
 type Attribute struct {
@@ -19,6 +61,20 @@ type Attribute struct {
 	// specific constraints on acceptable values.
 	Type cty.Type
 
+	// NestedType, if non-nil, declares that this attribute's value is one or
+	// more objects that each have their own attribute schema, as an
+	// alternative to declaring a nested block type. NestedType and Type are
+	// mutually exclusive; when NestedType is set, the attribute's value type
+	// is derived from it and Type must be left unset.
+	//
+	// Nested attribute types are supported only by Terraform versions that
+	// speak plugin protocol version 6. When a provider is served over
+	// protocol version 5, a nested attribute type is presented to Terraform
+	// as a plain attribute of the equivalent type, and so the per-attribute
+	// Required, Optional, and Computed flags within it are not visible to
+	// Terraform Core.
+	NestedType *NestedAttributeType
+
 	// Required, Optional, and Computed together define how this attribute
 	// behaves in configuration and during change actions.
 	//
@@ -75,6 +131,96 @@ type Attribute struct {
 	// leave Default as nil and mark the attribute instead as Computed, allowing
 	// the value to be assigned either during planning or during apply.
 	Default interface{}
+
+	// RequiresReplace, if set, indicates that a change to the value of this
+	// attribute cannot be applied in-place, and so the SDK will plan to
+	// replace the whole object whenever the planned value differs from the
+	// prior value.
+	//
+	// Elements of a NestingSet block have no key, so the attributes marked
+	// as RequiresReplace in such a block also serve to correlate each
+	// planned element with its prior element, for RequiresReplaceIf.
+	RequiresReplace bool
+
+	// RequiresReplaceIf, if non-nil, must be set to a function that takes
+	// two arguments, the prior and planned values of the attribute, and
+	// returns a bool and Diagnostics. The function is called during planning
+	// whenever the prior and planned values differ, and should return true
+	// if that particular change cannot be applied in-place.
+	//
+	// The arguments are converted to the function's argument types using
+	// package gocty. Either value may be null and the planned value may be
+	// unknown, so cty.Value arguments are usually the most appropriate.
+	//
+	// RequiresReplaceIf is ignored if RequiresReplace is also set.
+	RequiresReplaceIf interface{}
+
+	// SemanticEqualFn, if non-nil, must be set to a function that takes two
+	// arguments and returns a bool and Diagnostics. The function is called
+	// with two different values for the attribute and should return true if
+	// they are semantically equivalent, such as two JSON documents that differ
+	// only in whitespace or two identifiers that differ only in case.
+	//
+	// When planning a change, the SDK keeps the prior value of an attribute
+	// whose new value is equivalent to it, so that no change is planned. The
+	// SDK similarly keeps the planned value when the result of applying a
+	// change has an equivalent value, and keeps the prior value when a
+	// refreshed object has an equivalent value.
+	//
+	// The arguments, which are always known and not null, are converted to the
+	// function's argument types using package gocty.
+	SemanticEqualFn interface{}
+
+	// PlanModifiers is a sequence of functions that each adjust the planned
+	// value for the attribute when planning a change to a managed resource
+	// instance. Each must take a single tfobj.AttributePlanBuilder argument
+	// and return Diagnostics.
+	//
+	// The modifiers are called in the given order after default values have
+	// been applied but before the resource type's PlanFn, if any, so the
+	// PlanFn sees their results. They are not called when planning to
+	// destroy an object, or for attributes inside nested blocks of
+	// tfschema.NestingSet mode.
+	//
+	// Package tfobj has some commonly-needed modifiers, including
+	// tfobj.UseStateForUnknown and tfobj.RequiresReplaceIfChanged.
+	//
+	// Diagnostics returned from a modifier must have Path values relative to
+	// the attribute.
+	PlanModifiers []interface{}
+
+	// DefaultFn, if non-nil, must be set to a function that takes a single
+	// argument and returns a value and Diagnostics. It serves the same purpose
+	// as Default, but is called each time a default value is needed, which
+	// allows the default to be decided dynamically, such as from an
+	// environment variable or from the values of other attributes.
+	//
+	// The argument receives the object that contains the attribute, before
+	// any other defaults have been applied, converted to the type of the
+	// argument in the same way as for ValidateFn. Values within the object may
+	// be unknown, so a cty.Value argument is usually the most appropriate. The
+	// returned value is converted to the attribute's type, using package
+	// convert if it is a cty.Value or package gocty otherwise. Return a null
+	// value to leave the attribute unset.
+	//
+	// Diagnostics returned from the function must have Path values relative
+	// to the object given in the argument.
+	//
+	// Default and DefaultFn are mutually exclusive. The package-level
+	// functions EnvDefaultFn and MultiEnvDefaultFn return functions suitable
+	// for DefaultFn that take defaults from environment variables.
+	//
+	// The SDK calls DefaultFn when preparing the provider configuration and
+	// when planning changes. This package cannot call it by itself, though,
+	// so the ApplyDefaults methods leave attributes with DefaultFn set as
+	// null. Use the ApplyDefaultsFunc methods to supply a way to call it.
+	DefaultFn interface{}
+
+	// Deprecated, if non-empty, marks the attribute as deprecated. The
+	// attribute still works, but a warning including this message is
+	// returned during validation whenever it is set in configuration.
+	// The message should explain what the user should do instead.
+	Deprecated string
 }
 
 type NestedBlockType struct {
@@ -82,6 +228,28 @@ type NestedBlockType struct {
 	Content BlockType
 
 	MaxItems, MinItems int
+
+	// Deprecated, if non-empty, marks the block type as deprecated, with
+	// the same meaning as for Attribute.Deprecated.
+	Deprecated string
+}
+
+// NestedAttributeType describes the structure of the value of an attribute
+// that has a nested type, as an alternative to a nested block type.
+//
+// Unlike nested block types, the attributes in a nested attribute type may be
+// set as a whole from an arbitrary expression in configuration, and each of
+// the nested attributes has its own Required, Optional, and Computed flags.
+//
+// Only NestingSingle, NestingList, NestingSet, and NestingMap are valid
+// nesting modes for a nested attribute type.
+type NestedAttributeType struct {
+	Nesting    NestingMode
+	Attributes map[string]*Attribute
+
+	// MinItems and MaxItems constrain the number of elements for the
+	// collection nesting modes. They are ignored for NestingSingle.
+	MaxItems, MinItems int
 }
 
 type NestingMode int
@@ -103,17 +271,28 @@ const (
 // Will panic if the configured default cannot be converted to the attribute's
 // value type.
 func (a *Attribute) DefaultValue() cty.Value {
+	ty := a.ImpliedCtyType()
 	if a.Default == nil {
-		return cty.NullVal(a.Type)
+		return cty.NullVal(ty)
 	}
 
-	v, err := gocty.ToCtyValue(a.Default, a.Type)
+	v, err := gocty.ToCtyValue(a.Default, ty)
 	if err != nil {
-		panic(fmt.Sprintf("invalid default value %#v for %#v: %s", a.Default, a.Type, err))
+		panic(fmt.Sprintf("invalid default value %#v for %#v: %s", a.Default, ty, err))
 	}
 	return v
 }
 
+// ImpliedCtyType returns the type of value expected for the receiving
+// attribute. This is either the Type field or, if NestedType is set, the
+// type implied by the nested attribute type.
+func (a *Attribute) ImpliedCtyType() cty.Type {
+	if a.NestedType != nil {
+		return a.NestedType.ImpliedCtyType()
+	}
+	return a.Type
+}
+
 // Null returns a null value of the type implied by the receiving schema.
 func (b *BlockType) Null() cty.Value {
 	return cty.NullVal(b.ImpliedCtyType())
@@ -139,7 +318,7 @@ func (b *BlockType) Unknown() cty.Value {
 func (b *BlockType) ImpliedCtyType() cty.Type {
 	atys := make(map[string]cty.Type)
 	for name, attrS := range b.Attributes {
-		atys[name] = attrS.Type
+		atys[name] = attrS.ImpliedCtyType()
 	}
 	for name, blockS := range b.NestedBlockTypes {
 		atys[name] = blockS.impliedCtyType()
@@ -147,6 +326,26 @@ func (b *BlockType) ImpliedCtyType() cty.Type {
 	return cty.Object(atys)
 }
 
+// ImpliedCtyType derives a cty.Type value to represent values of an attribute
+// with the receiving nested type, following the same rules as for nested
+// block types with the same nesting mode.
+func (t *NestedAttributeType) ImpliedCtyType() cty.Type {
+	return t.blockType().impliedCtyType()
+}
+
+// blockType returns a nested block type that has the same value type as the
+// receiving nested attribute type, so that the two can share logic.
+func (t *NestedAttributeType) blockType() *NestedBlockType {
+	return &NestedBlockType{
+		Nesting: t.Nesting,
+		Content: BlockType{
+			Attributes: t.Attributes,
+		},
+		MaxItems: t.MaxItems,
+		MinItems: t.MinItems,
+	}
+}
+
 func (b *NestedBlockType) impliedCtyType() cty.Type {
 	nested := b.Content.ImpliedCtyType()
 	if b.Nesting == NestingSingle || b.Nesting == NestingGroup {
@@ -180,9 +379,34 @@ func (b *NestedBlockType) impliedCtyType() cty.Type {
 // schema) and returns a new object value where any null attribute values in
 // the given object are replaced with their default values from the schema.
 //
+// ApplyDefaults does not call DefaultFn functions. Any attribute that has
+// DefaultFn set is left null, and so the result differs from the object that
+// the SDK itself produces when planning. Use ApplyDefaultsFunc with a
+// DefaultFnCaller to apply those defaults too.
+//
 // The result is guaranteed to also conform to the schema. This function may
 // panic if the schema is incorrectly specified.
 func (b *BlockType) ApplyDefaults(given cty.Value) cty.Value {
+	ret, _ := b.ApplyDefaultsFunc(given, nil)
+	return ret
+}
+
+// DefaultFnCaller is the signature of a function that calls the DefaultFn of
+// the given attribute, which has the given name within the given object. It
+// must return a value of the attribute's type, along with any diagnostics
+// returned by DefaultFn, whose paths are relative to the given object.
+type DefaultFnCaller func(attr *Attribute, name string, obj cty.Value) (cty.Value, sdkdiags.Diagnostics)
+
+// ApplyDefaultsFunc is like ApplyDefaults except that it also uses the given
+// function to obtain default values for attributes that have DefaultFn set,
+// returning any diagnostics produced along with the new object. If the given
+// function is nil then ApplyDefaultsFunc behaves exactly like ApplyDefaults.
+//
+// The paths of the returned diagnostics are relative to the given object. The
+// resulting object has null values for any attributes whose DefaultFn
+// returned errors.
+func (b *BlockType) ApplyDefaultsFunc(given cty.Value, callDefaultFn DefaultFnCaller) (cty.Value, sdkdiags.Diagnostics) {
+	var diags sdkdiags.Diagnostics
 	vals := make(map[string]cty.Value)
 
 	for name, attrS := range b.Attributes {
@@ -191,20 +415,56 @@ func (b *BlockType) ApplyDefaults(given cty.Value) cty.Value {
 		if gv.IsNull() {
 			switch {
 			case attrS.Computed:
-				rv = cty.UnknownVal(attrS.Type)
+				rv = cty.UnknownVal(attrS.ImpliedCtyType())
+			case attrS.DefaultFn != nil && callDefaultFn != nil:
+				var moreDiags sdkdiags.Diagnostics
+				rv, moreDiags = callDefaultFn(attrS, name, given)
+				diags = diags.Append(moreDiags)
+				if moreDiags.HasErrors() {
+					rv = cty.NullVal(attrS.ImpliedCtyType())
+				}
 			default:
 				rv = attrS.DefaultValue()
 			}
+		} else if attrS.NestedType != nil {
+			var moreDiags sdkdiags.Diagnostics
+			rv, moreDiags = attrS.NestedType.ApplyDefaultsFunc(gv, callDefaultFn)
+			diags = diags.Append(moreDiags.UnderPath(cty.GetAttrPath(name)))
 		}
 		vals[name] = rv
 	}
 
 	for name, blockS := range b.NestedBlockTypes {
 		gv := given.GetAttr(name)
-		vals[name] = blockS.ApplyDefaults(gv)
+		rv, moreDiags := blockS.ApplyDefaultsFunc(gv, callDefaultFn)
+		diags = diags.Append(moreDiags.UnderPath(cty.GetAttrPath(name)))
+		vals[name] = rv
 	}
 
-	return cty.ObjectVal(vals)
+	return cty.ObjectVal(vals), diags
+}
+
+// ApplyDefaults takes a value conforming to the type implied by the receiving
+// nested attribute type and returns a new value where the defaults for the
+// nested attributes have been applied to each object.
+//
+// Unlike for nested block types, the given value may be null or unknown, in
+// which case it is returned verbatim.
+//
+// As with BlockType.ApplyDefaults, attributes with DefaultFn set are left
+// null. Use ApplyDefaultsFunc to apply those defaults too.
+func (t *NestedAttributeType) ApplyDefaults(given cty.Value) cty.Value {
+	ret, _ := t.ApplyDefaultsFunc(given, nil)
+	return ret
+}
+
+// ApplyDefaultsFunc is like ApplyDefaults except that it also calls DefaultFn
+// functions, in the same way as BlockType.ApplyDefaultsFunc.
+func (t *NestedAttributeType) ApplyDefaultsFunc(given cty.Value, callDefaultFn DefaultFnCaller) (cty.Value, sdkdiags.Diagnostics) {
+	if given.IsNull() || !given.IsKnown() {
+		return given, nil
+	}
+	return t.blockType().ApplyDefaultsFunc(given, callDefaultFn)
 }
 
 // ApplyDefaults takes a value conforming to the type that represents blocks of
@@ -212,63 +472,106 @@ func (b *BlockType) ApplyDefaults(given cty.Value) cty.Value {
 // to that type, with the result of SchemaBlockType.ApplyDefaults applied to
 // each element.
 //
-// This function expects that the given value will meet the guarantees offered
-// by Terraform Core for values representing nested block types: they will always
-// be known, and (aside from SchemaNestedSingle) never be null. If these
-// guarantees don't hold then this function will panic.
+// Terraform Core may present a nested block collection as unknown when its
+// blocks are generated from a value that isn't known yet. An unknown value is
+// returned verbatim, as is a null one.
+//
+// As with BlockType.ApplyDefaults, attributes with DefaultFn set are left
+// null. Use ApplyDefaultsFunc to apply those defaults too.
 func (b *NestedBlockType) ApplyDefaults(given cty.Value) cty.Value {
+	ret, _ := b.ApplyDefaultsFunc(given, nil)
+	return ret
+}
+
+// ApplyDefaultsFunc is like ApplyDefaults except that it also calls DefaultFn
+// functions, in the same way as BlockType.ApplyDefaultsFunc.
+//
+// The paths of any returned diagnostics are relative to the given value.
+func (b *NestedBlockType) ApplyDefaultsFunc(given cty.Value, callDefaultFn DefaultFnCaller) (cty.Value, sdkdiags.Diagnostics) {
+	var diags sdkdiags.Diagnostics
+	if !isDefaultableObject(given) {
+		return given, diags
+	}
 	wantTy := b.impliedCtyType()
 	switch b.Nesting {
 	case NestingSingle, NestingGroup:
-		if given.IsNull() {
-			return given
-		}
-		return b.Content.ApplyDefaults(given)
+		return b.Content.ApplyDefaultsFunc(given, callDefaultFn)
 	case NestingList:
 		vals := make([]cty.Value, 0, given.LengthInt())
 		for it := given.ElementIterator(); it.Next(); {
-			_, gv := it.Element()
-			vals = append(vals, b.Content.ApplyDefaults(gv))
+			k, gv := it.Element()
+			if !isDefaultableObject(gv) {
+				vals = append(vals, gv)
+				continue
+			}
+			rv, moreDiags := b.Content.ApplyDefaultsFunc(gv, callDefaultFn)
+			diags = diags.Append(moreDiags.UnderPath(cty.Path{cty.IndexStep{Key: k}}))
+			vals = append(vals, rv)
 		}
 		if !wantTy.IsListType() {
 			// Schema must contain dynamically-typed attributes then, so we'll
 			// return a tuple to properly capture the possibly-inconsistent
 			// element object types.
-			return cty.TupleVal(vals)
+			return cty.TupleVal(vals), diags
 		}
 		if len(vals) == 0 {
-			return cty.ListValEmpty(wantTy.ElementType())
+			return cty.ListValEmpty(wantTy.ElementType()), diags
 		}
-		return cty.ListVal(vals)
+		return cty.ListVal(vals), diags
 	case NestingMap:
 		vals := make(map[string]cty.Value, given.LengthInt())
 		for it := given.ElementIterator(); it.Next(); {
 			k, gv := it.Element()
-			vals[k.AsString()] = b.Content.ApplyDefaults(gv)
+			if !isDefaultableObject(gv) {
+				vals[k.AsString()] = gv
+				continue
+			}
+			rv, moreDiags := b.Content.ApplyDefaultsFunc(gv, callDefaultFn)
+			diags = diags.Append(moreDiags.UnderPath(cty.Path{cty.IndexStep{Key: k}}))
+			vals[k.AsString()] = rv
 		}
 		if !wantTy.IsMapType() {
 			// Schema must contain dynamically-typed attributes then, so we'll
 			// return an object to properly capture the possibly-inconsistent
 			// element object types.
-			return cty.ObjectVal(vals)
+			return cty.ObjectVal(vals), diags
 		}
 		if len(vals) == 0 {
-			return cty.MapValEmpty(wantTy.ElementType())
+			return cty.MapValEmpty(wantTy.ElementType()), diags
 		}
-		return cty.MapVal(vals)
+		return cty.MapVal(vals), diags
 	case NestingSet:
 		vals := make([]cty.Value, 0, given.LengthInt())
 		for it := given.ElementIterator(); it.Next(); {
 			_, gv := it.Element()
-			vals = append(vals, b.Content.ApplyDefaults(gv))
+			if !isDefaultableObject(gv) {
+				vals = append(vals, gv)
+				continue
+			}
+			// Set elements have no key we can use in a path, so any
+			// diagnostics are reported against the set as a whole.
+			rv, moreDiags := b.Content.ApplyDefaultsFunc(gv, callDefaultFn)
+			for i := range moreDiags {
+				moreDiags[i].Path = nil
+			}
+			diags = diags.Append(moreDiags)
+			vals = append(vals, rv)
 		}
 		// Dynamically-typed attributes are not supported with SchemaNestingSet,
 		// so we just always return a set value for these.
 		if len(vals) == 0 {
-			return cty.SetValEmpty(wantTy.ElementType())
+			return cty.SetValEmpty(wantTy.ElementType()), diags
 		}
-		return cty.SetVal(vals)
+		return cty.SetVal(vals), diags
 	default:
 		panic(fmt.Sprintf("invalid block nesting mode %#v", b.Nesting))
 	}
 }
+
+// isDefaultableObject returns true if the given collection element is an
+// object that defaults can be applied to. Elements of a nested attribute,
+// unlike nested blocks, can be null or unknown, in which case they have no
+// attributes to apply defaults to and so are used verbatim.
+func isDefaultableObject(v cty.Value) bool {
+	return v.IsKnown() && !v.IsNull()
+}
@@ -0,0 +1,293 @@
+package tfsdktest
+
+import (
+	"fmt"
+
+	tfsdk "github.com/apparentlymart/terraform-sdk"
+	"github.com/apparentlymart/terraform-sdk/internal/tfplugin5"
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	"github.com/zclconf/go-cty/cty/msgpack"
+)
+
+// decodeSchemaBlock converts a schema as returned from the provider's
+// GetSchema function back into a tfschema.BlockType, giving us the same view
+// of the schema that Terraform Core would have.
+func decodeSchemaBlock(src *tfplugin5.Schema_Block) *tfschema.BlockType {
+	ret := &tfschema.BlockType{
+		Attributes:       make(map[string]*tfschema.Attribute),
+		NestedBlockTypes: make(map[string]*tfschema.NestedBlockType),
+	}
+	if src == nil {
+		return ret
+	}
+
+	for _, attrS := range src.Attributes {
+		var ty cty.Type
+		if err := ty.UnmarshalJSON(attrS.Type); err != nil {
+			// Should never happen, since the SDK itself produced this JSON.
+			panic(fmt.Sprintf("invalid type for attribute %q: %s", attrS.Name, err))
+		}
+		ret.Attributes[attrS.Name] = &tfschema.Attribute{
+			Type:        ty,
+			Description: attrS.Description,
+			Required:    attrS.Required,
+			Optional:    attrS.Optional,
+			Computed:    attrS.Computed,
+			Sensitive:   attrS.Sensitive,
+		}
+	}
+
+	for _, blockS := range src.BlockTypes {
+		var nesting tfschema.NestingMode
+		switch blockS.Nesting {
+		case tfplugin5.Schema_NestedBlock_SINGLE:
+			nesting = tfschema.NestingSingle
+		case tfplugin5.Schema_NestedBlock_GROUP:
+			nesting = tfschema.NestingGroup
+		case tfplugin5.Schema_NestedBlock_LIST:
+			nesting = tfschema.NestingList
+		case tfplugin5.Schema_NestedBlock_MAP:
+			nesting = tfschema.NestingMap
+		case tfplugin5.Schema_NestedBlock_SET:
+			nesting = tfschema.NestingSet
+		default:
+			panic(fmt.Sprintf("unsupported block nesting mode %s for %q", blockS.Nesting, blockS.TypeName))
+		}
+		ret.NestedBlockTypes[blockS.TypeName] = &tfschema.NestedBlockType{
+			Nesting:  nesting,
+			Content:  *decodeSchemaBlock(blockS.Block),
+			MinItems: int(blockS.MinItems),
+			MaxItems: int(blockS.MaxItems),
+		}
+	}
+
+	return ret
+}
+
+func encodeDynamicValue(val cty.Value, schema *tfschema.BlockType) *tfplugin5.DynamicValue {
+	raw, err := msgpack.Marshal(val, schema.ImpliedCtyType())
+	if err != nil {
+		// Values are always coerced to the schema before we get here, so
+		// this indicates a bug in the test harness.
+		panic(fmt.Sprintf("invalid object to encode: %s", err))
+	}
+	return &tfplugin5.DynamicValue{
+		Msgpack: raw,
+	}
+}
+
+func decodeDynamicValue(src *tfplugin5.DynamicValue, schema *tfschema.BlockType) (cty.Value, tfsdk.Diagnostics) {
+	var diags tfsdk.Diagnostics
+	wantTy := schema.ImpliedCtyType()
+	if src == nil {
+		return cty.NullVal(wantTy), diags
+	}
+
+	ret, err := msgpack.Unmarshal(src.Msgpack, wantTy)
+	if err != nil {
+		diags = diags.Append(tfsdk.Diagnostic{
+			Severity: tfsdk.Error,
+			Summary:  "Invalid object from provider",
+			Detail:   fmt.Sprintf("Provider returned an object value that could not be decoded: %s.", tfsdk.FormatError(err)),
+		})
+		return cty.NullVal(wantTy), diags
+	}
+	return ret, diags
+}
+
+func decodeDiagnostics(src []*tfplugin5.Diagnostic) tfsdk.Diagnostics {
+	var diags tfsdk.Diagnostics
+	for _, diag := range src {
+		severity := tfsdk.Error
+		if diag.Severity == tfplugin5.Diagnostic_WARNING {
+			severity = tfsdk.Warning
+		}
+		diags = diags.Append(tfsdk.Diagnostic{
+			Severity: severity,
+			Summary:  diag.Summary,
+			Detail:   diag.Detail,
+			Path:     decodeAttrPath(diag.Attribute),
+		})
+	}
+	return diags
+}
+
+func decodeAttrPath(src *tfplugin5.AttributePath) cty.Path {
+	if src == nil || len(src.Steps) == 0 {
+		return nil
+	}
+	ret := make(cty.Path, 0, len(src.Steps))
+	for _, step := range src.Steps {
+		switch sel := step.Selector.(type) {
+		case *tfplugin5.AttributePath_Step_AttributeName:
+			ret = ret.GetAttr(sel.AttributeName)
+		case *tfplugin5.AttributePath_Step_ElementKeyString:
+			ret = ret.Index(cty.StringVal(sel.ElementKeyString))
+		case *tfplugin5.AttributePath_Step_ElementKeyInt:
+			ret = ret.Index(cty.NumberIntVal(sel.ElementKeyInt))
+		}
+	}
+	return ret
+}
+
+func decodeAttrPaths(src []*tfplugin5.AttributePath) []cty.Path {
+	var ret []cty.Path
+	for _, path := range src {
+		ret = append(ret, decodeAttrPath(path))
+	}
+	return ret
+}
+
+// coerceObject takes an object value that may omit some of the attributes
+// and nested blocks of the given schema and returns an object conforming to
+// the schema, much as Terraform Core would produce when decoding a
+// configuration block.
+//
+// Omitted attributes are set to null, omitted nested blocks are set to null
+// or to an empty collection as appropriate, and all other values are
+// converted to the types required by the schema.
+func coerceObject(schema *tfschema.BlockType, val cty.Value) (cty.Value, error) {
+	return coerceObjectPath(schema, val, nil)
+}
+
+func coerceObjectPath(schema *tfschema.BlockType, val cty.Value, path cty.Path) (cty.Value, error) {
+	wantTy := schema.ImpliedCtyType()
+	switch {
+	case val == cty.NilVal || val.IsNull():
+		return cty.NullVal(wantTy), nil
+	case !val.IsKnown():
+		return cty.UnknownVal(wantTy), nil
+	case !(val.Type().IsObjectType() || val.Type().IsMapType()):
+		return cty.NilVal, path.NewErrorf("object required")
+	}
+
+	vals := make(map[string]cty.Value)
+	for name, attrS := range schema.Attributes {
+		path := path.GetAttr(name)
+		attrTy := attrS.ImpliedCtyType()
+		av := cty.NullVal(attrTy)
+		if hasAttr(val, name) {
+			av = getAttr(val, name)
+		}
+		av, err := convert.Convert(av, attrTy)
+		if err != nil {
+			return cty.NilVal, path.NewError(err)
+		}
+		vals[name] = av
+	}
+
+	for name, blockS := range schema.NestedBlockTypes {
+		path := path.GetAttr(name)
+		bv := cty.NilVal
+		if hasAttr(val, name) {
+			bv = getAttr(val, name)
+		}
+		nv, err := coerceNestedBlock(blockS, wantTy.AttributeType(name), bv, path)
+		if err != nil {
+			return cty.NilVal, err
+		}
+		vals[name] = nv
+	}
+
+	for it := val.ElementIterator(); it.Next(); {
+		k, _ := it.Element()
+		name := k.AsString()
+		if _, ok := schema.Attributes[name]; ok {
+			continue
+		}
+		if _, ok := schema.NestedBlockTypes[name]; ok {
+			continue
+		}
+		return cty.NilVal, path.GetAttr(name).NewErrorf("unsupported attribute or block type")
+	}
+
+	ret, err := convert.Convert(cty.ObjectVal(vals), wantTy)
+	if err != nil {
+		return cty.NilVal, path.NewError(err)
+	}
+	return ret, nil
+}
+
+func coerceNestedBlock(schema *tfschema.NestedBlockType, wantTy cty.Type, val cty.Value, path cty.Path) (cty.Value, error) {
+	if val != cty.NilVal && !val.IsKnown() {
+		return cty.UnknownVal(wantTy), nil
+	}
+
+	switch schema.Nesting {
+	case tfschema.NestingSingle:
+		return coerceObjectPath(&schema.Content, val, path)
+	case tfschema.NestingGroup:
+		if val == cty.NilVal || val.IsNull() {
+			// An absent group block is equivalent to an empty one.
+			val = cty.EmptyObjectVal
+		}
+		return coerceObjectPath(&schema.Content, val, path)
+	}
+
+	if val == cty.NilVal || val.IsNull() {
+		val = cty.EmptyTupleVal
+	}
+	if !val.CanIterateElements() {
+		return cty.NilVal, path.NewErrorf("collection of blocks required")
+	}
+
+	if schema.Nesting == tfschema.NestingMap {
+		vals := make(map[string]cty.Value)
+		for it := val.ElementIterator(); it.Next(); {
+			k, ev := it.Element()
+			if k.Type() != cty.String {
+				return cty.NilVal, path.NewErrorf("map or object of blocks required")
+			}
+			ev, err := coerceObjectPath(&schema.Content, ev, path.Index(k))
+			if err != nil {
+				return cty.NilVal, err
+			}
+			vals[k.AsString()] = ev
+		}
+		if len(vals) == 0 && wantTy.IsMapType() {
+			return cty.MapValEmpty(wantTy.ElementType()), nil
+		}
+		if wantTy.IsMapType() {
+			return cty.MapVal(vals), nil
+		}
+		return cty.ObjectVal(vals), nil
+	}
+
+	var vals []cty.Value
+	for it := val.ElementIterator(); it.Next(); {
+		k, ev := it.Element()
+		ev, err := coerceObjectPath(&schema.Content, ev, path.Index(k))
+		if err != nil {
+			return cty.NilVal, err
+		}
+		vals = append(vals, ev)
+	}
+	switch {
+	case wantTy.IsListType() && len(vals) == 0:
+		return cty.ListValEmpty(wantTy.ElementType()), nil
+	case wantTy.IsListType():
+		return cty.ListVal(vals), nil
+	case wantTy.IsSetType() && len(vals) == 0:
+		return cty.SetValEmpty(wantTy.ElementType()), nil
+	case wantTy.IsSetType():
+		return cty.SetVal(vals), nil
+	default:
+		return cty.TupleVal(vals), nil
+	}
+}
+
+func hasAttr(val cty.Value, name string) bool {
+	if val.Type().IsObjectType() {
+		return val.Type().HasAttribute(name)
+	}
+	return val.HasIndex(cty.StringVal(name)).True()
+}
+
+func getAttr(val cty.Value, name string) cty.Value {
+	if val.Type().IsObjectType() {
+		return val.GetAttr(name)
+	}
+	return val.Index(cty.StringVal(name))
+}
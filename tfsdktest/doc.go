@@ -0,0 +1,12 @@
+// Package tfsdktest contains an in-process test harness for providers built
+// with this SDK, which exercises a provider through its plugin protocol
+// implementation without launching Terraform CLI or a plugin child process.
+//
+// The harness applies the same consistency rules to planned and applied
+// values as Terraform Core does, so tests can verify complete resource
+// lifecycles offline and catch the provider bugs that Terraform Core would
+// otherwise report as "inconsistent result" errors.
+//
+// For end-to-end tests against a real Terraform CLI executable, use
+// tfsdk.InitProviderTesting instead.
+package tfsdktest
@@ -0,0 +1,323 @@
+package tfsdktest
+
+import (
+	"context"
+	"fmt"
+
+	tfsdk "github.com/apparentlymart/terraform-sdk"
+	"github.com/apparentlymart/terraform-sdk/internal/sdkbridge"
+	"github.com/apparentlymart/terraform-sdk/internal/tfplugin5"
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Provider is an in-process client for a provider, which calls into the
+// provider's plugin protocol implementation directly rather than via a plugin
+// child process.
+//
+// Values passed to the methods of Provider may omit attributes and nested
+// blocks that are not set, in which case they are populated with null values
+// or empty collections as Terraform Core would do when decoding an equivalent
+// configuration block.
+type Provider struct {
+	server tfplugin5.ProviderServer
+
+	configSchema   *tfschema.BlockType
+	managedSchemas map[string]*tfschema.BlockType
+	dataSchemas    map[string]*tfschema.BlockType
+}
+
+// NewProvider prepares an in-process client for the given provider.
+//
+// The provider's schemas are checked with Provider.InternalValidate and then
+// retrieved immediately, so this function will panic if the schemas are
+// invalid or if the provider returns errors from its schema request.
+func NewProvider(p *tfsdk.Provider) *Provider {
+	if diags := p.InternalValidate(); diags.HasErrors() {
+		panic(fmt.Sprintf("invalid provider schema: %s", diags[0].Detail))
+	}
+
+	server := sdkbridge.NewProviderServer(p)
+	resp, err := server.GetSchema(context.Background(), &tfplugin5.GetProviderSchema_Request{})
+	if err != nil {
+		panic(fmt.Sprintf("failed to get provider schema: %s", err))
+	}
+	if diags := decodeDiagnostics(resp.Diagnostics); diags.HasErrors() {
+		panic(fmt.Sprintf("failed to get provider schema: %s", diags[0].Summary))
+	}
+
+	ret := &Provider{
+		server:         server,
+		configSchema:   decodeSchemaBlock(resp.Provider.GetBlock()),
+		managedSchemas: make(map[string]*tfschema.BlockType),
+		dataSchemas:    make(map[string]*tfschema.BlockType),
+	}
+	for name, schema := range resp.ResourceSchemas {
+		ret.managedSchemas[name] = decodeSchemaBlock(schema.Block)
+	}
+	for name, schema := range resp.DataSourceSchemas {
+		ret.dataSchemas[name] = decodeSchemaBlock(schema.Block)
+	}
+	return ret
+}
+
+// Close asks the provider to stop any in-flight operations, as Terraform Core
+// would do when it is interrupted or has finished its work.
+func (p *Provider) Close() {
+	p.server.Stop(context.Background(), &tfplugin5.Stop_Request{})
+}
+
+// Configure validates the given provider configuration and, if it is valid,
+// configures the provider with it. This must be called before any operations
+// that interact with remote objects.
+func (p *Provider) Configure(config cty.Value) tfsdk.Diagnostics {
+	config, diags := coerceConfig(p.configSchema, config)
+	if diags.HasErrors() {
+		return diags
+	}
+
+	prepResp, err := p.server.PrepareProviderConfig(context.Background(), &tfplugin5.PrepareProviderConfig_Request{
+		Config: encodeDynamicValue(config, p.configSchema),
+	})
+	if err != nil {
+		return diags.Append(err)
+	}
+	diags = diags.Append(decodeDiagnostics(prepResp.Diagnostics))
+	if diags.HasErrors() {
+		return diags
+	}
+	prepared, moreDiags := decodeDynamicValue(prepResp.PreparedConfig, p.configSchema)
+	diags = diags.Append(moreDiags)
+	if diags.HasErrors() {
+		return diags
+	}
+
+	resp, err := p.server.Configure(context.Background(), &tfplugin5.Configure_Request{
+		Config: encodeDynamicValue(prepared, p.configSchema),
+	})
+	if err != nil {
+		return diags.Append(err)
+	}
+	return diags.Append(decodeDiagnostics(resp.Diagnostics))
+}
+
+// ValidateResourceConfig validates the given configuration for the managed
+// resource type with the given name.
+func (p *Provider) ValidateResourceConfig(typeName string, config cty.Value) tfsdk.Diagnostics {
+	schema, diags := p.managedSchema(typeName)
+	if diags.HasErrors() {
+		return diags
+	}
+	config, moreDiags := coerceConfig(schema, config)
+	diags = diags.Append(moreDiags)
+	if diags.HasErrors() {
+		return diags
+	}
+
+	resp, err := p.server.ValidateResourceTypeConfig(context.Background(), &tfplugin5.ValidateResourceTypeConfig_Request{
+		TypeName: typeName,
+		Config:   encodeDynamicValue(config, schema),
+	})
+	if err != nil {
+		return diags.Append(err)
+	}
+	return diags.Append(decodeDiagnostics(resp.Diagnostics))
+}
+
+// ValidateDataSourceConfig validates the given configuration for the data
+// resource type with the given name.
+func (p *Provider) ValidateDataSourceConfig(typeName string, config cty.Value) tfsdk.Diagnostics {
+	schema, diags := p.dataSchema(typeName)
+	if diags.HasErrors() {
+		return diags
+	}
+	config, moreDiags := coerceConfig(schema, config)
+	diags = diags.Append(moreDiags)
+	if diags.HasErrors() {
+		return diags
+	}
+
+	resp, err := p.server.ValidateDataSourceConfig(context.Background(), &tfplugin5.ValidateDataSourceConfig_Request{
+		TypeName: typeName,
+		Config:   encodeDynamicValue(config, schema),
+	})
+	if err != nil {
+		return diags.Append(err)
+	}
+	return diags.Append(decodeDiagnostics(resp.Diagnostics))
+}
+
+// ReadDataSource validates the given configuration for the data resource
+// type with the given name and then, if it is valid, reads the data source.
+func (p *Provider) ReadDataSource(typeName string, config cty.Value) (cty.Value, tfsdk.Diagnostics) {
+	diags := p.ValidateDataSourceConfig(typeName, config)
+	if diags.HasErrors() {
+		return cty.DynamicVal, diags
+	}
+	schema, _ := p.dataSchema(typeName)
+	config, _ = coerceConfig(schema, config)
+
+	resp, err := p.server.ReadDataSource(context.Background(), &tfplugin5.ReadDataSource_Request{
+		TypeName: typeName,
+		Config:   encodeDynamicValue(config, schema),
+	})
+	if err != nil {
+		return schema.Null(), diags.Append(err)
+	}
+	diags = diags.Append(decodeDiagnostics(resp.Diagnostics))
+	state, moreDiags := decodeDynamicValue(resp.State, schema)
+	diags = diags.Append(moreDiags)
+	return state, diags
+}
+
+// ManagedResource returns a new resource instance of the managed resource
+// type with the given name, which does not yet have a remote object.
+//
+// This function will panic if the provider does not support the given
+// resource type.
+func (p *Provider) ManagedResource(typeName string) *ResourceInstance {
+	schema, diags := p.managedSchema(typeName)
+	if diags.HasErrors() {
+		panic(diags[0].Detail)
+	}
+	return &ResourceInstance{
+		p:        p,
+		typeName: typeName,
+		schema:   schema,
+		state:    schema.Null(),
+	}
+}
+
+// UpgradeResourceState upgrades the given JSON representation of an object of
+// the given managed resource type, saved at the given schema version, and
+// returns a resource instance whose state is the upgraded object.
+func (p *Provider) UpgradeResourceState(typeName string, version int64, stateJSON []byte) (*ResourceInstance, tfsdk.Diagnostics) {
+	schema, diags := p.managedSchema(typeName)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	resp, err := p.server.UpgradeResourceState(context.Background(), &tfplugin5.UpgradeResourceState_Request{
+		TypeName: typeName,
+		Version:  version,
+		RawState: &tfplugin5.RawState{
+			Json: stateJSON,
+		},
+	})
+	if err != nil {
+		return nil, diags.Append(err)
+	}
+	diags = diags.Append(decodeDiagnostics(resp.Diagnostics))
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	state, moreDiags := decodeDynamicValue(resp.UpgradedState, schema)
+	diags = diags.Append(moreDiags)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	ri := p.ManagedResource(typeName)
+	ri.state = state
+	return ri, diags
+}
+
+// ImportResource imports existing remote objects using the given id and
+// the import function of the managed resource type with the given name.
+//
+// As in Terraform Core, each of the imported objects is then refreshed, and
+// it is an error if any of them does not exist.
+func (p *Provider) ImportResource(typeName string, id string) ([]*ResourceInstance, tfsdk.Diagnostics) {
+	_, diags := p.managedSchema(typeName)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	resp, err := p.server.ImportResourceState(context.Background(), &tfplugin5.ImportResourceState_Request{
+		TypeName: typeName,
+		Id:       id,
+	})
+	if err != nil {
+		return nil, diags.Append(err)
+	}
+	diags = diags.Append(decodeDiagnostics(resp.Diagnostics))
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	var ret []*ResourceInstance
+	for _, imported := range resp.ImportedResources {
+		schema, moreDiags := p.managedSchema(imported.TypeName)
+		diags = diags.Append(moreDiags)
+		if moreDiags.HasErrors() {
+			continue
+		}
+		state, moreDiags := decodeDynamicValue(imported.State, schema)
+		diags = diags.Append(moreDiags)
+		if moreDiags.HasErrors() {
+			continue
+		}
+
+		ri := p.ManagedResource(imported.TypeName)
+		ri.state = state
+		ri.private = imported.Private
+		diags = diags.Append(ri.Refresh())
+		if ri.state.IsNull() {
+			diags = diags.Append(tfsdk.Diagnostic{
+				Severity: tfsdk.Error,
+				Summary:  "Cannot import non-existent remote object",
+				Detail:   fmt.Sprintf("While attempting to import an existing object to %s, the provider detected that no object exists with the given id.", imported.TypeName),
+			})
+			continue
+		}
+		ret = append(ret, ri)
+	}
+	return ret, diags
+}
+
+func (p *Provider) managedSchema(typeName string) (*tfschema.BlockType, tfsdk.Diagnostics) {
+	var diags tfsdk.Diagnostics
+	schema, ok := p.managedSchemas[typeName]
+	if !ok {
+		diags = diags.Append(tfsdk.Diagnostic{
+			Severity: tfsdk.Error,
+			Summary:  "Unsupported resource type",
+			Detail:   fmt.Sprintf("This provider does not support managed resource type %q", typeName),
+		})
+	}
+	return schema, diags
+}
+
+func (p *Provider) dataSchema(typeName string) (*tfschema.BlockType, tfsdk.Diagnostics) {
+	var diags tfsdk.Diagnostics
+	schema, ok := p.dataSchemas[typeName]
+	if !ok {
+		diags = diags.Append(tfsdk.Diagnostic{
+			Severity: tfsdk.Error,
+			Summary:  "Unsupported resource type",
+			Detail:   fmt.Sprintf("This provider does not support data resource type %q", typeName),
+		})
+	}
+	return schema, diags
+}
+
+// coerceConfig is a wrapper around coerceObject that returns its error as
+// a diagnostic, for configuration values given by the caller.
+func coerceConfig(schema *tfschema.BlockType, config cty.Value) (cty.Value, tfsdk.Diagnostics) {
+	var diags tfsdk.Diagnostics
+	ret, err := coerceObject(schema, config)
+	if err != nil {
+		var path cty.Path
+		if pErr, ok := err.(cty.PathError); ok {
+			path = pErr.Path
+		}
+		diags = diags.Append(tfsdk.Diagnostic{
+			Severity: tfsdk.Error,
+			Summary:  "Invalid configuration",
+			Detail:   fmt.Sprintf("The given configuration does not conform to the schema: %s.", tfsdk.FormatError(err)),
+			Path:     path,
+		})
+		return schema.Null(), diags
+	}
+	return ret, diags
+}
@@ -0,0 +1,335 @@
+package tfsdktest
+
+import (
+	"context"
+	"fmt"
+
+	tfsdk "github.com/apparentlymart/terraform-sdk"
+	"github.com/apparentlymart/terraform-sdk/internal/objchange"
+	"github.com/apparentlymart/terraform-sdk/internal/tfplugin5"
+	"github.com/apparentlymart/terraform-sdk/tfobj"
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ResourceInstance represents a single instance of a managed resource type,
+// tracking its current state and private data across operations in the same
+// way as Terraform Core would.
+//
+// Use Provider.ManagedResource to obtain a ResourceInstance with no remote
+// object yet, and then use Plan and Apply (or ApplyConfig) to create it.
+type ResourceInstance struct {
+	p        *Provider
+	typeName string
+	schema   *tfschema.BlockType
+
+	state   cty.Value
+	private []byte
+}
+
+// TypeName returns the name of the resource type of the receiving instance.
+func (ri *ResourceInstance) TypeName() string {
+	return ri.typeName
+}
+
+// State returns the current state of the receiving instance, which is null
+// if it has no remote object.
+func (ri *ResourceInstance) State() cty.Value {
+	return ri.state
+}
+
+// Plan represents a planned change for a resource instance, produced by
+// ResourceInstance.Plan.
+type Plan struct {
+	// Prior is the state of the instance when the plan was created, and
+	// Config is the configuration that the plan was created from.
+	Prior, Config cty.Value
+
+	// Planned is the planned new state for the instance, which may contain
+	// unknown values to be decided during apply. If the plan requires the
+	// object to be replaced then this is the planned state for the new
+	// object.
+	Planned cty.Value
+
+	// RequiresReplace contains the paths of any attributes whose changes
+	// cannot be applied in-place, as reported by the provider.
+	RequiresReplace []cty.Path
+
+	ri             *ResourceInstance
+	replace        bool
+	plannedPrivate []byte
+}
+
+// Action returns the action that applying the plan would take.
+//
+// If the plan requires the object to be replaced then the action is Create,
+// because the existing object will be deleted before the new one is created.
+func (p *Plan) Action() tfobj.Action {
+	switch {
+	case p.Prior.IsNull() || p.replace:
+		return tfobj.Create
+	case p.Planned.IsNull():
+		return tfobj.Delete
+	default:
+		return tfobj.Update
+	}
+}
+
+// Replace returns true if applying the plan would delete the existing object
+// and create a new one in its place.
+func (p *Plan) Replace() bool {
+	return p.replace
+}
+
+// HasChanges returns true if applying the plan would make any changes.
+func (p *Plan) HasChanges() bool {
+	return p.replace || !p.Planned.RawEquals(p.Prior)
+}
+
+// Plan validates the given configuration and then plans a change to make the
+// receiving instance's remote object match it. Pass a null value to plan to
+// destroy the object.
+//
+// The planned new state is checked using the same rules as Terraform Core
+// uses, and any violations are reported as errors.
+func (ri *ResourceInstance) Plan(config cty.Value) (*Plan, tfsdk.Diagnostics) {
+	config, diags := coerceConfig(ri.schema, config)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	if config.IsNull() {
+		// Terraform Core does not consult the provider when planning to
+		// destroy an object.
+		return &Plan{
+			Prior:   ri.state,
+			Config:  config,
+			Planned: config,
+			ri:      ri,
+		}, diags
+	}
+
+	diags = diags.Append(ri.p.ValidateResourceConfig(ri.typeName, config))
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	plan, moreDiags := ri.planChange(ri.state, ri.private, config)
+	diags = diags.Append(moreDiags)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	if len(plan.RequiresReplace) > 0 && !ri.state.IsNull() && !plan.Planned.RawEquals(ri.state) {
+		// As with Terraform Core, a replacement is planned as a create
+		// starting from a null prior object.
+		createPlan, moreDiags := ri.planChange(ri.schema.Null(), nil, config)
+		diags = diags.Append(moreDiags)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		createPlan.Prior = ri.state
+		createPlan.RequiresReplace = plan.RequiresReplace
+		createPlan.replace = true
+		plan = createPlan
+	}
+
+	return plan, diags
+}
+
+func (ri *ResourceInstance) planChange(prior cty.Value, priorPrivate []byte, config cty.Value) (*Plan, tfsdk.Diagnostics) {
+	var diags tfsdk.Diagnostics
+	proposed := objchange.ProposedNewObject(ri.schema, prior, config)
+
+	resp, err := ri.p.server.PlanResourceChange(context.Background(), &tfplugin5.PlanResourceChange_Request{
+		TypeName:         ri.typeName,
+		PriorState:       encodeDynamicValue(prior, ri.schema),
+		ProposedNewState: encodeDynamicValue(proposed, ri.schema),
+		Config:           encodeDynamicValue(config, ri.schema),
+		PriorPrivate:     priorPrivate,
+	})
+	if err != nil {
+		return nil, diags.Append(err)
+	}
+	diags = diags.Append(decodeDiagnostics(resp.Diagnostics))
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	planned, moreDiags := decodeDynamicValue(resp.PlannedState, ri.schema)
+	diags = diags.Append(moreDiags)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	for _, err := range objchange.AssertPlanValid(ri.schema, prior, config, planned) {
+		diags = diags.Append(inconsistencyDiagnostic(
+			"Provider produced invalid plan",
+			fmt.Sprintf("Provider produced an invalid planned new state for %s", ri.typeName),
+			err,
+		))
+	}
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	return &Plan{
+		Prior:           prior,
+		Config:          config,
+		Planned:         planned,
+		RequiresReplace: decodeAttrPaths(resp.RequiresReplace),
+		ri:              ri,
+		plannedPrivate:  resp.PlannedPrivate,
+	}, diags
+}
+
+// Apply applies the given plan, which must have been produced by calling
+// Plan on the same instance with no other operations in between.
+//
+// The new state returned by the provider is checked for consistency with the
+// plan using the same rules as Terraform Core uses, and any violations are
+// reported as errors. The instance's state is updated with the provider's
+// result regardless, as Terraform Core would do.
+func (ri *ResourceInstance) Apply(plan *Plan) tfsdk.Diagnostics {
+	var diags tfsdk.Diagnostics
+	if plan.ri != ri || !plan.Prior.RawEquals(ri.state) {
+		diags = diags.Append(tfsdk.Diagnostic{
+			Severity: tfsdk.Error,
+			Summary:  "Stale plan",
+			Detail:   fmt.Sprintf("The given plan was not created for the current state of this %s instance.", ri.typeName),
+		})
+		return diags
+	}
+
+	if plan.replace {
+		diags = diags.Append(ri.applyChange(ri.state, ri.private, ri.schema.Null(), ri.schema.Null(), nil))
+		if diags.HasErrors() {
+			return diags
+		}
+	}
+
+	diags = diags.Append(ri.applyChange(ri.state, ri.private, plan.Config, plan.Planned, plan.plannedPrivate))
+	return diags
+}
+
+func (ri *ResourceInstance) applyChange(prior cty.Value, priorPrivate []byte, config, planned cty.Value, plannedPrivate []byte) tfsdk.Diagnostics {
+	var diags tfsdk.Diagnostics
+	if prior.IsNull() && planned.IsNull() {
+		// Nothing to do.
+		return diags
+	}
+	if planned.IsNull() {
+		// Terraform Core sends the prior private data when deleting.
+		plannedPrivate = priorPrivate
+	}
+
+	resp, err := ri.p.server.ApplyResourceChange(context.Background(), &tfplugin5.ApplyResourceChange_Request{
+		TypeName:       ri.typeName,
+		PriorState:     encodeDynamicValue(prior, ri.schema),
+		PlannedState:   encodeDynamicValue(planned, ri.schema),
+		Config:         encodeDynamicValue(config, ri.schema),
+		PlannedPrivate: plannedPrivate,
+	})
+	if err != nil {
+		return diags.Append(err)
+	}
+	diags = diags.Append(decodeDiagnostics(resp.Diagnostics))
+	newVal, moreDiags := decodeDynamicValue(resp.NewState, ri.schema)
+	diags = diags.Append(moreDiags)
+	if moreDiags.HasErrors() {
+		return diags
+	}
+
+	if !diags.HasErrors() {
+		for _, err := range objchange.AssertObjectCompatible(ri.schema, planned, newVal) {
+			diags = diags.Append(inconsistencyDiagnostic(
+				"Provider produced inconsistent result after apply",
+				fmt.Sprintf("When applying changes to %s, the provider produced an unexpected new value", ri.typeName),
+				err,
+			))
+		}
+	}
+	if !newVal.IsWhollyKnown() {
+		diags = diags.Append(tfsdk.Diagnostic{
+			Severity: tfsdk.Error,
+			Summary:  "Provider returned invalid result object after apply",
+			Detail:   fmt.Sprintf("After the apply operation, the provider still indicated an unknown value for %s. All values must be known after apply.", ri.typeName),
+		})
+		newVal = cty.UnknownAsNull(newVal)
+	}
+
+	ri.state = newVal
+	ri.private = resp.Private
+	return diags
+}
+
+// ApplyConfig is a convenience wrapper that calls Plan with the given
+// configuration and then, if planning succeeds, applies the plan.
+func (ri *ResourceInstance) ApplyConfig(config cty.Value) tfsdk.Diagnostics {
+	plan, diags := ri.Plan(config)
+	if diags.HasErrors() {
+		return diags
+	}
+	return diags.Append(ri.Apply(plan))
+}
+
+// Destroy is a convenience wrapper that plans and applies the deletion of
+// the receiving instance's remote object, if any.
+func (ri *ResourceInstance) Destroy() tfsdk.Diagnostics {
+	return ri.ApplyConfig(cty.NullVal(ri.schema.ImpliedCtyType()))
+}
+
+// Refresh reads the current state of the receiving instance's remote object
+// and updates the instance's state to match it. If the remote object no
+// longer exists then the state becomes null.
+func (ri *ResourceInstance) Refresh() tfsdk.Diagnostics {
+	var diags tfsdk.Diagnostics
+	if ri.state.IsNull() {
+		return diags
+	}
+
+	resp, err := ri.p.server.ReadResource(context.Background(), &tfplugin5.ReadResource_Request{
+		TypeName:     ri.typeName,
+		CurrentState: encodeDynamicValue(ri.state, ri.schema),
+		Private:      ri.private,
+	})
+	if err != nil {
+		return diags.Append(err)
+	}
+	diags = diags.Append(decodeDiagnostics(resp.Diagnostics))
+	if diags.HasErrors() {
+		return diags
+	}
+	newVal, moreDiags := decodeDynamicValue(resp.NewState, ri.schema)
+	diags = diags.Append(moreDiags)
+	if diags.HasErrors() {
+		return diags
+	}
+	if !newVal.IsWhollyKnown() {
+		diags = diags.Append(tfsdk.Diagnostic{
+			Severity: tfsdk.Error,
+			Summary:  "Provider produced invalid object",
+			Detail:   fmt.Sprintf("Provider produced an unknown value while refreshing %s. All values must be known after refresh.", ri.typeName),
+		})
+		return diags
+	}
+
+	ri.state = newVal
+	ri.private = resp.Private
+	return diags
+}
+
+// inconsistencyDiagnostic returns an error diagnostic describing an
+// inconsistency detected by package objchange, using the same wording as
+// Terraform Core.
+func inconsistencyDiagnostic(summary, prefix string, err error) tfsdk.Diagnostic {
+	var path cty.Path
+	if pErr, ok := err.(cty.PathError); ok {
+		path = pErr.Path
+	}
+	return tfsdk.Diagnostic{
+		Severity: tfsdk.Error,
+		Summary:  summary,
+		Detail:   fmt.Sprintf("%s: %s.\n\nThis is a bug in the provider, which should be reported in the provider's own issue tracker.", prefix, tfsdk.FormatError(err)),
+		Path:     path,
+	}
+}
@@ -0,0 +1,214 @@
+package tfsdktest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	tfsdk "github.com/apparentlymart/terraform-sdk"
+	"github.com/apparentlymart/terraform-sdk/tfobj"
+	"github.com/apparentlymart/terraform-sdk/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type testClient struct {
+	nextID  int
+	objects map[string]cty.Value
+}
+
+func testProvider(client *testClient) *tfsdk.Provider {
+	schema := &tfschema.BlockType{
+		Attributes: map[string]*tfschema.Attribute{
+			"id":   {Type: cty.String, Computed: true},
+			"name": {Type: cty.String, Required: true},
+			"size": {Type: cty.Number, Optional: true, Default: 1},
+		},
+	}
+	create := func(ctx context.Context, client *testClient, planned tfobj.ObjectReader) (cty.Value, tfsdk.Diagnostics) {
+		client.nextID++
+		id := fmt.Sprintf("thing-%d", client.nextID)
+		obj := cty.ObjectVal(map[string]cty.Value{
+			"id":   cty.StringVal(id),
+			"name": planned.Attr("name"),
+			"size": planned.Attr("size"),
+		})
+		client.objects[id] = obj
+		return obj, nil
+	}
+
+	return &tfsdk.Provider{
+		ConfigSchema: &tfschema.BlockType{},
+		ConfigureFn: func(ctx context.Context, config cty.Value) (*testClient, tfsdk.Diagnostics) {
+			return client, nil
+		},
+		ManagedResourceTypes: map[string]tfsdk.ManagedResourceType{
+			"test_thing": tfsdk.NewManagedResourceType(&tfsdk.ResourceTypeDef{
+				ConfigSchema: schema,
+				PlanFn: func(ctx context.Context, client *testClient, plan tfobj.PlanBuilder) (cty.Value, cty.PathSet, tfsdk.Diagnostics) {
+					if plan.Action() == tfobj.Update && plan.AttrHasChange("name") {
+						plan.SetAttrRequiresReplacement("name")
+					}
+					return plan.ObjectVal(), plan.RequiresReplace(), nil
+				},
+				CreateFn: create,
+				ReadFn: func(ctx context.Context, client *testClient, current tfobj.ObjectReader) (cty.Value, tfsdk.Diagnostics) {
+					obj, ok := client.objects[current.Attr("id").AsString()]
+					if !ok {
+						return cty.NullVal(schema.ImpliedCtyType()), nil
+					}
+					return obj, nil
+				},
+				UpdateFn: func(ctx context.Context, client *testClient, prior tfobj.ObjectReader, planned tfobj.PlanReader) (cty.Value, tfsdk.Diagnostics) {
+					obj := planned.ObjectVal()
+					client.objects[obj.GetAttr("id").AsString()] = obj
+					return obj, nil
+				},
+				DeleteFn: func(ctx context.Context, client *testClient, prior tfobj.ObjectReader) (cty.Value, tfsdk.Diagnostics) {
+					delete(client.objects, prior.Attr("id").AsString())
+					return cty.NullVal(schema.ImpliedCtyType()), nil
+				},
+				ImportFn: func(ctx context.Context, client *testClient, id string) ([]tfsdk.ImportedObject, tfsdk.Diagnostics) {
+					return []tfsdk.ImportedObject{
+						{
+							Object: cty.ObjectVal(map[string]cty.Value{
+								"id":   cty.StringVal(id),
+								"name": cty.NullVal(cty.String),
+								"size": cty.NullVal(cty.Number),
+							}),
+						},
+					}, nil
+				},
+			}),
+
+			// test_broken returns a different name than was planned, which
+			// Terraform Core would reject as an inconsistent result.
+			"test_broken": tfsdk.NewManagedResourceType(&tfsdk.ResourceTypeDef{
+				ConfigSchema: schema,
+				PlanFn: func(ctx context.Context, client *testClient, plan tfobj.PlanBuilder) (cty.Value, cty.PathSet, tfsdk.Diagnostics) {
+					return plan.ObjectVal(), plan.RequiresReplace(), nil
+				},
+				CreateFn: func(ctx context.Context, client *testClient, planned tfobj.ObjectReader) (cty.Value, tfsdk.Diagnostics) {
+					return cty.ObjectVal(map[string]cty.Value{
+						"id":   cty.StringVal("broken"),
+						"name": cty.StringVal(strings.ToUpper(planned.Attr("name").AsString())),
+						"size": planned.Attr("size"),
+					}), nil
+				},
+			}),
+		},
+	}
+}
+
+func TestResourceInstanceLifecycle(t *testing.T) {
+	client := &testClient{objects: make(map[string]cty.Value)}
+	p := NewProvider(testProvider(client))
+	defer p.Close()
+
+	if diags := p.Configure(cty.EmptyObjectVal); diags.HasErrors() {
+		t.Fatalf("unexpected errors from Configure: %#v", diags)
+	}
+
+	ri := p.ManagedResource("test_thing")
+
+	plan, diags := ri.Plan(cty.ObjectVal(map[string]cty.Value{
+		"name": cty.StringVal("a"),
+	}))
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors from create plan: %#v", diags)
+	}
+	if got, want := plan.Action(), tfobj.Create; got != want {
+		t.Errorf("wrong action %#v; want %#v", got, want)
+	}
+	if got, want := plan.Planned.GetAttr("size"), cty.NumberIntVal(1); !want.RawEquals(got) {
+		t.Errorf("wrong planned size %#v; want %#v", got, want)
+	}
+	if got := plan.Planned.GetAttr("id"); got.IsKnown() {
+		t.Errorf("planned id is %#v; want unknown", got)
+	}
+	if diags := ri.Apply(plan); diags.HasErrors() {
+		t.Fatalf("unexpected errors from create: %#v", diags)
+	}
+	if got, want := ri.State().GetAttr("id"), cty.StringVal("thing-1"); !want.RawEquals(got) {
+		t.Errorf("wrong id after create %#v; want %#v", got, want)
+	}
+
+	diags = ri.ApplyConfig(cty.ObjectVal(map[string]cty.Value{
+		"name": cty.StringVal("a"),
+		"size": cty.NumberIntVal(2),
+	}))
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors from update: %#v", diags)
+	}
+	if got, want := ri.State().GetAttr("id"), cty.StringVal("thing-1"); !want.RawEquals(got) {
+		t.Errorf("wrong id after update %#v; want %#v", got, want)
+	}
+	if got, want := ri.State().GetAttr("size"), cty.NumberIntVal(2); !want.RawEquals(got) {
+		t.Errorf("wrong size after update %#v; want %#v", got, want)
+	}
+
+	plan, diags = ri.Plan(cty.ObjectVal(map[string]cty.Value{
+		"name": cty.StringVal("b"),
+		"size": cty.NumberIntVal(2),
+	}))
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors from replace plan: %#v", diags)
+	}
+	if !plan.Replace() {
+		t.Errorf("plan does not require replacement")
+	}
+	if diags := ri.Apply(plan); diags.HasErrors() {
+		t.Fatalf("unexpected errors from replace: %#v", diags)
+	}
+	if got, want := ri.State().GetAttr("id"), cty.StringVal("thing-2"); !want.RawEquals(got) {
+		t.Errorf("wrong id after replace %#v; want %#v", got, want)
+	}
+	if _, exists := client.objects["thing-1"]; exists {
+		t.Errorf("original object still exists after replace")
+	}
+
+	imported, diags := p.ImportResource("test_thing", "thing-2")
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors from import: %#v", diags)
+	}
+	if got, want := len(imported), 1; got != want {
+		t.Fatalf("wrong number of imported objects %d; want %d", got, want)
+	}
+	if got, want := imported[0].State(), ri.State(); !want.RawEquals(got) {
+		t.Errorf("wrong imported state\ngot:  %#v\nwant: %#v", got, want)
+	}
+
+	if diags := ri.Destroy(); diags.HasErrors() {
+		t.Fatalf("unexpected errors from destroy: %#v", diags)
+	}
+	if !ri.State().IsNull() {
+		t.Errorf("state is %#v after destroy; want null", ri.State())
+	}
+	if got := len(client.objects); got != 0 {
+		t.Errorf("%d objects remain after destroy", got)
+	}
+}
+
+func TestResourceInstanceInconsistentApply(t *testing.T) {
+	client := &testClient{objects: make(map[string]cty.Value)}
+	p := NewProvider(testProvider(client))
+	defer p.Close()
+
+	if diags := p.Configure(cty.EmptyObjectVal); diags.HasErrors() {
+		t.Fatalf("unexpected errors from Configure: %#v", diags)
+	}
+
+	ri := p.ManagedResource("test_broken")
+	diags := ri.ApplyConfig(cty.ObjectVal(map[string]cty.Value{
+		"name": cty.StringVal("a"),
+	}))
+	if !diags.HasErrors() {
+		t.Fatalf("unexpected success; want inconsistent result error")
+	}
+	if got, want := diags[0].Summary, "Provider produced inconsistent result after apply"; got != want {
+		t.Errorf("wrong error summary %q; want %q", got, want)
+	}
+	if got, want := diags[0].Path, cty.GetAttrPath("name"); !want.Equals(got) {
+		t.Errorf("wrong error path %#v; want %#v", got, want)
+	}
+}
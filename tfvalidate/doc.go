@@ -0,0 +1,16 @@
+// Package tfvalidate contains validation functions for common kinds of
+// attribute values, which can be used as the ValidateFn of a
+// tfschema.Attribute either directly or combined using All and Any.
+//
+// For example:
+//
+//	"endpoint": {
+//		Type:       cty.String,
+//		Optional:   true,
+//		ValidateFn: tfvalidate.URLWithScheme("https"),
+//	},
+//
+// Each validator checks only known, non-null values, and so a validator can
+// be used with an optional attribute, or with the elements of a collection
+// using EachElement, without any special handling of unset values.
+package tfvalidate
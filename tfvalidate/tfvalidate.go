@@ -0,0 +1,135 @@
+package tfvalidate
+
+import (
+	"errors"
+	"strings"
+
+	tfsdk "github.com/apparentlymart/terraform-sdk"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+)
+
+// Validator is the type of all of the validation functions in this package.
+// A Validator can be used directly as the ValidateFn of a tfschema.Attribute.
+//
+// Any diagnostics returned have paths relative to the given value.
+type Validator func(val cty.Value) tfsdk.Diagnostics
+
+// All returns a validator that checks the given value against each of the
+// given validators in turn, returning the diagnostics from all of them.
+func All(validators ...Validator) Validator {
+	return func(val cty.Value) tfsdk.Diagnostics {
+		var diags tfsdk.Diagnostics
+		for _, v := range validators {
+			diags = diags.Append(v(val))
+		}
+		return diags
+	}
+}
+
+// Any returns a validator that accepts the given value if at least one of the
+// given validators accepts it.
+//
+// If none of the validators accept the value then the result is a single
+// error diagnostic that describes all of the alternatives.
+func Any(validators ...Validator) Validator {
+	return func(val cty.Value) tfsdk.Diagnostics {
+		var msgs []string
+		for _, v := range validators {
+			diags := v(val)
+			if !diags.HasErrors() {
+				return diags
+			}
+			for _, diag := range diags {
+				if diag.Severity == tfsdk.Error {
+					msgs = append(msgs, errorMessage(diag))
+					break
+				}
+			}
+		}
+
+		var diags tfsdk.Diagnostics
+		switch len(msgs) {
+		case 0:
+			// Only possible if there were no validators at all, in which
+			// case there's nothing to satisfy.
+			return diags
+		case 1:
+			diags = diags.Append(tfsdk.ValidationError(errors.New(msgs[0])))
+		default:
+			msg := strings.Join(msgs[:len(msgs)-1], ", ") + ", or " + msgs[len(msgs)-1]
+			diags = diags.Append(tfsdk.ValidationError(errors.New(msg)))
+		}
+		return diags
+	}
+}
+
+// EachElement returns a validator that applies the given validator to each
+// element of a list, set, or map value, or of a tuple or object value.
+//
+// Diagnostics for list, tuple, and map elements are reported against the
+// path of the element. Set elements cannot be identified by a path, so
+// diagnostics for set elements are reported against the set as a whole.
+func EachElement(validator Validator) Validator {
+	return func(val cty.Value) tfsdk.Diagnostics {
+		var diags tfsdk.Diagnostics
+		if !val.IsKnown() || val.IsNull() || !val.CanIterateElements() {
+			return diags
+		}
+		isSet := val.Type().IsSetType()
+		for it := val.ElementIterator(); it.Next(); {
+			k, ev := it.Element()
+			elemDiags := validator(ev)
+			if isSet {
+				for i := range elemDiags {
+					elemDiags[i].Path = nil
+				}
+			} else {
+				var step cty.PathStep = cty.IndexStep{Key: k}
+				if val.Type().IsObjectType() {
+					step = cty.GetAttrStep{Name: k.AsString()}
+				}
+				elemDiags = elemDiags.UnderPath(cty.Path{step})
+			}
+			diags = diags.Append(elemDiags)
+		}
+		return diags
+	}
+}
+
+// checker is a simpler form of validation function that returns an error
+// describing why the given known, non-null value is unsuitable, or nil if it
+// is acceptable. The error message must make sense after a colon in a full
+// English sentence, as for tfsdk.ValidationError.
+type checker func(val cty.Value) error
+
+// validator returns a Validator that converts the given value to the given
+// type and then, if it is known and not null, passes it to the given checker.
+func validator(ty cty.Type, check checker) Validator {
+	return func(val cty.Value) tfsdk.Diagnostics {
+		var diags tfsdk.Diagnostics
+		if !val.IsKnown() || val.IsNull() {
+			return diags
+		}
+		val, err := convert.Convert(val, ty)
+		if err != nil {
+			diags = diags.Append(tfsdk.ValidationError(err))
+			return diags
+		}
+		if err := check(val); err != nil {
+			diags = diags.Append(tfsdk.ValidationError(err))
+		}
+		return diags
+	}
+}
+
+// errorMessage returns the message describing the problem reported by the
+// given diagnostic, without the generic prefix added by tfsdk.ValidationError,
+// so that it can be combined with other such messages.
+func errorMessage(diag tfsdk.Diagnostic) string {
+	const prefix = "This value cannot be used: "
+	if diag.Summary == "Unsuitable argument value" && strings.HasPrefix(diag.Detail, prefix) {
+		return strings.TrimSuffix(strings.TrimPrefix(diag.Detail, prefix), ".")
+	}
+	return diag.Summary
+}
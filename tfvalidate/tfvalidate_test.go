@@ -0,0 +1,158 @@
+package tfvalidate
+
+import (
+	"regexp"
+	"testing"
+
+	tfsdk "github.com/apparentlymart/terraform-sdk"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestValidators(t *testing.T) {
+	tests := map[string]struct {
+		Validator Validator
+		Value     cty.Value
+		Want      []string
+	}{
+		"StringLenBetween ok": {
+			StringLenBetween(1, 3),
+			cty.StringVal("abc"),
+			nil,
+		},
+		"StringLenBetween too long": {
+			StringLenBetween(1, 3),
+			cty.StringVal("abcd"),
+			[]string{"This value cannot be used: must be between 1 and 3 characters long."},
+		},
+		"StringLenBetween not a string": {
+			StringLenBetween(1, 3),
+			cty.EmptyObjectVal,
+			[]string{"This value cannot be used: string required."},
+		},
+		"StringLenBetween null": {
+			StringLenBetween(1, 3),
+			cty.NullVal(cty.String),
+			nil,
+		},
+		"StringLenBetween unknown": {
+			StringLenBetween(1, 3),
+			cty.UnknownVal(cty.String),
+			nil,
+		},
+		"StringMatch with message": {
+			StringMatch(regexp.MustCompile(`^[a-z]+$`), "must contain only lowercase letters"),
+			cty.StringVal("ABC"),
+			[]string{"This value cannot be used: must contain only lowercase letters."},
+		},
+		"StringMatch without message": {
+			StringMatch(regexp.MustCompile(`^[a-z]+$`), ""),
+			cty.StringVal("ABC"),
+			[]string{`This value cannot be used: must match the regular expression "^[a-z]+$".`},
+		},
+		"StringOneOf": {
+			StringOneOf("a", "b"),
+			cty.StringVal("c"),
+			[]string{`This value cannot be used: must be one of "a", "b".`},
+		},
+		"IntBetween ok": {
+			IntBetween(1, 10),
+			cty.NumberIntVal(10),
+			nil,
+		},
+		"IntBetween fraction": {
+			IntBetween(1, 10),
+			cty.NumberFloatVal(1.5),
+			[]string{"This value cannot be used: must be a whole number between 1 and 10."},
+		},
+		"IntBetween from string": {
+			IntBetween(1, 10),
+			cty.StringVal("11"),
+			[]string{"This value cannot be used: must be a whole number between 1 and 10."},
+		},
+		"IsCIDR ok": {
+			IsCIDR(),
+			cty.StringVal("10.0.0.0/16"),
+			nil,
+		},
+		"IsCIDR invalid": {
+			IsCIDR(),
+			cty.StringVal("10.0.0.0"),
+			[]string{`This value cannot be used: must be a network address in CIDR notation, such as "10.0.0.0/16".`},
+		},
+		"URLWithScheme ok": {
+			URLWithScheme("https"),
+			cty.StringVal("https://example.com/"),
+			nil,
+		},
+		"URLWithScheme wrong scheme": {
+			URLWithScheme("https"),
+			cty.StringVal("http://example.com/"),
+			[]string{`This value cannot be used: must be an absolute URL using the "https" scheme.`},
+		},
+		"URLWithScheme relative": {
+			URLWithScheme("http", "https"),
+			cty.StringVal("/foo"),
+			[]string{`This value cannot be used: must be an absolute URL using one of the schemes "http", "https".`},
+		},
+		"IsJSON": {
+			IsJSON(),
+			cty.StringVal("{"),
+			[]string{"This value cannot be used: must be a valid JSON document."},
+		},
+		"All": {
+			All(StringLenBetween(1, 2), StringOneOf("a", "b")),
+			cty.StringVal("abc"),
+			[]string{
+				"This value cannot be used: must be between 1 and 2 characters long.",
+				`This value cannot be used: must be one of "a", "b".`,
+			},
+		},
+		"Any ok": {
+			Any(IsCIDR(), StringOneOf("none")),
+			cty.StringVal("none"),
+			nil,
+		},
+		"Any invalid": {
+			Any(IsCIDR(), StringOneOf("none")),
+			cty.StringVal("all"),
+			[]string{`This value cannot be used: must be a network address in CIDR notation, such as "10.0.0.0/16", or must be one of "none".`},
+		},
+		"EachElement list": {
+			EachElement(StringOneOf("a")),
+			cty.ListVal([]cty.Value{cty.StringVal("a"), cty.StringVal("b"), cty.UnknownVal(cty.String)}),
+			[]string{`This value cannot be used: must be one of "a". (in [1])`},
+		},
+		"EachElement map": {
+			EachElement(StringOneOf("a")),
+			cty.MapVal(map[string]cty.Value{"x": cty.StringVal("a"), "y": cty.StringVal("b")}),
+			[]string{`This value cannot be used: must be one of "a". (in ["y"])`},
+		},
+		"EachElement set": {
+			EachElement(StringOneOf("a")),
+			cty.SetVal([]cty.Value{cty.StringVal("a"), cty.StringVal("b")}),
+			[]string{`This value cannot be used: must be one of "a".`},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := test.Validator(test.Value)
+			var got []string
+			for _, diag := range diags {
+				s := diag.Detail
+				if len(diag.Path) != 0 {
+					s += " (in " + tfsdk.FormatPath(diag.Path) + ")"
+				}
+				got = append(got, s)
+			}
+			if len(got) != len(test.Want) {
+				t.Fatalf("wrong diagnostics\ngot:  %#v\nwant: %#v", got, test.Want)
+			}
+			for i := range got {
+				if got[i] != test.Want[i] {
+					t.Errorf("wrong diagnostic %d\ngot:  %s\nwant: %s", i, got[i], test.Want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package tfvalidate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+// StringLenBetween returns a validator that requires a string with at least
+// min and at most max characters.
+func StringLenBetween(min, max int) Validator {
+	return validator(cty.String, func(val cty.Value) error {
+		if l := utf8.RuneCountInString(val.AsString()); l < min || l > max {
+			return fmt.Errorf("must be between %d and %d characters long", min, max)
+		}
+		return nil
+	})
+}
+
+// StringMatch returns a validator that requires a string matching the given
+// regular expression.
+//
+// If message is non-empty then it is used as the error message for a string
+// that does not match, and so should describe the expected format in a way
+// that makes sense after a colon in a full English sentence, such as
+// "must contain only lowercase letters". Otherwise, the error message
+// includes the pattern itself.
+func StringMatch(re *regexp.Regexp, message string) Validator {
+	if message == "" {
+		message = fmt.Sprintf("must match the regular expression %q", re.String())
+	}
+	return validator(cty.String, func(val cty.Value) error {
+		if !re.MatchString(val.AsString()) {
+			return errors.New(message)
+		}
+		return nil
+	})
+}
+
+// StringOneOf returns a validator that requires a string that is exactly
+// equal to one of the given strings.
+func StringOneOf(valid ...string) Validator {
+	quoted := make([]string, len(valid))
+	for i, s := range valid {
+		quoted[i] = fmt.Sprintf("%q", s)
+	}
+	return validator(cty.String, func(val cty.Value) error {
+		s := val.AsString()
+		for _, v := range valid {
+			if s == v {
+				return nil
+			}
+		}
+		return fmt.Errorf("must be one of %s", strings.Join(quoted, ", "))
+	})
+}
+
+// IntBetween returns a validator that requires a whole number that is at
+// least min and at most max.
+func IntBetween(min, max int64) Validator {
+	minF := new(big.Float).SetInt64(min)
+	maxF := new(big.Float).SetInt64(max)
+	return validator(cty.Number, func(val cty.Value) error {
+		bf := val.AsBigFloat()
+		if !bf.IsInt() || bf.Cmp(minF) < 0 || bf.Cmp(maxF) > 0 {
+			return fmt.Errorf("must be a whole number between %d and %d", min, max)
+		}
+		return nil
+	})
+}
+
+// IsCIDR returns a validator that requires an IPv4 or IPv6 network address
+// in CIDR notation, such as "10.0.0.0/16".
+func IsCIDR() Validator {
+	return validator(cty.String, func(val cty.Value) error {
+		if _, _, err := net.ParseCIDR(val.AsString()); err != nil {
+			return errors.New(`must be a network address in CIDR notation, such as "10.0.0.0/16"`)
+		}
+		return nil
+	})
+}
+
+// URLWithScheme returns a validator that requires an absolute URL using one
+// of the given schemes, such as "https".
+func URLWithScheme(schemes ...string) Validator {
+	var want string
+	switch len(schemes) {
+	case 1:
+		want = fmt.Sprintf("the %q scheme", schemes[0])
+	default:
+		quoted := make([]string, len(schemes))
+		for i, s := range schemes {
+			quoted[i] = fmt.Sprintf("%q", s)
+		}
+		want = fmt.Sprintf("one of the schemes %s", strings.Join(quoted, ", "))
+	}
+	return validator(cty.String, func(val cty.Value) error {
+		u, err := url.Parse(val.AsString())
+		if err == nil && u.Host != "" {
+			for _, s := range schemes {
+				if strings.EqualFold(u.Scheme, s) {
+					return nil
+				}
+			}
+		}
+		return fmt.Errorf("must be an absolute URL using %s", want)
+	})
+}
+
+// IsJSON returns a validator that requires a string containing a valid JSON
+// document.
+func IsJSON() Validator {
+	return validator(cty.String, func(val cty.Value) error {
+		if !json.Valid([]byte(val.AsString())) {
+			return errors.New("must be a valid JSON document")
+		}
+		return nil
+	})
+}